@@ -56,13 +56,7 @@ def pytest_configure(config):
             "tests.store.tracking.test_sqlalchemy_store.test_log_batch_null_metrics",
             "tests/override_test_sqlalchemy_store.py",
         ),
-        # We do not support applying the SQL schema to sqlite like Python does.
-        # So we do not support sqlite:////:memory: database.
-        (
-            "tests.store.tracking.test_sqlalchemy_store.test_sqlalchemy_store_behaves_as_expected_with_inmemory_sqlite_db",
-            "tests/override_test_sqlalchemy_store.py",
-        ),  # We do not support applying the SQL schema to sqlite like Python does.
-        # So we do not support sqlite:////:memory: database.
+        # The Go validation of max_results reports a different error message.
         (
             "tests.store.tracking.test_sqlalchemy_store.test_search_experiments_max_results_validation",
             "tests/override_test_sqlalchemy_store.py",
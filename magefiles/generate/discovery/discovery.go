@@ -13,8 +13,13 @@ import (
 )
 
 type ServiceInfo struct {
-	Name    string
-	Methods []MethodInfo
+	Name        string
+	PackageName string
+	// FullName is the name of the service in the protos, like mlflow.MlflowService.
+	FullName string
+	// FileName is the path of the proto file declaring the service.
+	FileName string
+	Methods  []MethodInfo
 }
 
 type MethodInfo struct {
@@ -32,6 +37,8 @@ type Endpoint struct {
 
 var routeParameterRegex = regexp.MustCompile(`<[^>]+:([^>]+)>`)
 
+var nestedRouteParameterRegex = regexp.MustCompile(`:[\w.]+`)
+
 // Get the safe path to use in Fiber registration.
 func (e Endpoint) GetFiberPath() string {
 	// e.Path cannot be trusted, it could be something like /mlflow-artifacts/artifacts/<path:artifact_path>
@@ -47,6 +54,12 @@ func (e Endpoint) GetFiberPath() string {
 	path = strings.ReplaceAll(path, "{", ":")
 	path = strings.ReplaceAll(path, "}", "")
 
+	// fiber treats `.` as a delimiter, nested fields like {assessment.trace_id}
+	// are converted to :assessment_trace_id
+	path = nestedRouteParameterRegex.ReplaceAllStringFunc(path, func(s string) string {
+		return strings.ReplaceAll(s, ".", "_")
+	})
+
 	return path
 }
 
@@ -71,7 +84,13 @@ func GetServiceInfos() ([]ServiceInfo, error) {
 			return nil, fmt.Errorf("service %s not found", service.Name)
 		}
 
-		serviceInfo := ServiceInfo{Name: service.Name, Methods: make([]MethodInfo, 0)}
+		serviceInfo := ServiceInfo{
+			Name:        service.Name,
+			PackageName: service.PackageName,
+			FullName:    string(serviceDescriptor.FullName()),
+			FileName:    service.Descriptor.Path(),
+			Methods:     make([]MethodInfo, 0),
+		}
 
 		methods := serviceDescriptor.Methods()
 		for mIdx := range methods.Len() {
@@ -38,6 +38,14 @@ func TestPattern(t *testing.T) {
 			},
 			expected: "/mlflow-artifacts/artifacts/:path",
 		},
+		{
+			name: "POST with nested route parameter",
+			endpoint: discovery.Endpoint{
+				Method: "POST",
+				Path:   "/mlflow/traces/{assessment.trace_id}/assessments",
+			},
+			expected: "/mlflow/traces/:assessment_trace_id/assessments",
+		},
 	}
 
 	for _, scenario := range scenarios {
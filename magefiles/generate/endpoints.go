@@ -4,12 +4,15 @@ type ServiceGenerationInfo struct {
 	FileNameWithoutExtension string
 	ServiceName              string
 	ImplementedEndpoints     []string
+	// GRPC registers the implemented endpoints on the gRPC server too.
+	GRPC bool
 }
 
 var ServiceInfoMap = map[string]ServiceGenerationInfo{
 	"MlflowService": {
 		FileNameWithoutExtension: "tracking",
 		ServiceName:              "TrackingService",
+		GRPC:                     true,
 		ImplementedEndpoints: []string{
 			"getExperimentByName",
 			"createExperiment",
@@ -33,34 +36,49 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 			"searchRuns",
 			// "listArtifacts",
 			"getMetricHistory",
-			// "getMetricHistoryBulkInterval",
+			"getMetricHistoryBulkInterval",
 			"logBatch",
 			// "logModel",
 			"logInputs",
+			"logOutputs",
+			"searchDatasets",
 			"startTrace",
 			"endTrace",
 			"getTraceInfo",
-			// "searchTraces",
+			"getTraceInfoV3",
+			"searchTraces",
 			"deleteTraces",
+			"createAssessment",
+			"updateAssessment",
+			"deleteAssessment",
+			"createLoggedModel",
+			"finalizeLoggedModel",
+			"getLoggedModel",
+			"deleteLoggedModel",
+			"searchLoggedModels",
+			"setLoggedModelTags",
+			"deleteLoggedModelTag",
+			"LogLoggedModelParams",
 		},
 	},
 	"ModelRegistryService": {
 		FileNameWithoutExtension: "model_registry",
 		ServiceName:              "ModelRegistryService",
+		GRPC:                     true,
 		ImplementedEndpoints: []string{
 			"createRegisteredModel",
 			"renameRegisteredModel",
 			"updateRegisteredModel",
 			"deleteRegisteredModel",
 			"getRegisteredModel",
-			// "searchRegisteredModels",
+			"searchRegisteredModels",
 			"getLatestVersions",
-			// "createModelVersion",
+			"createModelVersion",
 			"updateModelVersion",
 			"transitionModelVersionStage",
 			"deleteModelVersion",
 			"getModelVersion",
-			// "searchModelVersions",
+			"searchModelVersions",
 			"getModelVersionDownloadUri",
 			"setRegisteredModelTag",
 			"setModelVersionTag",
@@ -74,14 +92,10 @@ var ServiceInfoMap = map[string]ServiceGenerationInfo{
 	"MlflowArtifactsService": {
 		FileNameWithoutExtension: "artifacts",
 		ServiceName:              "ArtifactsService",
-		ImplementedEndpoints:     []string{
-			// "downloadArtifact",
-			// "uploadArtifact",
-			// "listArtifacts",
-			// "deleteArtifact",
-			// "createMultipartUpload",
-			// "completeMultipartUpload",
-			// "abortMultipartUpload",
+		ImplementedEndpoints: []string{
+			// The other endpoints take the artifact path from the URL,
+			// their routes live in pkg/server/routes/artifacts.go.
+			"listArtifacts",
 		},
 	},
 }
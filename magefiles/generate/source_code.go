@@ -102,9 +102,21 @@ func saveASTToFile(fset *token.FileSet, file *ast.File, addComment bool, outputP
 }
 
 //nolint:funlen
-func mkAppRoute(method discovery.MethodInfo, endpoint discovery.Endpoint) ast.Stmt {
+func mkAppRoute(interfaceName string, method discovery.MethodInfo, endpoint discovery.Endpoint) ast.Stmt {
 	urlExpr := &ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf(`"%s"`, endpoint.GetFiberPath())}
 
+	// defer tracing.StartRouteSpan(ctx, "searchExperiments").End()
+	spanStmt := &ast.DeferStmt{
+		Call: mkCallExpr(&ast.SelectorExpr{
+			X: mkCallExpr(
+				mkSelectorExpr("tracing", "StartRouteSpan"),
+				ast.NewIdent("ctx"),
+				&ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf(`"%s"`, method.Name)},
+			),
+			Sel: ast.NewIdent("End"),
+		}),
+	}
+
 	// input := &protos.SearchExperiments
 	inputExpr := mkAssignStmt(
 		[]ast.Expr{ast.NewIdent("input")},
@@ -129,20 +141,25 @@ func mkAppRoute(method discovery.MethodInfo, endpoint discovery.Endpoint) ast.St
 		mkBlockStmt(returnErr),
 	)
 
-	// output, err := service.Method(input)
+	// output, err := tracing.CallService(ctx, "TrackingService.SearchExperiments", service.SearchExperiments, input)
 	outputExpr := mkAssignStmt([]ast.Expr{
 		ast.NewIdent("output"),
 		ast.NewIdent("err"),
 	}, []ast.Expr{
 		mkCallExpr(
-			mkSelectorExpr(
-				"service",
-				strcase.ToCamel(method.Name),
-			),
+			mkSelectorExpr("tracing", "CallService"),
 			mkCallExpr(
 				mkSelectorExpr("utils", "NewContextWithLoggerFromFiberContext"),
 				ast.NewIdent("ctx"),
 			),
+			&ast.BasicLit{
+				Kind:  token.STRING,
+				Value: fmt.Sprintf(`"%s.%s"`, interfaceName, strcase.ToCamel(method.Name)),
+			},
+			mkSelectorExpr(
+				"service",
+				strcase.ToCamel(method.Name),
+			),
 			ast.NewIdent("input"),
 		),
 	})
@@ -177,6 +194,7 @@ func mkAppRoute(method discovery.MethodInfo, endpoint discovery.Endpoint) ast.St
 		},
 		Body: &ast.BlockStmt{
 			List: []ast.Stmt{
+				spanStmt,
 				inputExpr,
 				inputErrorCheck,
 				outputExpr,
@@ -202,7 +220,7 @@ func mkRouteRegistrationFunction(
 	for _, method := range serviceInfo.Methods {
 		for _, endpoint := range method.Endpoints {
 			if _, ok := endpoints[method.Name]; ok {
-				routes = append(routes, mkAppRoute(method, endpoint))
+				routes = append(routes, mkAppRoute(interfaceName, method, endpoint))
 			}
 		}
 	}
@@ -243,6 +261,15 @@ func mkGeneratedFile(pkg, outputPath string, decls []ast.Decl) error {
 
 const expectedImportStatements = 2
 
+// Get the import statement of the Go package holding the messages of a service.
+func mkProtosImportStatement(packageName string) string {
+	if packageName == "protos" {
+		return `"github.com/mlflow/mlflow-go-backend/pkg/protos"`
+	}
+
+	return fmt.Sprintf(`"github.com/mlflow/mlflow-go-backend/pkg/protos/%s"`, packageName)
+}
+
 // Generate the service interface.
 func generateServices(
 	pkgFolder string,
@@ -257,7 +284,7 @@ func generateServices(
 	if len(endpoints) > 0 {
 		importStatements = []string{
 			`"context"`,
-			`"github.com/mlflow/mlflow-go-backend/pkg/protos"`,
+			mkProtosImportStatement(serviceInfo.PackageName),
 			`"github.com/mlflow/mlflow-go-backend/pkg/contract"`,
 		}
 	}
@@ -293,7 +320,8 @@ func generateRouteRegistrations(
 		importStatements = append(
 			importStatements,
 			`"github.com/mlflow/mlflow-go-backend/pkg/utils"`,
-			`"github.com/mlflow/mlflow-go-backend/pkg/protos"`,
+			`"github.com/mlflow/mlflow-go-backend/pkg/tracing"`,
+			mkProtosImportStatement(serviceInfo.PackageName),
 		)
 	}
 
@@ -309,6 +337,91 @@ func generateRouteRegistrations(
 	return mkGeneratedFile(pkg, outputPath, decls)
 }
 
+// Generate the statement adding a method to the gRPC service description.
+func mkGRPCMethod(interfaceName string, method discovery.MethodInfo) ast.Stmt {
+	// addUnaryMethod(desc, "getExperiment", service.TrackingService.GetExperiment)
+	return &ast.ExprStmt{
+		X: mkCallExpr(
+			ast.NewIdent("addUnaryMethod"),
+			ast.NewIdent("desc"),
+			&ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf(`"%s"`, method.Name)},
+			&ast.SelectorExpr{
+				X:   mkSelectorExpr("service", interfaceName),
+				Sel: ast.NewIdent(strcase.ToCamel(method.Name)),
+			},
+		),
+	}
+}
+
+func mkGRPCRegistrationFunction(
+	endpoints map[string]any, interfaceName string, serviceInfo discovery.ServiceInfo,
+) *ast.FuncDecl {
+	stmts := make([]ast.Stmt, 0, len(endpoints)+2) //nolint:mnd
+
+	// desc := newServiceDesc("mlflow.MlflowService", (*service.TrackingService)(nil), "service.proto")
+	stmts = append(stmts, mkAssignStmt(
+		[]ast.Expr{ast.NewIdent("desc")},
+		[]ast.Expr{
+			mkCallExpr(
+				ast.NewIdent("newServiceDesc"),
+				&ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf(`"%s"`, serviceInfo.FullName)},
+				mkCallExpr(
+					&ast.ParenExpr{X: mkStarExpr(mkSelectorExpr("service", interfaceName))},
+					ast.NewIdent("nil"),
+				),
+				&ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf(`"%s"`, serviceInfo.FileName)},
+			),
+		},
+	))
+
+	for _, method := range serviceInfo.Methods {
+		if _, ok := endpoints[method.Name]; ok {
+			stmts = append(stmts, mkGRPCMethod(interfaceName, method))
+		}
+	}
+
+	// server.RegisterService(desc, impl)
+	stmts = append(stmts, &ast.ExprStmt{
+		X: mkCallExpr(mkSelectorExpr("server", "RegisterService"), ast.NewIdent("desc"), ast.NewIdent("impl")),
+	})
+
+	return &ast.FuncDecl{
+		Name: ast.NewIdent(fmt.Sprintf("Register%sServer", interfaceName)),
+		Type: &ast.FuncType{
+			Params: &ast.FieldList{
+				List: []*ast.Field{
+					mkNamedField("server", mkSelectorExpr("grpc", "ServiceRegistrar")),
+					mkNamedField("impl", mkSelectorExpr("service", interfaceName)),
+				},
+			},
+		},
+		Body: &ast.BlockStmt{
+			List: stmts,
+		},
+	}
+}
+
+func generateGRPCRegistrations(
+	pkgFolder string,
+	serviceInfo discovery.ServiceInfo,
+	generationInfo ServiceGenerationInfo,
+	endpoints map[string]any,
+) error {
+	decls := []ast.Decl{
+		mkImportStatements(
+			`"google.golang.org/grpc"`,
+			`"github.com/mlflow/mlflow-go-backend/pkg/contract/service"`,
+		),
+		mkGRPCRegistrationFunction(endpoints, generationInfo.ServiceName, serviceInfo),
+	}
+
+	fileName := generationInfo.FileNameWithoutExtension + ".g.go"
+	pkg := "rpc"
+	outputPath := filepath.Join(pkgFolder, "server", pkg, fileName)
+
+	return mkGeneratedFile(pkg, outputPath, decls)
+}
+
 func mkCEndpointBody(serviceName string, method discovery.MethodInfo) *ast.BlockStmt {
 	mapName := strcase.ToLowerCamel(serviceName) + "s"
 
@@ -351,7 +464,7 @@ func mkCEndpointBody(serviceName string, method discovery.MethodInfo) *ast.Block
 				mkCallExpr(
 					ast.NewIdent("invokeServiceMethod"),
 					mkSelectorExpr("service", strcase.ToCamel(method.Name)),
-					mkCallExpr(ast.NewIdent("new"), mkSelectorExpr("protos", method.Input)),
+					mkCallExpr(ast.NewIdent("new"), mkSelectorExpr(method.PackageName, method.Input)),
 					ast.NewIdent("requestData"),
 					ast.NewIdent("requestSize"),
 					ast.NewIdent("responseSize"),
@@ -421,7 +534,7 @@ func generateEndpoints(
 			decls,
 			mkImportStatements(
 				`"unsafe"`,
-				`"github.com/mlflow/mlflow-go-backend/pkg/protos"`,
+				mkProtosImportStatement(serviceInfo.PackageName),
 			),
 		)
 
@@ -469,6 +582,13 @@ func SourceCode(pkgFolder string) error {
 		if err != nil {
 			return err
 		}
+
+		if generationInfo.GRPC {
+			err = generateGRPCRegistrations(pkgFolder, serviceInfo, generationInfo, endpoints)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -1,77 +1,116 @@
 package generate
 
 var validations = map[string]string{
-	"GetExperiment_ExperimentId":         "required,stringAsPositiveInteger",
-	"CreateExperiment_Name":              "required,max=500",
-	"CreateExperiment_ArtifactLocation":  "omitempty,uriWithoutFragmentsOrParamsOrDotDotInQuery",
-	"SearchRuns_RunViewType":             "omitempty",
-	"SearchRuns_MaxResults":              "gt=0,max=50000",
-	"DeleteExperiment_ExperimentId":      "required,stringAsPositiveInteger",
-	"LogParam_Key":                       "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"LogParam_Value":                     "omitempty,truncate=6000",
-	"LogBatch_RunId":                     "required,runId",
-	"LogBatch_Params":                    "omitempty,uniqueParams,max=100,dive",
-	"LogBatch_Metrics":                   "max=1000,dive",
-	"LogBatch_Tags":                      "max=100",
-	"RunTag_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"RunTag_Value":                       "omitempty,max=5000",
-	"Param_Key":                          "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"Param_Value":                        "omitempty,truncate=6000",
-	"Metric_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"Metric_Timestamp":                   "required",
-	"Metric_Value":                       "required",
-	"CreateRun_ExperimentId":             "required,stringAsPositiveInteger",
-	"GetExperimentByName_ExperimentName": "required",
-	"GetLatestVersions_Name":             "required",
-	"LogMetric_RunId":                    "required",
-	"LogMetric_Key":                      "required",
-	"LogMetric_Value":                    "required",
-	"LogMetric_Timestamp":                "required",
-	"SetTraceTag_Key":                    "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"SetTraceTag_Value":                  "omitempty,truncate=8000",
-	"DeleteTag_RunId":                    "required",
-	"DeleteTag_Key":                      "required",
-	"SetExperimentTag_ExperimentId":      "required",
-	"SetExperimentTag_Key":               "required,max=250,validMetricParamOrTagName",
-	"SetExperimentTag_Value":             "max=5000",
-	"SearchExperiments_MaxResults":       "positiveNonZeroInteger,max=50000",
-	"SetTag_Key":                         "required,max=1000,validMetricParamOrTagName,pathIsUnique",
-	"SetTag_Value":                       "omitempty,truncate=8000",
-	"LogInputs_RunId":                    "required,runId",
-	"LogInputs_Datasets":                 "required",
-	"DatasetInput_Dataset":               "required",
-	"Dataset_Name":                       "required,max=500",
-	"Dataset_Digest":                     "required,max=36",
-	"Dataset_SourceType":                 "required",
-	"Dataset_Source":                     "required,max=65535",
-	"Dataset_Profile":                    "max:16777215",
-	"Dataset_Schema":                     "max:1048575",
-	"InputTag_Key":                       "required,max=255",
-	"InputTag_Value":                     "required,max=500",
-	"RenameRegisteredModel_Name":         "notEmpty,required",
-	"RenameRegisteredModel_NewName":      "notEmpty,required",
-	"SetRegisteredModelTag_Name":         "required",
-	"SetRegisteredModelTag_Key":          "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"SetRegisteredModelTag_Value":        "omitempty,max=5000",
-	"CreateRegisteredModel_Name":         "notEmpty,required",
-	"CreateRegisteredModel_Key":          "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"CreateRegisteredModel_Value":        "omitempty,max=5000,truncate=5000",
-	"DeleteRegisteredModelTag_Name":      "required",
-	"DeleteRegisteredModelTag_Key":       "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"SetRegisteredModelAlias_Name":       "required",
-	"SetRegisteredModelAlias_Alias":      "required,max=255,validMetricParamOrTagName,pathIsUnique",
-	"DeleteRegisteredModelAlias_Name":    "required",
-	"DeleteRegisteredModelAlias_Alias":   "required,max=255,validMetricParamOrTagName,pathIsUnique",
-	"DeleteModelVersionTag_Name":         "required",
-	"DeleteModelVersionTag_Key":          "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"DeleteModelVersionTag_Version":      "stringAsInteger",
-	"GetModelVersionByAlias_Name":        "required",
-	"GetModelVersionByAlias_Alias":       "required,max=255,validMetricParamOrTagName,pathIsUnique",
-	"GetModelVersionByAlias_Version":     "stringAsInteger",
-	"SetModelVersionTag_Name":            "required",
-	"SetModelVersionTag_Key":             "required,max=250,validMetricParamOrTagName,pathIsUnique",
-	"SetModelVersionTag_Value":           "omitempty,max=5000,truncate=5000",
-	"SetModelVersionTag_Version":         "stringAsInteger",
-	"GetModelVersion_Version":            "stringAsInteger",
-	"GetModelVersionDownloadUri_Version": "stringAsInteger",
+	"GetExperiment_ExperimentId":              "required,stringAsPositiveInteger",
+	"CreateExperiment_Name":                   "required,max=500",
+	"CreateExperiment_ArtifactLocation":       "omitempty,uriWithoutFragmentsOrParamsOrDotDotInQuery",
+	"SearchRuns_RunViewType":                  "omitempty",
+	"SearchRuns_MaxResults":                   "gt=0,max=50000",
+	"DeleteExperiment_ExperimentId":           "required,stringAsPositiveInteger",
+	"LogParam_Key":                            "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"LogParam_Value":                          "omitempty,truncate=6000",
+	"LogBatch_RunId":                          "required,runId",
+	"LogBatch_Params":                         "omitempty,uniqueParams,max=100,dive",
+	"LogBatch_Metrics":                        "max=1000,dive",
+	"LogBatch_Tags":                           "max=100",
+	"RunTag_Key":                              "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"RunTag_Value":                            "omitempty,max=5000",
+	"Param_Key":                               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"Param_Value":                             "omitempty,truncate=6000",
+	"Metric_Key":                              "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"Metric_Timestamp":                        "required",
+	"Metric_Value":                            "required",
+	"CreateRun_ExperimentId":                  "required,stringAsPositiveInteger",
+	"GetExperimentByName_ExperimentName":      "required",
+	"GetLatestVersions_Name":                  "required",
+	"LogMetric_RunId":                         "required",
+	"LogMetric_Key":                           "required",
+	"LogMetric_Value":                         "required",
+	"LogMetric_Timestamp":                     "required",
+	"SetTraceTag_Key":                         "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetTraceTag_Value":                       "omitempty,truncate=8000",
+	"DeleteTag_RunId":                         "required",
+	"DeleteTag_Key":                           "required",
+	"SetExperimentTag_ExperimentId":           "required",
+	"SetExperimentTag_Key":                    "required,max=250,validMetricParamOrTagName",
+	"SetExperimentTag_Value":                  "max=5000",
+	"SearchExperiments_MaxResults":            "positiveNonZeroInteger,max=50000",
+	"SetTag_Key":                              "required,max=1000,validMetricParamOrTagName,pathIsUnique",
+	"SetTag_Value":                            "omitempty,truncate=8000",
+	"LogInputs_RunId":                         "required,runId",
+	"LogInputs_Datasets":                      "required",
+	"GetTraceInfoV3_TraceId":                  "required",
+	"CreateAssessment_Assessment":             "required",
+	"UpdateAssessment_Assessment":             "required",
+	"UpdateAssessment_UpdateMask":             "required",
+	"DeleteAssessment_TraceId":                "required",
+	"DeleteAssessment_AssessmentId":           "required",
+	"Assessment_TraceId":                      "required",
+	"LogOutputs_RunId":                        "required,runId",
+	"LogOutputs_Models":                       "required,dive",
+	"ModelOutput_ModelId":                     "required",
+	"DatasetInput_Dataset":                    "required",
+	"Dataset_Name":                            "required,max=500",
+	"Dataset_Digest":                          "required,max=36",
+	"Dataset_SourceType":                      "required",
+	"Dataset_Source":                          "required,max=65535",
+	"Dataset_Profile":                         "max:16777215",
+	"Dataset_Schema":                          "max:1048575",
+	"InputTag_Key":                            "required,max=255",
+	"InputTag_Value":                          "required,max=500",
+	"RenameRegisteredModel_Name":              "notEmpty,required",
+	"RenameRegisteredModel_NewName":           "notEmpty,required",
+	"SetRegisteredModelTag_Name":              "required",
+	"SetRegisteredModelTag_Key":               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetRegisteredModelTag_Value":             "omitempty,max=5000",
+	"CreateRegisteredModel_Name":              "notEmpty,required",
+	"CreateRegisteredModel_Key":               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"CreateRegisteredModel_Value":             "omitempty,max=5000,truncate=5000",
+	"DeleteRegisteredModelTag_Name":           "required",
+	"DeleteRegisteredModelTag_Key":            "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetRegisteredModelAlias_Name":            "required",
+	"SetRegisteredModelAlias_Alias":           "required,max=255,validMetricParamOrTagName,pathIsUnique",
+	"DeleteRegisteredModelAlias_Name":         "required",
+	"DeleteRegisteredModelAlias_Alias":        "required,max=255,validMetricParamOrTagName,pathIsUnique",
+	"DeleteModelVersionTag_Name":              "required",
+	"DeleteModelVersionTag_Key":               "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"DeleteModelVersionTag_Version":           "stringAsInteger",
+	"GetModelVersionByAlias_Name":             "required",
+	"GetModelVersionByAlias_Alias":            "required,max=255,validMetricParamOrTagName,pathIsUnique",
+	"GetModelVersionByAlias_Version":          "stringAsInteger",
+	"SetModelVersionTag_Name":                 "required",
+	"SetModelVersionTag_Key":                  "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"SetModelVersionTag_Value":                "omitempty,max=5000,truncate=5000",
+	"SetModelVersionTag_Version":              "stringAsInteger",
+	"GetModelVersion_Version":                 "stringAsInteger",
+	"GetModelVersionDownloadUri_Version":      "stringAsInteger",
+	"SearchRegisteredModels_MaxResults":       "omitempty,positiveNonZeroInteger,max=1000",
+	"SearchTraces_MaxResults":                 "omitempty,positiveNonZeroInteger,max=50000",
+	"GetMetricHistoryBulkInterval_RunIds":     "required,min=1,max=100",
+	"GetMetricHistoryBulkInterval_MetricKey":  "required",
+	"GetMetricHistoryBulkInterval_MaxResults": "omitempty,positiveNonZeroInteger,max=2500",
+	"CreateLoggedModel_ExperimentId":          "required",
+	"FinalizeLoggedModel_ModelId":             "required",
+	"FinalizeLoggedModel_Status":              "required",
+	"GetLoggedModel_ModelId":                  "required",
+	"DeleteLoggedModel_ModelId":               "required",
+	"SearchLoggedModels_ExperimentIds":        "required,min=1",
+	"SearchDatasets_ExperimentIds":            "required,min=1",
+	"SearchLoggedModels_MaxResults":           "omitempty,positiveNonZeroInteger,max=50000",
+	"SetLoggedModelTags_ModelId":              "required",
+	"DeleteLoggedModelTag_ModelId":            "required",
+	"DeleteLoggedModelTag_TagKey":             "required",
+	"LogLoggedModelParamsRequest_ModelId":     "required",
+	"SearchModelVersions_MaxResults":          "omitempty,positiveNonZeroInteger,max=200000",
+	"CreateModelVersion_Name":                 "notEmpty,required",
+	"CreateModelVersion_Source":               "notEmpty,required",
+	"CreateModelVersion_Tags":                 "omitempty,dive",
+	"ModelVersionTag_Key":                     "required,max=250,validMetricParamOrTagName,pathIsUnique",
+	"ModelVersionTag_Value":                   "omitempty,max=5000",
+	"CreateMultipartUpload_Path":              "required",
+	"CreateMultipartUpload_NumParts":          "required,positiveNonZeroInteger,max=10000",
+	"CompleteMultipartUpload_Path":            "required",
+	"CompleteMultipartUpload_UploadId":        "required",
+	"AbortMultipartUpload_Path":               "required",
+	"AbortMultipartUpload_UploadId":           "required",
 }
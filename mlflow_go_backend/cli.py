@@ -64,9 +64,33 @@ def _get_commands():
         tracking_store_uri = kwargs["backend_store_uri"]
         config = {
             "address": f'{kwargs["host"]}:{kwargs["port"]}',
+            "auth": {
+                "enabled": opts.get("auth_enabled", "false").lower() == "true",
+                "admin_username": opts.get("auth_admin_username"),
+                "admin_password": opts.get("auth_admin_password"),
+                "default_permission": opts.get("auth_default_permission"),
+                "certificate_authentication": (
+                    opts.get("auth_certificate_authentication", "false").lower() == "true"
+                ),
+            },
+            "artifacts_destination": (
+                kwargs["artifacts_destination"] if kwargs["serve_artifacts"] else None
+            ),
             "default_artifact_root": mlflow.cli.resolve_default_artifact_root(
                 kwargs["serve_artifacts"], kwargs["default_artifact_root"], tracking_store_uri
             ),
+            "expose_prometheus": bool(kwargs.get("expose_prometheus")),
+            "grpc_address": opts.get("grpc_address"),
+            "http": {
+                "body_limit": opts.get("http_body_limit"),
+                "read_buffer_size": opts.get("http_read_buffer_size"),
+                "write_buffer_size": opts.get("http_write_buffer_size"),
+                "read_timeout": opts.get("http_read_timeout"),
+                "write_timeout": opts.get("http_write_timeout"),
+                "idle_timeout": opts.get("http_idle_timeout"),
+                "disable_keepalive": opts.get("http_disable_keepalive", "false").lower() == "true",
+                "concurrency": int(opts.get("http_concurrency", "0")),
+            },
             "log_level": opts.get("log_level", "DEBUG" if kwargs["dev"] else "INFO"),
             "python_address": python_address,
             "python_command": python_command,
@@ -75,7 +99,20 @@ def _get_commands():
             .parent.joinpath(mlflow.server.REL_STATIC_DIR)
             .resolve()
             .as_posix(),
+            "tls": {
+                "cert_file": opts.get("tls_cert_file"),
+                "key_file": opts.get("tls_key_file"),
+                "client_ca_file": opts.get("tls_client_ca_file"),
+                "client_auth": opts.get("tls_client_auth"),
+                "reload_interval": opts.get("tls_reload_interval"),
+            },
             "tracking_store_uri": tracking_store_uri,
+            "tracing": {
+                "exporter": opts.get("tracing_exporter"),
+                "endpoint": opts.get("tracing_endpoint"),
+                "file": opts.get("tracing_file"),
+                "service_name": opts.get("tracing_service_name"),
+            },
             "model_registry_store_uri": kwargs["registry_store_uri"] or tracking_store_uri,
             "version": mlflow.version.VERSION,
         }
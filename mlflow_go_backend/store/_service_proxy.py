@@ -2,11 +2,29 @@ import json
 
 from google.protobuf.message import DecodeError
 from mlflow.exceptions import MlflowException
-from mlflow.protos.databricks_pb2 import INTERNAL_ERROR, ErrorCode
+from mlflow.protos.databricks_pb2 import INTERNAL_ERROR, INVALID_PARAMETER_VALUE, ErrorCode
+from sqlalchemy.engine.url import make_url
 
 from mlflow_go_backend.lib import get_ffi, get_lib
 
 
+def _check_store_uri(store_uri):
+    # The in-memory database of the Go stores is not the one of the SQLAlchemy engine, the
+    # calls left to the Python store would not see the rows written by Go.
+    if store_uri is None or not store_uri.startswith("sqlite"):
+        return
+
+    url = make_url(store_uri)
+    if url.database in (None, "", ":memory:"):
+        raise MlflowException(
+            message=(
+                f"The in-memory store URI '{store_uri}' is not supported by the Go backend, "
+                "use a SQLite file or a database server instead"
+            ),
+            error_code=INVALID_PARAMETER_VALUE,
+        )
+
+
 class _ServiceProxy:
     def __init__(self, id):
         self.id = id
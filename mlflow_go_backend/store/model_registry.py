@@ -3,6 +3,7 @@ import logging
 
 from mlflow.entities.model_registry import ModelVersion, RegisteredModel
 from mlflow.protos.model_registry_pb2 import (
+    CreateModelVersion,
     CreateRegisteredModel,
     DeleteModelVersion,
     DeleteModelVersionTag,
@@ -15,6 +16,8 @@ from mlflow.protos.model_registry_pb2 import (
     GetModelVersionDownloadUri,
     GetRegisteredModel,
     RenameRegisteredModel,
+    SearchModelVersions,
+    SearchRegisteredModels,
     SetModelVersionTag,
     SetRegisteredModelAlias,
     SetRegisteredModelTag,
@@ -22,10 +25,15 @@ from mlflow.protos.model_registry_pb2 import (
     UpdateModelVersion,
     UpdateRegisteredModel,
 )
+from mlflow.store.entities import PagedList
+from mlflow.store.model_registry import (
+    SEARCH_MODEL_VERSION_MAX_RESULTS_DEFAULT,
+    SEARCH_REGISTERED_MODEL_MAX_RESULTS_DEFAULT,
+)
 
 from mlflow_go_backend import is_go_enabled
 from mlflow_go_backend.lib import get_lib
-from mlflow_go_backend.store._service_proxy import _ServiceProxy
+from mlflow_go_backend.store._service_proxy import _check_store_uri, _ServiceProxy
 
 _logger = logging.getLogger(__name__)
 
@@ -33,6 +41,7 @@ _logger = logging.getLogger(__name__)
 class _ModelRegistryStore:
     def __init__(self, *args, **kwargs):
         store_uri = args[0] if len(args) > 0 else kwargs.get("db_uri", kwargs.get("root_directory"))
+        _check_store_uri(store_uri)
         config = json.dumps(
             {
                 "model_registry_store_uri": store_uri,
@@ -92,6 +101,55 @@ class _ModelRegistryStore:
 
         return entity
 
+    def search_registered_models(
+        self,
+        filter_string=None,
+        max_results=SEARCH_REGISTERED_MODEL_MAX_RESULTS_DEFAULT,
+        order_by=None,
+        page_token=None,
+    ):
+        request = SearchRegisteredModels(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchRegisteredModels, request
+        )
+        registered_models = [
+            RegisteredModel.from_proto(registered_model)
+            for registered_model in response.registered_models
+        ]
+        return PagedList(registered_models, (response.next_page_token or None))
+
+    def create_model_version(
+        self,
+        name,
+        source,
+        run_id=None,
+        tags=None,
+        run_link=None,
+        description=None,
+        local_model_path=None,
+        model_id=None,
+    ):
+        request = CreateModelVersion(
+            name=name,
+            source=source,
+            run_id=run_id,
+            tags=[tag.to_proto() for tag in tags] if tags else [],
+            run_link=run_link,
+            description=description,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceCreateModelVersion, request
+        )
+        entity = ModelVersion.from_proto(response.model_version)
+        if entity.description == "":
+            entity.description = None
+        return entity
+
     def delete_model_version(self, name, version):
         request = DeleteModelVersion(name=name, version=str(version))
         self.service.call_endpoint(get_lib().ModelRegistryServiceDeleteModelVersion, request)
@@ -106,6 +164,27 @@ class _ModelRegistryStore:
             entity.description = None
         return entity
 
+    def search_model_versions(
+        self,
+        filter_string=None,
+        max_results=SEARCH_MODEL_VERSION_MAX_RESULTS_DEFAULT,
+        order_by=None,
+        page_token=None,
+    ):
+        request = SearchModelVersions(
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(
+            get_lib().ModelRegistryServiceSearchModelVersions, request
+        )
+        model_versions = [
+            ModelVersion.from_proto(model_version) for model_version in response.model_versions
+        ]
+        return PagedList(model_versions, (response.next_page_token or None))
+
     def update_model_version(self, name, version, description=None):
         request = UpdateModelVersion(name=name, version=str(version), description=description)
         self.service.call_endpoint(get_lib().ModelRegistryServiceUpdateModelVersion, request)
@@ -188,3 +267,12 @@ def _get_sqlalchemy_store(store_uri):
         SqlAlchemyStore = ModelRegistryStore(SqlAlchemyStore)
 
     return SqlAlchemyStore(store_uri)
+
+
+def _get_file_store(store_uri):
+    from mlflow.store.model_registry.file_store import FileStore
+
+    if is_go_enabled():
+        FileStore = ModelRegistryStore(FileStore)
+
+    return FileStore(store_uri)
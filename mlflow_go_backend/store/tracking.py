@@ -1,55 +1,75 @@
 import json
 import logging
-from typing import Dict, Optional
+from typing import Dict, List, Optional, Tuple
 
 from mlflow.entities import (
+    Assessment,
     Experiment,
+    LoggedModel,
+    LoggedModelParameter,
+    LoggedModelStatus,
+    LoggedModelTag,
     Metric,
     Run,
     RunInfo,
     TraceInfoV2,
     ViewType,
 )
+from mlflow.entities.assessment import ExpectationValue, FeedbackValue
+from mlflow.entities.dataset_summary import _DatasetSummary
 from mlflow.entities.trace_status import TraceStatus
 from mlflow.environment_variables import MLFLOW_TRUNCATE_LONG_VALUES
 from mlflow.exceptions import MlflowException
 from mlflow.protos import databricks_pb2
 from mlflow.protos.service_pb2 import (
+    CreateAssessment,
     CreateExperiment,
+    CreateLoggedModel,
     CreateRun,
+    DeleteAssessment,
     DeleteExperiment,
+    DeleteLoggedModel,
+    DeleteLoggedModelTag,
     DeleteRun,
     DeleteTag,
     DeleteTraces,
     DeleteTraceTag,
     EndTrace,
+    FinalizeLoggedModel,
     GetExperiment,
     GetExperimentByName,
+    GetLoggedModel,
     GetMetricHistory,
     GetRun,
     GetTraceInfo,
     LogBatch,
+    LogLoggedModelParamsRequest,
     LogMetric,
     LogParam,
     RestoreExperiment,
     RestoreRun,
+    SearchDatasets,
     SearchExperiments,
+    SearchLoggedModels,
     SearchRuns,
+    SearchTraces,
+    SetLoggedModelTags,
     SetTag,
     SetTraceTag,
     StartTrace,
     TraceRequestMetadata,
     TraceTag,
+    UpdateAssessment,
     UpdateExperiment,
     UpdateRun,
 )
 from mlflow.store.entities import PagedList
-from mlflow.store.tracking import SEARCH_MAX_RESULTS_DEFAULT
+from mlflow.store.tracking import SEARCH_MAX_RESULTS_DEFAULT, SEARCH_TRACES_DEFAULT_MAX_RESULTS
 from mlflow.utils.uri import resolve_uri_if_local
 
 from mlflow_go_backend import is_go_enabled
 from mlflow_go_backend.lib import get_lib
-from mlflow_go_backend.store._service_proxy import _ServiceProxy
+from mlflow_go_backend.store._service_proxy import _check_store_uri, _ServiceProxy
 
 _logger = logging.getLogger(__name__)
 
@@ -62,6 +82,7 @@ class _TrackingStore:
             if len(args) > 1
             else kwargs.get("default_artifact_root", kwargs.get("artifact_root_uri"))
         )
+        _check_store_uri(store_uri)
         config = json.dumps(
             {
                 "log_level": logging.getLevelName(_logger.getEffectiveLevel()),
@@ -297,6 +318,44 @@ class _TrackingStore:
             entity.execution_time_ms = None
         return entity
 
+    def search_traces(
+        self,
+        experiment_ids: List[str],
+        filter_string: Optional[str] = None,
+        max_results: int = SEARCH_TRACES_DEFAULT_MAX_RESULTS,
+        order_by: Optional[List[str]] = None,
+        page_token: Optional[str] = None,
+        model_id: Optional[str] = None,
+        sql_warehouse_id: Optional[str] = None,
+    ) -> Tuple[List[TraceInfoV2], Optional[str]]:
+        # the Go store neither filters the traces by their model nor reads them from a SQL warehouse
+        if model_id is not None or sql_warehouse_id is not None:
+            return super().search_traces(
+                experiment_ids=experiment_ids,
+                filter_string=filter_string,
+                max_results=max_results,
+                order_by=order_by,
+                page_token=page_token,
+                model_id=model_id,
+                sql_warehouse_id=sql_warehouse_id,
+            )
+
+        request = SearchTraces(
+            experiment_ids=experiment_ids,
+            filter=filter_string,
+            max_results=max_results,
+            order_by=order_by,
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceSearchTraces, request)
+        traces = []
+        for trace_info in response.traces:
+            entity = TraceInfoV2.from_proto(trace_info)
+            if entity.execution_time_ms == 0:
+                entity.execution_time_ms = None
+            traces.append(entity)
+        return traces, (response.next_page_token or None)
+
     def delete_traces(
         self,
         experiment_id: str,
@@ -313,6 +372,59 @@ class _TrackingStore:
         response = self.service.call_endpoint(get_lib().TrackingServiceDeleteTraces, request)
         return response.traces_deleted
 
+    def _search_datasets(self, experiment_ids: List[str]) -> List[_DatasetSummary]:
+        request = SearchDatasets(experiment_ids=experiment_ids)
+        response = self.service.call_endpoint(get_lib().TrackingServiceSearchDatasets, request)
+        return [
+            _DatasetSummary(
+                experiment_id=summary.experiment_id,
+                name=summary.name,
+                digest=summary.digest,
+                context=summary.context if summary.HasField("context") else None,
+            )
+            for summary in response.dataset_summaries
+        ]
+
+    def create_assessment(self, assessment: Assessment) -> Assessment:
+        request = CreateAssessment(assessment=assessment.to_proto())
+        response = self.service.call_endpoint(get_lib().TrackingServiceCreateAssessment, request)
+        return Assessment.from_proto(response.assessment)
+
+    def update_assessment(
+        self,
+        trace_id: str,
+        assessment_id: str,
+        name: Optional[str] = None,
+        expectation: Optional[ExpectationValue] = None,
+        feedback: Optional[FeedbackValue] = None,
+        rationale: Optional[str] = None,
+        metadata: Optional[Dict[str, str]] = None,
+    ) -> Assessment:
+        request = UpdateAssessment()
+        request.assessment.trace_id = trace_id
+        request.assessment.assessment_id = assessment_id
+        if name is not None:
+            request.assessment.assessment_name = name
+            request.update_mask.paths.append("assessment_name")
+        if expectation is not None:
+            request.assessment.expectation.CopyFrom(expectation.to_proto())
+            request.update_mask.paths.append("expectation")
+        if feedback is not None:
+            request.assessment.feedback.CopyFrom(feedback.to_proto())
+            request.update_mask.paths.append("feedback")
+        if rationale is not None:
+            request.assessment.rationale = rationale
+            request.update_mask.paths.append("rationale")
+        if metadata is not None:
+            request.assessment.metadata.update(metadata)
+            request.update_mask.paths.append("metadata")
+        response = self.service.call_endpoint(get_lib().TrackingServiceUpdateAssessment, request)
+        return Assessment.from_proto(response.assessment)
+
+    def delete_assessment(self, trace_id: str, assessment_id: str) -> None:
+        request = DeleteAssessment(trace_id=trace_id, assessment_id=assessment_id)
+        self.service.call_endpoint(get_lib().TrackingServiceDeleteAssessment, request)
+
     def get_metric_history(self, run_id, metric_key, max_results=None, page_token=None):
         request = GetMetricHistory(
             run_id=run_id, metric_key=metric_key, max_results=max_results, page_token=page_token
@@ -320,9 +432,117 @@ class _TrackingStore:
         response = self.service.call_endpoint(get_lib().TrackingServiceGetMetricHistory, request)
         return PagedList([Metric.from_proto(metric) for metric in response.metrics], None)
 
+    def create_logged_model(
+        self,
+        experiment_id: str,
+        name: Optional[str] = None,
+        source_run_id: Optional[str] = None,
+        tags: Optional[List[LoggedModelTag]] = None,
+        params: Optional[List[LoggedModelParameter]] = None,
+        model_type: Optional[str] = None,
+    ) -> LoggedModel:
+        request = CreateLoggedModel(
+            experiment_id=experiment_id,
+            name=name,
+            source_run_id=source_run_id,
+            tags=[tag.to_proto() for tag in tags] if tags else [],
+            params=[param.to_proto() for param in params] if params else [],
+            model_type=model_type,
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceCreateLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
 
-def TrackingStore(cls):
-    return type(cls.__name__, (_TrackingStore, cls), {})
+    def finalize_logged_model(self, model_id: str, status: LoggedModelStatus) -> LoggedModel:
+        request = FinalizeLoggedModel(model_id=model_id, status=status.to_proto())
+        response = self.service.call_endpoint(get_lib().TrackingServiceFinalizeLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
+
+    def get_logged_model(self, model_id: str) -> LoggedModel:
+        request = GetLoggedModel(model_id=model_id)
+        response = self.service.call_endpoint(get_lib().TrackingServiceGetLoggedModel, request)
+        return LoggedModel.from_proto(response.model)
+
+    def delete_logged_model(self, model_id: str) -> None:
+        request = DeleteLoggedModel(model_id=model_id)
+        self.service.call_endpoint(get_lib().TrackingServiceDeleteLoggedModel, request)
+
+    def search_logged_models(
+        self,
+        experiment_ids: List[str],
+        filter_string: Optional[str] = None,
+        datasets: Optional[List[Dict[str, str]]] = None,
+        max_results: Optional[int] = None,
+        order_by: Optional[List[Dict[str, str]]] = None,
+        page_token: Optional[str] = None,
+    ) -> PagedList[LoggedModel]:
+        request = SearchLoggedModels(
+            experiment_ids=experiment_ids,
+            filter=filter_string,
+            datasets=[
+                SearchLoggedModels.Dataset(
+                    dataset_name=dataset["dataset_name"],
+                    dataset_digest=dataset.get("dataset_digest"),
+                )
+                for dataset in datasets or []
+            ],
+            max_results=max_results,
+            order_by=[
+                SearchLoggedModels.OrderBy(
+                    field_name=order["field_name"],
+                    ascending=order.get("ascending", True),
+                    dataset_name=order.get("dataset_name"),
+                    dataset_digest=order.get("dataset_digest"),
+                )
+                for order in order_by or []
+            ],
+            page_token=page_token,
+        )
+        response = self.service.call_endpoint(get_lib().TrackingServiceSearchLoggedModels, request)
+        models = [LoggedModel.from_proto(model) for model in response.models]
+        return PagedList(models, (response.next_page_token or None))
+
+    def set_logged_model_tags(self, model_id: str, tags: List[LoggedModelTag]) -> None:
+        request = SetLoggedModelTags(model_id=model_id, tags=[tag.to_proto() for tag in tags])
+        self.service.call_endpoint(get_lib().TrackingServiceSetLoggedModelTags, request)
+
+    def delete_logged_model_tag(self, model_id: str, key: str) -> None:
+        request = DeleteLoggedModelTag(model_id=model_id, tag_key=key)
+        self.service.call_endpoint(get_lib().TrackingServiceDeleteLoggedModelTag, request)
+
+    def log_logged_model_params(self, model_id: str, params: List[LoggedModelParameter]) -> None:
+        request = LogLoggedModelParamsRequest(
+            model_id=model_id, params=[param.to_proto() for param in params]
+        )
+        self.service.call_endpoint(get_lib().TrackingServiceLogLoggedModelParams, request)
+
+
+# The Go file store only knows about the experiments and the runs, the traces, assessments and
+# logged models of a FileStore are left to its own Python implementation.
+_FILE_STORE_PYTHON_METHODS = (
+    "set_trace_tag",
+    "delete_trace_tag",
+    "start_trace",
+    "end_trace",
+    "get_trace_info",
+    "search_traces",
+    "delete_traces",
+    "create_assessment",
+    "update_assessment",
+    "delete_assessment",
+    "create_logged_model",
+    "finalize_logged_model",
+    "get_logged_model",
+    "delete_logged_model",
+    "search_logged_models",
+    "set_logged_model_tags",
+    "delete_logged_model_tag",
+    "log_logged_model_params",
+)
+
+
+def TrackingStore(cls, python_methods=()):
+    methods = {name: getattr(cls, name) for name in python_methods if hasattr(cls, name)}
+    return type(cls.__name__, (_TrackingStore, cls), methods)
 
 
 def _get_sqlalchemy_store(store_uri, artifact_uri):
@@ -336,3 +556,12 @@ def _get_sqlalchemy_store(store_uri, artifact_uri):
         artifact_uri = DEFAULT_LOCAL_FILE_AND_ARTIFACT_PATH
 
     return SqlAlchemyStore(store_uri, artifact_uri)
+
+
+def _get_file_store(store_uri, artifact_uri):
+    from mlflow.store.tracking.file_store import FileStore
+
+    if is_go_enabled():
+        FileStore = TrackingStore(FileStore, _FILE_STORE_PYTHON_METHODS)
+
+    return FileStore(store_uri, artifact_uri)
@@ -0,0 +1,224 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// LocalArtifactRepository stores artifacts in a directory on the local filesystem.
+type LocalArtifactRepository struct {
+	root string
+}
+
+func NewLocalArtifactRepository(rootURI string) (*LocalArtifactRepository, error) {
+	root := rootURI
+
+	if strings.HasPrefix(rootURI, "file:") {
+		parsed, err := url.Parse(rootURI)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse artifact root %q: %w", rootURI, err)
+		}
+
+		root = parsed.Path
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve artifact root %q: %w", rootURI, err)
+	}
+
+	return &LocalArtifactRepository{
+		root: root,
+	}, nil
+}
+
+// Resolve the artifact path to a location on disk, refusing anything outside of the root.
+func (r *LocalArtifactRepository) resolve(artifactPath string) (string, *contract.Error) {
+	location := filepath.Join(r.root, filepath.FromSlash(artifactPath))
+
+	if location != r.root && !strings.HasPrefix(location, r.root+string(filepath.Separator)) {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid artifact path: %s", artifactPath),
+		)
+	}
+
+	return location, nil
+}
+
+func (r *LocalArtifactRepository) List(
+	_ context.Context, artifactPath string,
+) ([]*entities.FileInfo, *contract.Error) {
+	location, err := r.resolve(artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Listing something that isn't a directory yields nothing, like the Python store.
+	if info, osErr := os.Stat(location); osErr != nil || !info.IsDir() {
+		return []*entities.FileInfo{}, nil
+	}
+
+	entries, osErr := os.ReadDir(location)
+	if osErr != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+			osErr,
+		)
+	}
+
+	fileInfos := make([]*entities.FileInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		if location == r.root && entry.Name() == multipartUploadsDir {
+			continue
+		}
+
+		fileInfo := &entities.FileInfo{
+			Path:  path.Join(artifactPath, entry.Name()),
+			IsDir: entry.IsDir(),
+		}
+
+		if !entry.IsDir() {
+			info, err := entry.Info()
+			if err != nil {
+				return nil, contract.NewErrorWith(
+					protos.ErrorCode_INTERNAL_ERROR,
+					fmt.Sprintf("failed to stat artifact %q", fileInfo.Path),
+					err,
+				)
+			}
+
+			fileInfo.FileSize = info.Size()
+		}
+
+		fileInfos = append(fileInfos, fileInfo)
+	}
+
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].Path < fileInfos[j].Path
+	})
+
+	return fileInfos, nil
+}
+
+func (r *LocalArtifactRepository) Open(_ context.Context, artifactPath string) (io.ReadCloser, *contract.Error) {
+	location, err := r.resolve(artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	file, osErr := os.Open(location)
+	if osErr != nil {
+		if errors.Is(osErr, fs.ErrNotExist) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Artifact %q does not exist", artifactPath),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to open artifact %q", artifactPath),
+			osErr,
+		)
+	}
+
+	if info, osErr := file.Stat(); osErr == nil && info.IsDir() {
+		file.Close()
+
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Artifact %q is a directory", artifactPath),
+		)
+	}
+
+	return file, nil
+}
+
+// Write streams the content into the artifact. It is first written to a temporary file
+// next to its destination so that readers never observe a partially written artifact.
+func (r *LocalArtifactRepository) Write(_ context.Context, artifactPath string, content io.Reader) *contract.Error {
+	location, err := r.resolve(artifactPath)
+	if err != nil {
+		return err
+	}
+
+	if location == r.root {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Artifact path must not be empty")
+	}
+
+	//nolint:mnd
+	if err := os.MkdirAll(filepath.Dir(location), 0o755); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create directory for artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	file, osErr := os.CreateTemp(filepath.Dir(location), "."+filepath.Base(location)+".*.tmp")
+	if osErr != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create artifact %q", artifactPath),
+			osErr,
+		)
+	}
+
+	tempName := file.Name()
+
+	_, copyErr := io.Copy(file, content)
+	closeErr := file.Close()
+
+	if copyErr == nil {
+		copyErr = closeErr
+	}
+
+	if copyErr == nil {
+		copyErr = os.Rename(tempName, location)
+	}
+
+	if copyErr != nil {
+		os.Remove(tempName)
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			copyErr,
+		)
+	}
+
+	return nil
+}
+
+func (r *LocalArtifactRepository) Delete(_ context.Context, artifactPath string) *contract.Error {
+	location, err := r.resolve(artifactPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(location); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package local_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func TestLocalArtifactRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repository, err := local.NewLocalArtifactRepository("file://" + t.TempDir())
+	require.NoError(t, err)
+
+	require.Nil(t, repository.Write(ctx, "models/model.pkl", strings.NewReader("model")))
+	require.Nil(t, repository.Write(ctx, "models/MLmodel", strings.NewReader("flavors: {}")))
+
+	files, cErr := repository.List(ctx, "")
+	require.Nil(t, cErr)
+	require.Len(t, files, 1)
+	assert.Equal(t, "models", files[0].Path)
+	assert.True(t, files[0].IsDir)
+
+	files, cErr = repository.List(ctx, "models")
+	require.Nil(t, cErr)
+	require.Len(t, files, 2)
+	assert.Equal(t, "models/MLmodel", files[0].Path)
+	assert.Equal(t, "models/model.pkl", files[1].Path)
+	assert.Equal(t, int64(5), files[1].FileSize)
+
+	reader, cErr := repository.Open(ctx, "models/model.pkl")
+	require.Nil(t, cErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "model", string(content))
+
+	require.Nil(t, repository.Delete(ctx, "models"))
+
+	_, cErr = repository.Open(ctx, "models/model.pkl")
+	require.NotNil(t, cErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(cErr.Code))
+
+	_, cErr = repository.Open(ctx, "../outside")
+	require.NotNil(t, cErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(cErr.Code))
+}
+
+func TestLocalArtifactRepositoryMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repository, err := local.NewLocalArtifactRepository(t.TempDir())
+	require.NoError(t, err)
+
+	upload, cErr := repository.CreateMultipartUpload(ctx, "checkpoints/model.bin", 2)
+	require.Nil(t, cErr)
+	require.Len(t, upload.Credentials, 2)
+
+	firstETag, cErr := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("first "))
+	require.Nil(t, cErr)
+
+	parts := []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: firstETag},
+		{PartNumber: 2, ETag: `"unknown"`},
+	}
+
+	// The second part is missing, the upload is resumed by only sending that one.
+	cErr = repository.CompleteMultipartUpload(ctx, "checkpoints/model.bin", upload.UploadID, parts)
+	require.NotNil(t, cErr)
+	assert.Contains(t, cErr.Message, "parts [2]")
+
+	parts[1].ETag, cErr = repository.UploadPart(ctx, upload.UploadID, 2, strings.NewReader("second"))
+	require.Nil(t, cErr)
+
+	require.Nil(t, repository.CompleteMultipartUpload(ctx, "checkpoints/model.bin", upload.UploadID, parts))
+
+	reader, cErr := repository.Open(ctx, "checkpoints/model.bin")
+	require.Nil(t, cErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "first second", string(content))
+
+	// Uploads in progress don't show up as artifacts.
+	files, cErr := repository.List(ctx, "")
+	require.Nil(t, cErr)
+	require.Len(t, files, 1)
+	assert.Equal(t, "checkpoints", files[0].Path)
+
+	abandoned, cErr := repository.CreateMultipartUpload(ctx, "abandoned.bin", 1)
+	require.Nil(t, cErr)
+
+	require.Nil(t, repository.AbortExpiredMultipartUploads(ctx, time.Now().Add(time.Hour)))
+
+	_, cErr = repository.UploadPart(ctx, abandoned.UploadID, 1, strings.NewReader("late"))
+	require.NotNil(t, cErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(cErr.Code))
+}
+
+func TestLocalArtifactRepositoryMultipartUploadParts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repository, err := local.NewLocalArtifactRepository(t.TempDir())
+	require.NoError(t, err)
+
+	upload, cErr := repository.CreateMultipartUpload(ctx, "model.bin", 3)
+	require.Nil(t, cErr)
+
+	uploadPath, cErr := repository.MultipartUploadPath(ctx, upload.UploadID)
+	require.Nil(t, cErr)
+	assert.Equal(t, "model.bin", uploadPath)
+
+	etags := make([]string, 0, 3)
+
+	for partNumber, content := range []string{"a", "b", "c"} {
+		etag, cErr := repository.UploadPart(ctx, upload.UploadID, int64(partNumber+1), strings.NewReader(content))
+		require.Nil(t, cErr)
+
+		etags = append(etags, etag)
+	}
+
+	// Sending a part again replaces it, the ETag of the previous one doesn't match anymore.
+	replacedETag, cErr := repository.UploadPart(ctx, upload.UploadID, 2, strings.NewReader("B"))
+	require.Nil(t, cErr)
+
+	_, cErr = repository.UploadPart(ctx, upload.UploadID, 4, strings.NewReader("d"))
+	require.NotNil(t, cErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(cErr.Code))
+
+	for _, testCase := range []struct {
+		name     string
+		parts    []*entities.MultipartUploadPart
+		expected string
+	}{
+		{
+			name:     "NoParts",
+			expected: "parts []",
+		},
+		{
+			name: "ReplacedPart",
+			parts: []*entities.MultipartUploadPart{
+				{PartNumber: 1, ETag: etags[0]}, {PartNumber: 2, ETag: etags[1]}, {PartNumber: 3, ETag: etags[2]},
+			},
+			expected: "parts [2]",
+		},
+		{
+			name: "MissingPart",
+			parts: []*entities.MultipartUploadPart{
+				{PartNumber: 1, ETag: etags[0]}, {PartNumber: 4, ETag: etags[2]},
+			},
+			expected: "parts [4]",
+		},
+		{
+			name: "DuplicatePart",
+			parts: []*entities.MultipartUploadPart{
+				{PartNumber: 1, ETag: etags[0]}, {PartNumber: 1, ETag: etags[0]}, {PartNumber: 2, ETag: replacedETag},
+			},
+			expected: "part 1 comes after part 1",
+		},
+		{
+			name: "UnorderedParts",
+			parts: []*entities.MultipartUploadPart{
+				{PartNumber: 2, ETag: replacedETag}, {PartNumber: 1, ETag: etags[0]},
+			},
+			expected: "part 1 comes after part 2",
+		},
+	} {
+		cErr := repository.CompleteMultipartUpload(ctx, "model.bin", upload.UploadID, testCase.parts)
+		require.NotNil(t, cErr, testCase.name)
+		assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(cErr.Code), testCase.name)
+		assert.Contains(t, cErr.Message, testCase.expected, testCase.name)
+	}
+
+	// A failed completion keeps the upload, it is completed once the parts are listed properly.
+	require.Nil(t, repository.CompleteMultipartUpload(ctx, "model.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etags[0]}, {PartNumber: 2, ETag: replacedETag}, {PartNumber: 3, ETag: etags[2]},
+	}))
+
+	reader, cErr := repository.Open(ctx, "model.bin")
+	require.Nil(t, cErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "aBc", string(content))
+}
+
+func TestLocalArtifactRepositoryMultipartUploadExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repository, err := local.NewLocalArtifactRepository(t.TempDir())
+	require.NoError(t, err)
+
+	upload, cErr := repository.CreateMultipartUpload(ctx, "model.bin", 1)
+	require.Nil(t, cErr)
+
+	// The upload started after the expiry date is kept.
+	require.Nil(t, repository.AbortExpiredMultipartUploads(ctx, time.Now().Add(-time.Hour)))
+
+	etag, cErr := repository.UploadPart(ctx, upload.UploadID, 1, strings.NewReader("model"))
+	require.Nil(t, cErr)
+
+	require.Nil(t, repository.AbortExpiredMultipartUploads(ctx, time.Now().Add(time.Hour)))
+
+	cErr = repository.CompleteMultipartUpload(ctx, "model.bin", upload.UploadID, []*entities.MultipartUploadPart{
+		{PartNumber: 1, ETag: etag},
+	})
+	require.NotNil(t, cErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, protos.ErrorCode(cErr.Code))
+}
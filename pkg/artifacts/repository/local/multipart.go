@@ -0,0 +1,401 @@
+package local
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// The parts of unfinished uploads are kept in this directory below the root,
+// so an upload survives restarts and a failed part can be sent again.
+const multipartUploadsDir = ".mlflow-mpu"
+
+const uploadMetadataFile = "upload.json"
+
+type multipartUpload struct {
+	Path      string `json:"path"`
+	NumParts  int64  `json:"num_parts"`
+	StartTime int64  `json:"start_time"`
+}
+
+func (r *LocalArtifactRepository) uploadDir(uploadID string) (string, *contract.Error) {
+	// upload ids are generated by us, anything else can't be trusted as part of a path.
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\.`) {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid upload id: %s", uploadID),
+		)
+	}
+
+	return filepath.Join(r.root, multipartUploadsDir, uploadID), nil
+}
+
+func partFileName(partNumber int64) string {
+	return fmt.Sprintf("part-%05d", partNumber)
+}
+
+func (r *LocalArtifactRepository) getMultipartUpload(uploadID string) (*multipartUpload, string, *contract.Error) {
+	uploadDir, err := r.uploadDir(uploadID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	content, osErr := os.ReadFile(filepath.Join(uploadDir, uploadMetadataFile))
+	if osErr != nil {
+		if errors.Is(osErr, fs.ErrNotExist) {
+			return nil, "", contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Multipart upload %s does not exist", uploadID),
+			)
+		}
+
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to read multipart upload %s", uploadID),
+			osErr,
+		)
+	}
+
+	var upload multipartUpload
+	if err := json.Unmarshal(content, &upload); err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to read multipart upload %s", uploadID),
+			err,
+		)
+	}
+
+	return &upload, uploadDir, nil
+}
+
+// CreateMultipartUpload registers the upload. The credentials point to the UploadPart endpoint
+// of this server, relative to the API root, as there is no storage to send the parts to directly.
+func (r *LocalArtifactRepository) CreateMultipartUpload(
+	_ context.Context, artifactPath string, numParts int64,
+) (*entities.MultipartUpload, *contract.Error) {
+	if _, err := r.resolve(artifactPath); err != nil {
+		return nil, err
+	}
+
+	uploadID := utils.NewUUID()
+
+	uploadDir, err := r.uploadDir(uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, _ := json.Marshal(multipartUpload{ //nolint:errchkjson
+		Path:      artifactPath,
+		NumParts:  numParts,
+		StartTime: time.Now().UnixMilli(),
+	})
+
+	//nolint:mnd
+	if err := os.MkdirAll(uploadDir, 0o755); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create multipart upload for %q", artifactPath),
+			err,
+		)
+	}
+
+	//nolint:mnd
+	if err := os.WriteFile(filepath.Join(uploadDir, uploadMetadataFile), content, 0o644); err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create multipart upload for %q", artifactPath),
+			err,
+		)
+	}
+
+	credentials := make([]*entities.MultipartUploadCredential, 0, numParts)
+	for partNumber := int64(1); partNumber <= numParts; partNumber++ {
+		credentials = append(credentials, &entities.MultipartUploadCredential{
+			URL:        fmt.Sprintf("mlflow-artifacts/mpu/parts/%s/%d", uploadID, partNumber),
+			PartNumber: partNumber,
+			Headers:    map[string]string{},
+		})
+	}
+
+	return &entities.MultipartUpload{
+		UploadID:    uploadID,
+		Credentials: credentials,
+	}, nil
+}
+
+func (r *LocalArtifactRepository) MultipartUploadPath(_ context.Context, uploadID string) (string, *contract.Error) {
+	upload, _, err := r.getMultipartUpload(uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	return upload.Path, nil
+}
+
+// UploadPart stores a single part and returns its ETag, sending a part again replaces it.
+func (r *LocalArtifactRepository) UploadPart(
+	_ context.Context, uploadID string, partNumber int64, content io.Reader,
+) (string, *contract.Error) {
+	upload, uploadDir, err := r.getMultipartUpload(uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	if partNumber < 1 || partNumber > upload.NumParts {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid part number %d, upload %s has %d parts", partNumber, uploadID, upload.NumParts),
+		)
+	}
+
+	file, osErr := os.CreateTemp(uploadDir, partFileName(partNumber)+".*.tmp")
+	if osErr != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write part %d of upload %s", partNumber, uploadID),
+			osErr,
+		)
+	}
+
+	hash := md5.New() //nolint:gosec
+	_, copyErr := io.Copy(io.MultiWriter(file, hash), content)
+	closeErr := file.Close()
+
+	if copyErr == nil {
+		copyErr = closeErr
+	}
+
+	etag := hex.EncodeToString(hash.Sum(nil))
+
+	if copyErr == nil {
+		// The ETag is part of the name, that way a part and its ETag are replaced at once.
+		copyErr = r.replacePart(uploadDir, partNumber, file.Name(), etag)
+	}
+
+	if copyErr != nil {
+		os.Remove(file.Name())
+
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write part %d of upload %s", partNumber, uploadID),
+			copyErr,
+		)
+	}
+
+	return strconv.Quote(etag), nil
+}
+
+func (r *LocalArtifactRepository) replacePart(uploadDir string, partNumber int64, tempName, etag string) error {
+	previous, err := filepath.Glob(filepath.Join(uploadDir, partFileName(partNumber)+".*.part"))
+	if err != nil {
+		return fmt.Errorf("failed to look up previous part: %w", err)
+	}
+
+	if err := os.Rename(tempName, filepath.Join(uploadDir, partFileName(partNumber)+"."+etag+".part")); err != nil {
+		return fmt.Errorf("failed to store part: %w", err)
+	}
+
+	for _, name := range previous {
+		if !strings.HasSuffix(name, "."+etag+".part") {
+			os.Remove(name)
+		}
+	}
+
+	return nil
+}
+
+// Get the ETag of every part that has been uploaded so far, by part number.
+func (r *LocalArtifactRepository) uploadedParts(uploadDir string) (map[int64]string, error) {
+	names, err := filepath.Glob(filepath.Join(uploadDir, "part-*.part"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parts: %w", err)
+	}
+
+	parts := make(map[int64]string, len(names))
+
+	for _, name := range names {
+		segments := strings.Split(filepath.Base(name), ".")
+		//nolint:mnd
+		if len(segments) != 3 {
+			continue
+		}
+
+		partNumber, err := strconv.ParseInt(strings.TrimPrefix(segments[0], "part-"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		parts[partNumber] = segments[1]
+	}
+
+	return parts, nil
+}
+
+func copyFile(writer io.Writer, name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(writer, file); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", name, err)
+	}
+
+	return nil
+}
+
+//nolint:funlen,cyclop
+func (r *LocalArtifactRepository) CompleteMultipartUpload(
+	ctx context.Context, artifactPath, uploadID string, parts []*entities.MultipartUploadPart,
+) *contract.Error {
+	upload, uploadDir, err := r.getMultipartUpload(uploadID)
+	if err != nil {
+		return err
+	}
+
+	if upload.Path != artifactPath {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Multipart upload %s is not an upload of %q", uploadID, artifactPath),
+		)
+	}
+
+	uploadedParts, osErr := r.uploadedParts(uploadDir)
+	if osErr != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to complete multipart upload", osErr)
+	}
+
+	// Like S3, the parts are listed in order, a part listed twice would otherwise be written twice.
+	for i := 1; i < len(parts); i++ {
+		if parts[i].PartNumber <= parts[i-1].PartNumber {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Multipart upload %s can't be completed, the part numbers must be in increasing order, "+
+						"part %d comes after part %d",
+					uploadID, parts[i].PartNumber, parts[i-1].PartNumber,
+				),
+			)
+		}
+	}
+
+	// Report every part that still has to be sent, so the client can resume the upload with those.
+	missing := make([]string, 0)
+
+	for _, part := range parts {
+		if etag, ok := uploadedParts[part.PartNumber]; !ok || etag != strings.Trim(part.ETag, `"`) {
+			missing = append(missing, strconv.FormatInt(part.PartNumber, 10))
+		}
+	}
+
+	if len(parts) == 0 || len(missing) > 0 {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Multipart upload %s can't be completed, parts [%s] are missing or don't match their ETag",
+				uploadID, strings.Join(missing, ", "),
+			),
+		)
+	}
+
+	// The parts are opened one at a time, an upload can have up to 10000 of them.
+	reader, writer := io.Pipe()
+
+	go func() {
+		for _, part := range parts {
+			if err := copyFile(writer, filepath.Join(
+				uploadDir, partFileName(part.PartNumber)+"."+uploadedParts[part.PartNumber]+".part",
+			)); err != nil {
+				writer.CloseWithError(err)
+
+				return
+			}
+		}
+
+		writer.Close()
+	}()
+
+	if err := r.Write(ctx, artifactPath, reader); err != nil {
+		reader.Close()
+
+		return err
+	}
+
+	if err := os.RemoveAll(uploadDir); err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to clean up multipart upload", err)
+	}
+
+	return nil
+}
+
+func (r *LocalArtifactRepository) AbortMultipartUpload(
+	_ context.Context, artifactPath, uploadID string,
+) *contract.Error {
+	upload, uploadDir, err := r.getMultipartUpload(uploadID)
+	if err != nil {
+		return err
+	}
+
+	if upload.Path != artifactPath {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Multipart upload %s is not an upload of %q", uploadID, artifactPath),
+		)
+	}
+
+	if err := os.RemoveAll(uploadDir); err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to abort multipart upload", err)
+	}
+
+	return nil
+}
+
+func (r *LocalArtifactRepository) AbortExpiredMultipartUploads(
+	_ context.Context, startedBefore time.Time,
+) *contract.Error {
+	entries, err := os.ReadDir(filepath.Join(r.root, multipartUploadsDir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to list multipart uploads", err)
+	}
+
+	for _, entry := range entries {
+		upload, uploadDir, err := r.getMultipartUpload(entry.Name())
+		if err != nil {
+			// Without metadata the upload can't be used anymore, only its age is known.
+			info, infoErr := entry.Info()
+			if infoErr != nil || !info.ModTime().Before(startedBefore) {
+				continue
+			}
+
+			uploadDir = filepath.Join(r.root, multipartUploadsDir, entry.Name())
+		} else if upload.StartTime >= startedBefore.UnixMilli() {
+			continue
+		}
+
+		if err := os.RemoveAll(uploadDir); err != nil {
+			return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to abort multipart upload", err)
+		}
+	}
+
+	return nil
+}
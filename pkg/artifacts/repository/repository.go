@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/local"
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/s3"
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// ArtifactRepository stores the artifacts below a root URI,
+// every artifact path is relative to that root.
+type ArtifactRepository interface {
+	List(ctx context.Context, artifactPath string) ([]*entities.FileInfo, *contract.Error)
+	Open(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error)
+	Write(ctx context.Context, artifactPath string, content io.Reader) *contract.Error
+	Delete(ctx context.Context, artifactPath string) *contract.Error
+}
+
+// MultipartArtifactRepository is implemented by the repositories that accept an artifact
+// uploaded in separate parts, which are put together once the upload is completed.
+type MultipartArtifactRepository interface {
+	ArtifactRepository
+	CreateMultipartUpload(
+		ctx context.Context, artifactPath string, numParts int64,
+	) (*entities.MultipartUpload, *contract.Error)
+	CompleteMultipartUpload(
+		ctx context.Context, artifactPath, uploadID string, parts []*entities.MultipartUploadPart,
+	) *contract.Error
+	AbortMultipartUpload(ctx context.Context, artifactPath, uploadID string) *contract.Error
+	// AbortExpiredMultipartUploads cleans up the uploads this repository started before the given time,
+	// which were abandoned by their clients.
+	AbortExpiredMultipartUploads(ctx context.Context, startedBefore time.Time) *contract.Error
+}
+
+// PartUploadArtifactRepository is a MultipartArtifactRepository without a storage the clients
+// can send the parts to, instead the parts are uploaded through this server.
+type PartUploadArtifactRepository interface {
+	MultipartArtifactRepository
+	UploadPart(ctx context.Context, uploadID string, partNumber int64, content io.Reader) (string, *contract.Error)
+	// MultipartUploadPath returns the artifact path of an upload, its parts are authorized against it.
+	MultipartUploadPath(ctx context.Context, uploadID string) (string, *contract.Error)
+}
+
+const mlflowArtifactsScheme = "mlflow-artifacts"
+
+var (
+	ErrArtifactsNotServed = errors.New("artifacts aren't served, no artifacts destination is configured")
+	ErrUnsupportedScheme  = errors.New("unsupported artifact URI scheme")
+)
+
+// NewArtifactRepository returns the repository for the scheme of artifactURI.
+// `mlflow-artifacts:/` URIs are resolved against the configured artifacts destination.
+//
+//nolint:ireturn
+func NewArtifactRepository(
+	ctx context.Context, cfg *config.Config, artifactURI string,
+) (ArtifactRepository, error) {
+	parsed, err := url.Parse(artifactURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact URI %q: %w", artifactURI, err)
+	}
+
+	switch parsed.Scheme {
+	case "", "file":
+		return local.NewLocalArtifactRepository(artifactURI)
+	case "s3":
+		return s3.NewS3ArtifactRepository(ctx, artifactURI)
+	case mlflowArtifactsScheme:
+		destination, err := ResolveMlflowArtifactsURI(cfg, artifactURI)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewArtifactRepository(ctx, cfg, destination)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, artifactURI)
+	}
+}
+
+// ResolveMlflowArtifactsURI maps a `mlflow-artifacts:/` URI onto the artifacts destination.
+func ResolveMlflowArtifactsURI(cfg *config.Config, artifactURI string) (string, error) {
+	parsed, err := url.Parse(artifactURI)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse artifact URI %q: %w", artifactURI, err)
+	}
+
+	if cfg.ArtifactsDestination == "" {
+		return "", ErrArtifactsNotServed
+	}
+
+	destination, err := url.Parse(cfg.ArtifactsDestination)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse artifacts destination %q: %w", cfg.ArtifactsDestination, err)
+	}
+
+	if destination.Scheme == mlflowArtifactsScheme {
+		return "", fmt.Errorf("%w: artifacts destination can't be %s", ErrUnsupportedScheme, cfg.ArtifactsDestination)
+	}
+
+	resolved, err := utils.AppendToURIPath(cfg.ArtifactsDestination, parsed.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve artifact URI %q: %w", artifactURI, err)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,401 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const (
+	defaultRegion = "us-east-1"
+	// DeleteObjects accepts at most 1000 keys per request.
+	deleteBatchSize = 1000
+)
+
+var errInvalidS3URI = errors.New("invalid S3 URI")
+
+// S3ArtifactRepository stores artifacts in an S3 bucket below a key prefix.
+// Like the Python store, MLFLOW_S3_ENDPOINT_URL points it to any S3 compatible storage.
+type S3ArtifactRepository struct {
+	client *s3.Client
+	bucket string
+	prefix string
+
+	// uploads are the multipart uploads started by this repository and not completed or aborted yet,
+	// keyed by their ID. The other uploads of the bucket are left to its lifecycle rules.
+	uploads      map[string]startedUpload
+	uploadsMutex sync.Mutex
+}
+
+type startedUpload struct {
+	key     string
+	started time.Time
+}
+
+func NewS3ArtifactRepository(
+	ctx context.Context, artifactURI string, optFns ...func(*s3.Options),
+) (*S3ArtifactRepository, error) {
+	parsed, err := url.Parse(artifactURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse S3 URI %q: %w", artifactURI, err)
+	}
+
+	if parsed.Scheme != "s3" || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidS3URI, artifactURI)
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+
+	if awsConfig.Region == "" {
+		awsConfig.Region = defaultRegion
+	}
+
+	options := make([]func(*s3.Options), 0, len(optFns)+1)
+
+	if endpoint := os.Getenv("MLFLOW_S3_ENDPOINT_URL"); endpoint != "" {
+		options = append(options, func(o *s3.Options) {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		})
+	}
+
+	options = append(options, optFns...)
+
+	return &S3ArtifactRepository{
+		client:  s3.NewFromConfig(awsConfig, options...),
+		bucket:  parsed.Host,
+		prefix:  strings.Trim(parsed.Path, "/"),
+		uploads: map[string]startedUpload{},
+	}, nil
+}
+
+func (r *S3ArtifactRepository) key(artifactPath string) string {
+	return strings.TrimPrefix(path.Join(r.prefix, artifactPath), "/")
+}
+
+func (r *S3ArtifactRepository) relativePath(key string) string {
+	if r.prefix == "" {
+		return key
+	}
+
+	return strings.TrimPrefix(key, r.prefix+"/")
+}
+
+func (r *S3ArtifactRepository) List(
+	ctx context.Context, artifactPath string,
+) ([]*entities.FileInfo, *contract.Error) {
+	prefix := r.key(artifactPath)
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	fileInfos := make([]*entities.FileInfo, 0)
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(r.bucket),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+				err,
+			)
+		}
+
+		for _, commonPrefix := range page.CommonPrefixes {
+			fileInfos = append(fileInfos, &entities.FileInfo{
+				Path:  strings.TrimSuffix(r.relativePath(aws.ToString(commonPrefix.Prefix)), "/"),
+				IsDir: true,
+			})
+		}
+
+		for _, object := range page.Contents {
+			// Some tools create empty objects to represent directories.
+			if aws.ToString(object.Key) == prefix {
+				continue
+			}
+
+			fileInfos = append(fileInfos, &entities.FileInfo{
+				Path:     r.relativePath(aws.ToString(object.Key)),
+				FileSize: aws.ToInt64(object.Size),
+			})
+		}
+	}
+
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].Path < fileInfos[j].Path
+	})
+
+	return fileInfos, nil
+}
+
+func (r *S3ArtifactRepository) Open(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error) {
+	output, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key(artifactPath)),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Artifact %q does not exist", artifactPath),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to open artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return output.Body, nil
+}
+
+// Write streams the content to S3, switching to a multipart upload for large artifacts.
+func (r *S3ArtifactRepository) Write(ctx context.Context, artifactPath string, content io.Reader) *contract.Error {
+	if strings.Trim(artifactPath, "/") == "" {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Artifact path must not be empty")
+	}
+
+	if _, err := manager.NewUploader(r.client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(r.key(artifactPath)),
+		Body:   content,
+	}); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to write artifact %q", artifactPath),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Delete removes the artifact and, when it is a directory, everything below it.
+func (r *S3ArtifactRepository) Delete(ctx context.Context, artifactPath string) *contract.Error {
+	key := r.key(artifactPath)
+
+	objects := []types.ObjectIdentifier{{Key: aws.String(key)}}
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucket),
+		Prefix: aws.String(key + "/"),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to list artifacts in %q", artifactPath),
+				err,
+			)
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+		}
+	}
+
+	for batch := range slices.Chunk(objects, deleteBatchSize) {
+		if _, err := r.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(r.bucket),
+			Delete: &types.Delete{Objects: batch, Quiet: aws.Bool(true)},
+		}); err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to delete artifact %q", artifactPath),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload starts the upload and returns a presigned URL for every part,
+// the parts are sent to S3 directly by the client.
+func (r *S3ArtifactRepository) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, numParts int64,
+) (*entities.MultipartUpload, *contract.Error) {
+	key := r.key(artifactPath)
+
+	output, err := r.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create multipart upload for %q", artifactPath),
+			err,
+		)
+	}
+
+	r.trackUpload(aws.ToString(output.UploadId), key)
+
+	presignClient := s3.NewPresignClient(r.client)
+	credentials := make([]*entities.MultipartUploadCredential, 0, numParts)
+
+	// part numbers start at 1.
+	for partNumber := int64(1); partNumber <= numParts; partNumber++ {
+		request, err := presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(r.bucket),
+			Key:        aws.String(key),
+			UploadId:   output.UploadId,
+			PartNumber: aws.Int32(int32(partNumber)),
+		})
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to presign part %d of %q", partNumber, artifactPath),
+				err,
+			)
+		}
+
+		credentials = append(credentials, &entities.MultipartUploadCredential{
+			URL:        request.URL,
+			PartNumber: partNumber,
+			Headers:    map[string]string{},
+		})
+	}
+
+	return &entities.MultipartUpload{
+		UploadID:    aws.ToString(output.UploadId),
+		Credentials: credentials,
+	}, nil
+}
+
+func (r *S3ArtifactRepository) CompleteMultipartUpload(
+	ctx context.Context, artifactPath, uploadID string, parts []*entities.MultipartUploadPart,
+) *contract.Error {
+	completedParts := make([]types.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completedParts = append(completedParts, types.CompletedPart{
+			PartNumber: aws.Int32(int32(part.PartNumber)),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	if _, err := r.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucket),
+		Key:             aws.String(r.key(artifactPath)),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completedParts},
+	}); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to complete multipart upload %q of %q", uploadID, artifactPath),
+			err,
+		)
+	}
+
+	r.untrackUpload(uploadID)
+
+	return nil
+}
+
+func (r *S3ArtifactRepository) AbortMultipartUpload(
+	ctx context.Context, artifactPath, uploadID string,
+) *contract.Error {
+	if _, err := r.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(r.bucket),
+		Key:      aws.String(r.key(artifactPath)),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to abort multipart upload %q of %q", uploadID, artifactPath),
+			err,
+		)
+	}
+
+	r.untrackUpload(uploadID)
+
+	return nil
+}
+
+func (r *S3ArtifactRepository) trackUpload(uploadID, key string) {
+	r.uploadsMutex.Lock()
+	defer r.uploadsMutex.Unlock()
+
+	r.uploads[uploadID] = startedUpload{key: key, started: time.Now()}
+}
+
+func (r *S3ArtifactRepository) untrackUpload(uploadID string) {
+	r.uploadsMutex.Lock()
+	defer r.uploadsMutex.Unlock()
+
+	delete(r.uploads, uploadID)
+}
+
+// AbortExpiredMultipartUploads only aborts the uploads started by this repository, the bucket may hold
+// the uploads of other servers and clients. The uploads of a previous run of the server are not known either,
+// the lifecycle rules of the bucket clean them up.
+func (r *S3ArtifactRepository) AbortExpiredMultipartUploads(
+	ctx context.Context, startedBefore time.Time,
+) *contract.Error {
+	r.uploadsMutex.Lock()
+
+	expired := map[string]string{}
+
+	for uploadID, upload := range r.uploads {
+		if upload.started.Before(startedBefore) {
+			expired[uploadID] = upload.key
+		}
+	}
+
+	r.uploadsMutex.Unlock()
+
+	for uploadID, key := range expired {
+		_, err := r.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(r.bucket),
+			Key:      aws.String(key),
+			UploadId: aws.String(uploadID),
+		})
+
+		// The upload is gone when another server completed or aborted it.
+		var noSuchUpload *types.NoSuchUpload
+		if err != nil && !errors.As(err, &noSuchUpload) {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to abort multipart upload %q", uploadID),
+				err,
+			)
+		}
+
+		r.untrackUpload(uploadID)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository/s3"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+const bucket = "mlflow"
+
+// Run the repositories against an in-memory S3 compatible server, like a local MinIO would.
+func newTestServer(t *testing.T) string {
+	t.Helper()
+
+	backend := s3mem.New()
+	require.NoError(t, backend.CreateBucket(bucket))
+
+	server := httptest.NewServer(gofakes3.New(backend).Server())
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func newTestRepositoryOn(t *testing.T, serverURL string) *s3.S3ArtifactRepository {
+	t.Helper()
+
+	repository, err := s3.NewS3ArtifactRepository(
+		context.Background(),
+		"s3://"+bucket+"/experiments/1",
+		func(o *awss3.Options) {
+			o.BaseEndpoint = aws.String(serverURL)
+			o.UsePathStyle = true
+			o.Region = "us-east-1"
+			o.Credentials = credentials.NewStaticCredentialsProvider("key", "secret", "")
+		},
+	)
+	require.NoError(t, err)
+
+	return repository
+}
+
+func newTestRepository(t *testing.T) *s3.S3ArtifactRepository {
+	t.Helper()
+
+	return newTestRepositoryOn(t, newTestServer(t))
+}
+
+func TestS3ArtifactRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repository := newTestRepository(t)
+
+	require.Nil(t, repository.Write(ctx, "model/model.pkl", strings.NewReader("model")))
+	require.Nil(t, repository.Write(ctx, "model/MLmodel", strings.NewReader("flavors: {}")))
+	require.Nil(t, repository.Write(ctx, "metrics.json", strings.NewReader("{}")))
+
+	files, cErr := repository.List(ctx, "")
+	require.Nil(t, cErr)
+	assert.Equal(t, []*entities.FileInfo{
+		{Path: "metrics.json", FileSize: 2},
+		{Path: "model", IsDir: true},
+	}, files)
+
+	files, cErr = repository.List(ctx, "model")
+	require.Nil(t, cErr)
+	assert.Equal(t, []*entities.FileInfo{
+		{Path: "model/MLmodel", FileSize: 11},
+		{Path: "model/model.pkl", FileSize: 5},
+	}, files)
+
+	reader, cErr := repository.Open(ctx, "model/model.pkl")
+	require.Nil(t, cErr)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "model", string(content))
+
+	require.Nil(t, repository.Delete(ctx, "model"))
+
+	files, cErr = repository.List(ctx, "")
+	require.Nil(t, cErr)
+	assert.Equal(t, []*entities.FileInfo{{Path: "metrics.json", FileSize: 2}}, files)
+
+	_, cErr = repository.Open(ctx, "model/model.pkl")
+	require.NotNil(t, cErr)
+}
+
+func TestS3ArtifactRepositoryMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repository := newTestRepository(t)
+
+	upload, cErr := repository.CreateMultipartUpload(ctx, "checkpoint.bin", 2)
+	require.Nil(t, cErr)
+	require.Len(t, upload.Credentials, 2)
+
+	// S3 requires every part but the last one to be at least 5MiB.
+	contents := [][]byte{bytes.Repeat([]byte("a"), 5*1024*1024), []byte("b")}
+	parts := make([]*entities.MultipartUploadPart, 0, len(contents))
+
+	for idx, credential := range upload.Credentials {
+		request, err := http.NewRequestWithContext(
+			ctx, http.MethodPut, credential.URL, bytes.NewReader(contents[idx]),
+		)
+		require.NoError(t, err)
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		parts = append(parts, &entities.MultipartUploadPart{
+			PartNumber: credential.PartNumber,
+			ETag:       response.Header.Get("ETag"),
+		})
+	}
+
+	require.Nil(t, repository.CompleteMultipartUpload(ctx, "checkpoint.bin", upload.UploadID, parts))
+
+	files, cErr := repository.List(ctx, "")
+	require.Nil(t, cErr)
+	assert.Equal(t, []*entities.FileInfo{{Path: "checkpoint.bin", FileSize: 5*1024*1024 + 1}}, files)
+
+	upload, cErr = repository.CreateMultipartUpload(ctx, "aborted.bin", 1)
+	require.Nil(t, cErr)
+	require.Nil(t, repository.AbortMultipartUpload(ctx, "aborted.bin", upload.UploadID))
+}
+
+func TestS3ArtifactRepositoryMultipartUploadExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serverURL := newTestServer(t)
+	repository := newTestRepositoryOn(t, serverURL)
+	// Another server sharing the bucket.
+	otherRepository := newTestRepositoryOn(t, serverURL)
+
+	upload, cErr := repository.CreateMultipartUpload(ctx, "expired.bin", 1)
+	require.Nil(t, cErr)
+
+	otherUpload, cErr := otherRepository.CreateMultipartUpload(ctx, "other.bin", 1)
+	require.Nil(t, cErr)
+
+	require.Nil(t, repository.AbortExpiredMultipartUploads(ctx, time.Now().Add(time.Hour)))
+
+	// The upload of the repository is gone, the one of the other server is left to it.
+	require.NotNil(t, repository.AbortMultipartUpload(ctx, "expired.bin", upload.UploadID))
+	require.Nil(t, otherRepository.AbortMultipartUpload(ctx, "other.bin", otherUpload.UploadID))
+}
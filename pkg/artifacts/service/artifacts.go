@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+
+// Same checks as `validate_path_is_safe` in the Python server.
+func validatePathIsSafe(artifactPath string) (string, *contract.Error) {
+	invalidPathError := contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Invalid path")
+
+	if strings.Contains(artifactPath, "%") {
+		unescaped, err := url.PathUnescape(artifactPath)
+		if err != nil || strings.Contains(unescaped, "%") {
+			return "", invalidPathError
+		}
+
+		artifactPath = unescaped
+	}
+
+	if strings.Contains(artifactPath, "#") {
+		return "", invalidPathError
+	}
+
+	if strings.HasPrefix(artifactPath, "file:") {
+		parsed, err := url.Parse(artifactPath)
+		if err != nil {
+			return "", invalidPathError
+		}
+
+		artifactPath = parsed.Path
+	}
+
+	if strings.Contains(artifactPath, "\\") ||
+		strings.HasPrefix(artifactPath, "/") ||
+		(len(artifactPath) >= 2 && artifactPath[1] == ':') {
+		return "", invalidPathError
+	}
+
+	for _, segment := range strings.Split(artifactPath, "/") {
+		if segment == ".." {
+			return "", invalidPathError
+		}
+	}
+
+	return artifactPath, nil
+}
+
+func (as ArtifactsService) checkServingEnabled() *contract.Error {
+	if as.repository == nil {
+		return contract.NewError(
+			protos.ErrorCode_TEMPORARILY_UNAVAILABLE,
+			"Artifact serving is disabled, the server is running without an artifacts destination",
+		)
+	}
+
+	return nil
+}
+
+func (as ArtifactsService) ListArtifacts(
+	ctx context.Context, input *artifacts.ListArtifacts,
+) (*artifacts.ListArtifacts_Response, *contract.Error) {
+	if err := as.checkServingEnabled(); err != nil {
+		return nil, err
+	}
+
+	artifactPath, err := validatePathIsSafe(input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfos, err := as.repository.List(ctx, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The paths are relative to the requested directory, not to the artifact root.
+	files := make([]*artifacts.FileInfo, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		fileInfo.Path = path.Base(fileInfo.Path)
+		files = append(files, fileInfo.ToProto())
+	}
+
+	return &artifacts.ListArtifacts_Response{
+		Files: files,
+	}, nil
+}
+
+func (as ArtifactsService) DownloadArtifact(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error) {
+	if err := as.checkServingEnabled(); err != nil {
+		return nil, err
+	}
+
+	artifactPath, err := validatePathIsSafe(artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return as.repository.Open(ctx, artifactPath)
+}
+
+func (as ArtifactsService) UploadArtifact(ctx context.Context, artifactPath string, content io.Reader) *contract.Error {
+	if err := as.checkServingEnabled(); err != nil {
+		return err
+	}
+
+	artifactPath, err := validatePathIsSafe(artifactPath)
+	if err != nil {
+		return err
+	}
+
+	if err := as.repository.Write(ctx, artifactPath, content); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (as ArtifactsService) DeleteArtifact(ctx context.Context, artifactPath string) *contract.Error {
+	if err := as.checkServingEnabled(); err != nil {
+		return err
+	}
+
+	artifactPath, err := validatePathIsSafe(artifactPath)
+	if err != nil {
+		return err
+	}
+
+	if artifactPath == "" {
+		return contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Artifact path must not be empty")
+	}
+
+	if err := as.repository.Delete(ctx, artifactPath); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DownloadArtifactFromURI opens an artifact below any artifact URI, not only below the artifacts destination.
+func (as ArtifactsService) DownloadArtifactFromURI(
+	ctx context.Context, artifactURI, artifactPath string,
+) (io.ReadCloser, *contract.Error) {
+	artifactPath, err := validatePathIsSafe(artifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, repoErr := repository.NewArtifactRepository(ctx, as.config, artifactURI)
+	if repoErr != nil {
+		switch {
+		case errors.Is(repoErr, repository.ErrArtifactsNotServed):
+			return nil, as.checkServingEnabled()
+		case errors.Is(repoErr, repository.ErrUnsupportedScheme):
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_NOT_IMPLEMENTED,
+				fmt.Sprintf("no artifact repository available for %q", artifactURI),
+				repoErr,
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("failed to access artifacts at %q", artifactURI),
+			repoErr,
+		)
+	}
+
+	return repo.Open(ctx, artifactPath)
+}
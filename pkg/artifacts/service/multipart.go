@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+
+func (as ArtifactsService) getMultipartRepository() (repository.MultipartArtifactRepository, *contract.Error) {
+	if err := as.checkServingEnabled(); err != nil {
+		return nil, err
+	}
+
+	multipartRepository, ok := as.repository.(repository.MultipartArtifactRepository)
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_NOT_IMPLEMENTED,
+			"Multipart upload is not supported for the current artifact repository",
+		)
+	}
+
+	return multipartRepository, nil
+}
+
+// The multipart upload endpoints upload the file at `path` into the directory in the URL.
+func getMultipartUploadPath(artifactPath, filePath string) (string, *contract.Error) {
+	artifactPath, err := validatePathIsSafe(artifactPath)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(artifactPath, path.Base(filePath)), nil
+}
+
+func (as ArtifactsService) CreateMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CreateMultipartUpload,
+) (*artifacts.CreateMultipartUpload_Response, *contract.Error) {
+	multipartRepository, err := as.getMultipartRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	uploadPath, err := getMultipartUploadPath(artifactPath, input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := multipartRepository.CreateMultipartUpload(ctx, uploadPath, input.GetNumParts())
+	if err != nil {
+		return nil, err
+	}
+
+	return upload.ToProto(), nil
+}
+
+func (as ArtifactsService) CompleteMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.CompleteMultipartUpload,
+) (*artifacts.CompleteMultipartUpload_Response, *contract.Error) {
+	multipartRepository, err := as.getMultipartRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	uploadPath, err := getMultipartUploadPath(artifactPath, input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*entities.MultipartUploadPart, 0, len(input.GetParts()))
+	for _, part := range input.GetParts() {
+		parts = append(parts, entities.NewMultipartUploadPartFromProto(part))
+	}
+
+	if err := multipartRepository.CompleteMultipartUpload(ctx, uploadPath, input.GetUploadId(), parts); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.CompleteMultipartUpload_Response{}, nil
+}
+
+func (as ArtifactsService) AbortMultipartUpload(
+	ctx context.Context, artifactPath string, input *artifacts.AbortMultipartUpload,
+) (*artifacts.AbortMultipartUpload_Response, *contract.Error) {
+	multipartRepository, err := as.getMultipartRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	uploadPath, err := getMultipartUploadPath(artifactPath, input.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := multipartRepository.AbortMultipartUpload(ctx, uploadPath, input.GetUploadId()); err != nil {
+		return nil, err
+	}
+
+	return &artifacts.AbortMultipartUpload_Response{}, nil
+}
+
+func (as ArtifactsService) getPartUploadRepository() (repository.PartUploadArtifactRepository, *contract.Error) {
+	if err := as.checkServingEnabled(); err != nil {
+		return nil, err
+	}
+
+	partUploadRepository, ok := as.repository.(repository.PartUploadArtifactRepository)
+	if !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_NOT_IMPLEMENTED,
+			"Uploading parts through the server is not supported for the current artifact repository",
+		)
+	}
+
+	return partUploadRepository, nil
+}
+
+// UploadMultipartUploadPart receives a part for the repositories the client can't send the parts to directly.
+func (as ArtifactsService) UploadMultipartUploadPart(
+	ctx context.Context, uploadID string, partNumber int64, content io.Reader,
+) (string, *contract.Error) {
+	partUploadRepository, err := as.getPartUploadRepository()
+	if err != nil {
+		return "", err
+	}
+
+	return partUploadRepository.UploadPart(ctx, uploadID, partNumber, content)
+}
+
+// GetMultipartUploadPath returns the artifact path of an upload whose parts are uploaded through the server.
+func (as ArtifactsService) GetMultipartUploadPath(ctx context.Context, uploadID string) (string, *contract.Error) {
+	partUploadRepository, err := as.getPartUploadRepository()
+	if err != nil {
+		return "", err
+	}
+
+	return partUploadRepository.MultipartUploadPath(ctx, uploadID)
+}
@@ -2,20 +2,73 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/artifacts/repository"
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 type ArtifactsService struct {
-	config *config.Config
+	config        *config.Config
+	repository    repository.ArtifactRepository
+	stopCleanupFn context.CancelFunc
 }
 
-func NewArtifactsService(_ context.Context, config *config.Config) (*ArtifactsService, error) {
-	return &ArtifactsService{
-		config: config,
-	}, nil
+func NewArtifactsService(ctx context.Context, config *config.Config) (*ArtifactsService, error) {
+	service := &ArtifactsService{
+		config:        config,
+		stopCleanupFn: func() {},
+	}
+
+	// Without a destination artifacts aren't served, which mirrors `mlflow server --no-serve-artifacts`.
+	if config.ArtifactsDestination != "" {
+		repo, err := repository.NewArtifactRepository(ctx, config, config.ArtifactsDestination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create artifact repository: %w", err)
+		}
+
+		service.repository = repo
+
+		// The cleanup is opt-in, the config of the library server is not validated
+		// and the ticker of the cleanup panics on a negative expiry.
+		expiry := config.MultipartUploadExpiry.Duration
+		if multipartRepository, ok := repo.(repository.MultipartArtifactRepository); ok && expiry > 0 {
+			cleanupCtx, cancel := context.WithCancel(ctx)
+			service.stopCleanupFn = cancel
+
+			go cleanupMultipartUploads(cleanupCtx, multipartRepository, expiry)
+		}
+	}
+
+	return service, nil
+}
+
+// Abort the multipart uploads which weren't completed or aborted within the expiry.
+func cleanupMultipartUploads(
+	ctx context.Context, repo repository.MultipartArtifactRepository, expiry time.Duration,
+) {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	ticker := time.NewTicker(min(expiry, time.Hour))
+	defer ticker.Stop()
+
+	for {
+		if err := repo.AbortExpiredMultipartUploads(ctx, time.Now().Add(-expiry)); err != nil {
+			logger.Warnf("Failed to clean up expired multipart uploads: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 func (as ArtifactsService) Destroy() error {
+	as.stopCleanupFn()
+
 	return nil
 }
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The creators of experiments and registered models manage them.
+
+func grantExperimentCreator(r *request) error {
+	var response struct {
+		ExperimentID string `json:"experiment_id"`
+	}
+	if err := json.Unmarshal(r.response.Body(), &response); err != nil {
+		return fmt.Errorf("failed to parse the created experiment: %w", err)
+	}
+
+	if _, err := r.store.CreateExperimentPermission(
+		r.context, response.ExperimentID, r.user.Username, PermissionManage,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func grantRegisteredModelCreator(r *request) error {
+	name, contractErr := r.value("name")
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if _, err := r.store.CreateRegisteredModelPermission(r.context, name, r.user.Username, PermissionManage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func renameRegisteredModel(r *request) error {
+	name, contractErr := r.value("name")
+	if contractErr != nil {
+		return contractErr
+	}
+
+	newName, contractErr := r.value("new_name")
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := r.store.RenameRegisteredModelPermissions(r.context, name, newName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteRegisteredModel(r *request) error {
+	name, contractErr := r.value("name")
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := r.store.DeleteRegisteredModelPermissions(r.context, name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// filterResponse removes the items of a search response the user cannot read,
+// a page can hence hold fewer items than requested.
+func filterResponse(r *request, field string, readable func(item json.RawMessage) (bool, error)) error {
+	if r.user.IsAdmin {
+		return nil
+	}
+
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(r.response.Body(), &response); err != nil {
+		return fmt.Errorf("failed to parse the search response: %w", err)
+	}
+
+	var items []json.RawMessage
+	if rawItems, ok := response[field]; ok {
+		if err := json.Unmarshal(rawItems, &items); err != nil {
+			return fmt.Errorf("failed to parse the search response: %w", err)
+		}
+	}
+
+	filtered := make([]json.RawMessage, 0, len(items))
+
+	for _, item := range items {
+		ok, err := readable(item)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			filtered = append(filtered, item)
+		}
+	}
+
+	if len(filtered) == len(items) {
+		return nil
+	}
+
+	rawFiltered, err := json.Marshal(filtered)
+	if err != nil {
+		return fmt.Errorf("failed to serialize the search response: %w", err)
+	}
+
+	response[field] = rawFiltered
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to serialize the search response: %w", err)
+	}
+
+	r.response.SetBody(body)
+
+	return nil
+}
+
+func filterExperiments(r *request) error {
+	return filterResponse(r, "experiments", func(item json.RawMessage) (bool, error) {
+		var experiment struct {
+			ExperimentID string `json:"experiment_id"`
+		}
+		if err := json.Unmarshal(item, &experiment); err != nil {
+			return false, fmt.Errorf("failed to parse experiment: %w", err)
+		}
+
+		permission, err := experimentPermission(r, experiment.ExperimentID)
+		if err != nil {
+			return false, err
+		}
+
+		return permission.CanRead(), nil
+	})
+}
+
+func readableByName(r *request) func(item json.RawMessage) (bool, error) {
+	return func(item json.RawMessage) (bool, error) {
+		var model struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(item, &model); err != nil {
+			return false, fmt.Errorf("failed to parse registered model: %w", err)
+		}
+
+		permission, err := registeredModelPermission(r, model.Name)
+		if err != nil {
+			return false, err
+		}
+
+		return permission.CanRead(), nil
+	}
+}
+
+func filterRegisteredModels(r *request) error {
+	return filterResponse(r, "registered_models", readableByName(r))
+}
+
+func filterModelVersions(r *request) error {
+	return filterResponse(r, "model_versions", readableByName(r))
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// verifiedCertificate returns the client certificate verified during the handshake of a TLS connection,
+// nil without TLS or client certificate.
+func verifiedCertificate(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return state.VerifiedChains[0][0]
+}
+
+// ClientCertificate returns the verified client certificate of a request served over TLS.
+func ClientCertificate(c *fiber.Ctx) *x509.Certificate {
+	return verifiedCertificate(c.Context().TLSConnectionState())
+}
+
+// AuthenticateCertificate returns the user named after the common name of a verified client certificate.
+func (s Store) AuthenticateCertificate(ctx context.Context, certificate *x509.Certificate) (*User, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, certificate.Subject.CommonName)
+	if contractErr != nil {
+		if protos.ErrorCode(contractErr.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_UNAUTHENTICATED,
+				fmt.Sprintf("No user matches the client certificate %q", certificate.Subject),
+				contractErr,
+			)
+		}
+
+		return nil, contractErr
+	}
+
+	return user, nil
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func (s Store) CreateExperimentPermission(
+	ctx context.Context, experimentID, username string, permission Permission,
+) (*ExperimentPermission, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	experimentPermission := ExperimentPermission{
+		ExperimentID: experimentID,
+		UserID:       user.ID,
+		Permission:   permission,
+	}
+	if err := s.db.WithContext(ctx).Create(&experimentPermission).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+				fmt.Sprintf("Experiment permission (experiment_id=%s, username=%s) already exists", experimentID, username),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create experiment permission", err)
+	}
+
+	return &experimentPermission, nil
+}
+
+func (s Store) GetExperimentPermission(
+	ctx context.Context, experimentID, username string,
+) (*ExperimentPermission, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	return s.getExperimentPermission(ctx, experimentID, user)
+}
+
+func (s Store) getExperimentPermission(
+	ctx context.Context, experimentID string, user *User,
+) (*ExperimentPermission, *contract.Error) {
+	var experimentPermission ExperimentPermission
+	if err := s.db.WithContext(ctx).
+		Where("experiment_id = ? AND user_id = ?", experimentID, user.ID).
+		First(&experimentPermission).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf(
+					"Experiment permission with experiment_id=%s and username=%s not found", experimentID, user.Username,
+				),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get experiment permission", err)
+	}
+
+	return &experimentPermission, nil
+}
+
+func (s Store) ListExperimentPermissions(ctx context.Context, user *User) ([]ExperimentPermission, *contract.Error) {
+	var experimentPermissions []ExperimentPermission
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ?", user.ID).
+		Order("id").
+		Find(&experimentPermissions).Error; err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to list experiment permissions", err)
+	}
+
+	return experimentPermissions, nil
+}
+
+func (s Store) UpdateExperimentPermission(
+	ctx context.Context, experimentID, username string, permission Permission,
+) *contract.Error {
+	experimentPermission, contractErr := s.GetExperimentPermission(ctx, experimentID, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Model(experimentPermission).Update("permission", permission).Error; err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to update experiment permission", err)
+	}
+
+	return nil
+}
+
+func (s Store) DeleteExperimentPermission(ctx context.Context, experimentID, username string) *contract.Error {
+	experimentPermission, contractErr := s.GetExperimentPermission(ctx, experimentID, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Delete(experimentPermission).Error; err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to delete experiment permission", err)
+	}
+
+	return nil
+}
+
+// ExperimentPermissionOf returns the permission of a user on an experiment,
+// the default permission applies when none was granted.
+func (s Store) ExperimentPermissionOf(
+	ctx context.Context, experimentID string, user *User,
+) (Permission, *contract.Error) {
+	experimentPermission, contractErr := s.getExperimentPermission(ctx, experimentID, user)
+	if contractErr != nil {
+		if protos.ErrorCode(contractErr.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+			return s.defaultPermission, nil
+		}
+
+		return "", contractErr
+	}
+
+	return experimentPermission.Permission, nil
+}
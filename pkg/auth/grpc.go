@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// rpcResponse is the output of a gRPC method serialized like the HTTP server does, for the after hooks.
+type rpcResponse struct {
+	body    []byte
+	changed bool
+}
+
+func (r *rpcResponse) Body() []byte {
+	return r.body
+}
+
+func (r *rpcResponse) SetBody(body []byte) {
+	r.body = body
+	r.changed = true
+}
+
+var pathParameterRegex = regexp.MustCompile(`\{([\w.]+)\}`)
+
+// rpcPath expands the path of an endpoint, like /mlflow/traces/{request_id}/tags, with the fields of the input.
+func rpcPath(path string, input map[string]any) string {
+	return pathParameterRegex.ReplaceAllStringFunc(path, func(parameter string) string {
+		var value any = input
+
+		for _, name := range strings.Split(strings.Trim(parameter, "{}"), ".") {
+			fields, _ := value.(map[string]any)
+			value = fields[name]
+		}
+
+		if values := bodyValues(value); len(values) > 0 {
+			return values[0]
+		}
+
+		return ""
+	})
+}
+
+func rpcInput(message proto.Message) (map[string]any, *contract.Error) {
+	rawInput, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to serialize the request", err)
+	}
+
+	var input map[string]any
+	if err := json.Unmarshal(rawInput, &input); err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to serialize the request", err)
+	}
+
+	return input, nil
+}
+
+// rpcAfter runs the after hook of the rule of a gRPC method which succeeded,
+// the output is replaced when the hook rewrote it.
+func rpcAfter(rule *rule, r *request, output any) (any, *contract.Error) {
+	message, ok := output.(proto.Message)
+	if !ok || rule == nil || rule.after == nil {
+		return output, nil
+	}
+
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to serialize the response", err)
+	}
+
+	response := &rpcResponse{body: body}
+	r.response = response
+
+	if contractErr := after(rule, r); contractErr != nil {
+		return nil, contractErr
+	}
+
+	if !response.changed {
+		return output, nil
+	}
+
+	rewritten := message.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(response.body, rewritten); err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to parse the response", err)
+	}
+
+	return rewritten, nil
+}
+
+// NewUnaryServerInterceptor authenticates the gRPC calls with their authorization metadata or client certificate,
+// then authorizes them with the rule of the HTTP endpoint of their method.
+func NewUnaryServerInterceptor(
+	store *Store, endpoint func(fullMethod string) (string, string, bool),
+) grpc.UnaryServerInterceptor {
+	rules := rules()
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var authorization string
+		if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+
+		var certificate *x509.Certificate
+		if client, ok := peer.FromContext(ctx); ok {
+			if info, ok := client.AuthInfo.(credentials.TLSInfo); ok {
+				certificate = verifiedCertificate(&info.State)
+			}
+		}
+
+		user, contractErr := authenticate(ctx, store, authorization, certificate)
+		if contractErr != nil {
+			return nil, contractErr
+		}
+
+		method, path, ok := endpoint(info.FullMethod)
+		if !ok {
+			return nil, contract.NewError(protos.ErrorCode_PERMISSION_DENIED, "Permission denied")
+		}
+
+		message, _ := req.(proto.Message)
+
+		input, contractErr := rpcInput(message)
+		if contractErr != nil {
+			return nil, contractErr
+		}
+
+		rule, params := findRule(rules, method, rpcPath(path, input))
+		r := &request{
+			context:    ctx,
+			store:      store,
+			user:       user,
+			pathParams: params,
+			params: func(name string) []string {
+				return bodyValues(input[name])
+			},
+		}
+
+		if contractErr := authorize(rule, r); contractErr != nil {
+			return nil, contractErr
+		}
+
+		output, err := handler(ctx, req)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		output, contractErr = rpcAfter(rule, r, output)
+		if contractErr != nil {
+			return nil, contractErr
+		}
+
+		return output, nil
+	}
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const userLocalsKey = "mlflow.auth.user"
+
+// The API is served on several prefixes, the rules are relative to them.
+var apiPrefixes = []string{"/api/2.0", "/ajax-api/2.0"}
+
+func isUnprotected(path string) bool {
+	return path == "/health" || path == "/version" || path == "/favicon.ico" ||
+		strings.HasPrefix(path, "/static-files/")
+}
+
+// apiPath returns the path relative to the API root, and whether the path is one of the API.
+func apiPath(path string) (string, bool) {
+	for _, prefix := range apiPrefixes {
+		if strings.HasPrefix(path, prefix+"/") {
+			return path[len(prefix):], true
+		}
+	}
+
+	return path, false
+}
+
+// authenticate returns the user of the credentials of an Authorization header,
+// or of the verified client certificate of a request without one when the certificate authentication is enabled.
+func authenticate(
+	ctx context.Context, store *Store, authorization string, certificate *x509.Certificate,
+) (*User, *contract.Error) {
+	if authorization == "" && certificate != nil && store.certificateAuthentication {
+		return store.AuthenticateCertificate(ctx, certificate)
+	}
+
+	scheme, credentials, _ := strings.Cut(authorization, " ")
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return nil, contract.NewErrorWith(protos.ErrorCode_UNAUTHENTICATED, "Invalid basic auth credentials", err)
+		}
+
+		username, password, _ := strings.Cut(string(decoded), ":")
+
+		return store.AuthenticateUser(ctx, username, password)
+	case "bearer":
+		return store.AuthenticateToken(ctx, credentials)
+	default:
+		return nil, contract.NewError(
+			protos.ErrorCode_UNAUTHENTICATED,
+			"You are not authenticated. Please provide basic auth credentials or an access token.",
+		)
+	}
+}
+
+// The middleware runs in front of the routes of the API apps, it answers the errors itself.
+func sendError(c *fiber.Ctx, err *contract.Error) error {
+	logger := utils.GetLoggerFromContext(c.UserContext())
+	if err.StatusCode() >= fiber.StatusInternalServerError {
+		logger.Errorf("Error encountered in %s %s: %s", c.Method(), c.Path(), err)
+	} else {
+		logger.Debugf("Rejected %s %s: %s", c.Method(), c.Path(), err)
+	}
+
+	if protos.ErrorCode(err.Code) == protos.ErrorCode_UNAUTHENTICATED {
+		c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="mlflow"`)
+	}
+
+	return c.Status(err.StatusCode()).JSON(err)
+}
+
+// authorize runs the validator of the rule of a request, admins are always authorized.
+// The requests without a rule are denied to the other users.
+func authorize(rule *rule, r *request) *contract.Error {
+	if r.user.IsAdmin {
+		return nil
+	}
+
+	if rule == nil {
+		return contract.NewError(protos.ErrorCode_PERMISSION_DENIED, "Permission denied")
+	}
+
+	if rule.validator == nil {
+		return nil
+	}
+
+	allowed, err := rule.validator(r)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return contract.NewError(protos.ErrorCode_PERMISSION_DENIED, "Permission denied")
+	}
+
+	return nil
+}
+
+// after runs the after hook of the rule of a request which succeeded.
+func after(rule *rule, r *request) *contract.Error {
+	if rule == nil || rule.after == nil {
+		return nil
+	}
+
+	if err := rule.after(r); err != nil {
+		var contractErr *contract.Error
+		if !errors.As(err, &contractErr) {
+			contractErr = contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, err.Error(), err)
+		}
+
+		return contractErr
+	}
+
+	return nil
+}
+
+// MultipartUploadPathLookup returns the artifact path of a multipart upload, the parts uploaded through the server
+// are authorized against it.
+type MultipartUploadPathLookup func(ctx context.Context, uploadID string) (string, *contract.Error)
+
+// NewMiddleware authenticates every request but the health checks and static files,
+// then authorizes it with the rule of its route.
+func NewMiddleware(store *Store, uploadPath MultipartUploadPathLookup) fiber.Handler {
+	rules := rules()
+
+	return func(c *fiber.Ctx) error {
+		path, isAPI := apiPath(c.Path())
+		if isUnprotected(path) {
+			return c.Next()
+		}
+
+		ctx := utils.NewContextWithLoggerFromFiberContext(c)
+
+		user, contractErr := authenticate(ctx, store, c.Get(fiber.HeaderAuthorization), ClientCertificate(c))
+		if contractErr != nil {
+			return sendError(c, contractErr)
+		}
+
+		c.Locals(userLocalsKey, user)
+
+		rule, params := findRule(rules, c.Method(), path)
+		req := &request{
+			context:    ctx,
+			store:      store,
+			user:       user,
+			pathParams: params,
+			params:     httpParams(c),
+			response:   c.Response(),
+			uploadPath: uploadPath,
+		}
+
+		// The UI pages and the other routes outside of the API are open to every authenticated user.
+		if rule != nil || isAPI {
+			if contractErr := authorize(rule, req); contractErr != nil {
+				return sendError(c, contractErr)
+			}
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if c.Response().StatusCode() == fiber.StatusOK {
+			if contractErr := after(rule, req); contractErr != nil {
+				return sendError(c, contractErr)
+			}
+		}
+
+		return nil
+	}
+}
+
+// CurrentUser returns the user authenticated by the middleware.
+func CurrentUser(c *fiber.Ctx) *User {
+	user, _ := c.Locals(userLocalsKey).(*User)
+
+	return user
+}
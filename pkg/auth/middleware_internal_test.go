@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func newTestApp(t *testing.T) (*fiber.App, *Store) {
+	t.Helper()
+
+	store, err := NewStore(context.Background(), &config.Config{
+		TrackingStoreURI: "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db")),
+		Auth: config.AuthConfig{
+			AdminUsername:     "admin",
+			AdminPassword:     "password",
+			DefaultPermission: "READ",
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	app := fiber.New()
+	app.Use(NewMiddleware(store, func(_ context.Context, uploadID string) (string, *contract.Error) {
+		if uploadID != "upload" {
+			return "", contract.NewError(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, "Multipart upload does not exist")
+		}
+
+		return "0/artifacts/model.bin", nil
+	}))
+
+	api := fiber.New()
+	api.Get("/mlflow/experiments/get", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{})
+	})
+	api.Post("/mlflow/experiments/update", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{})
+	})
+	api.Post("/mlflow/experiments/create", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"experiment_id": "1"})
+	})
+	api.Put("/mlflow-artifacts/mpu/parts/:upload_id/:part_number", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{})
+	})
+	api.Post("/mlflow/traces/search", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{})
+	})
+	RegisterRoutes(store, api)
+	app.Mount("/api/2.0", api)
+
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.SendString("OK")
+	})
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString("UI")
+	})
+
+	return app, store
+}
+
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func send(t *testing.T, app *fiber.App, method, target, authorization, body string) *http.Response {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	if authorization != "" {
+		req.Header.Set(fiber.HeaderAuthorization, authorization)
+	}
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		resp.Body.Close()
+	})
+
+	return resp
+}
+
+const getExperiment = "/api/2.0/mlflow/experiments/get?experiment_id=0"
+
+func TestAuthentication(t *testing.T) {
+	t.Parallel()
+
+	app, store := newTestApp(t)
+	ctx := context.Background()
+
+	resp := send(t, app, fiber.MethodGet, "/health", "", "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodGet, getExperiment, "", "")
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, `Basic realm="mlflow"`, resp.Header.Get(fiber.HeaderWWWAuthenticate))
+
+	resp = send(t, app, fiber.MethodGet, getExperiment, basicAuth("admin", "wrong"), "")
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodGet, getExperiment, basicAuth("admin", "password"), "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	admin, contractErr := store.GetUser(ctx, "admin")
+	require.Nil(t, contractErr)
+
+	token, _, contractErr := store.CreateAccessToken(ctx, admin, "ci")
+	require.Nil(t, contractErr)
+
+	resp = send(t, app, fiber.MethodGet, getExperiment, "Bearer "+token, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodGet, getExperiment, "Bearer invalid", "")
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+
+	app, store := newTestApp(t)
+
+	_, contractErr := store.CreateUser(context.Background(), "user", "password", false)
+	require.Nil(t, contractErr)
+
+	user := basicAuth("user", "password")
+
+	// The default permission allows reading, not updating.
+	resp := send(t, app, fiber.MethodGet, getExperiment, user, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/update", user, `{"experiment_id": "0"}`)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	// The creator of an experiment manages it.
+	resp = send(t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/create", user, `{"name": "experiment"}`)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/update", user, `{"experiment_id": "1"}`)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// Managing the permissions of an experiment requires the MANAGE permission, except for admins.
+	resp = send(
+		t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/permissions/create", user,
+		`{"experiment_id": "0", "username": "user", "permission": "MANAGE"}`,
+	)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	resp = send(
+		t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/permissions/create", basicAuth("admin", "password"),
+		`{"experiment_id": "0", "username": "user", "permission": "EDIT"}`,
+	)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/update", user, `{"experiment_id": "0"}`)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// Only admins create users.
+	resp = send(
+		t, app, fiber.MethodPost, "/api/2.0/mlflow/users/create", user, `{"username": "other", "password": "password"}`,
+	)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+}
+
+func TestAuthorizationWithoutRule(t *testing.T) {
+	t.Parallel()
+
+	app, store := newTestApp(t)
+
+	_, contractErr := store.CreateUser(context.Background(), "user", "password", false)
+	require.Nil(t, contractErr)
+
+	user := basicAuth("user", "password")
+
+	// The API routes without a rule are only allowed to the admins.
+	resp := send(t, app, fiber.MethodPost, "/api/2.0/mlflow/traces/search", user, `{}`)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPost, "/ajax-api/2.0/mlflow/traces/search", user, `{}`)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPost, "/api/2.0/mlflow/traces/search", basicAuth("admin", "password"), `{}`)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// The access tokens of the user and the pages outside of the API stay open.
+	resp = send(t, app, fiber.MethodGet, "/api/2.0/mlflow/users/access-tokens/list", user, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodGet, "/", user, "")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+func TestAuthorizationMultipartUploadParts(t *testing.T) {
+	t.Parallel()
+
+	app, store := newTestApp(t)
+
+	_, contractErr := store.CreateUser(context.Background(), "user", "password", false)
+	require.Nil(t, contractErr)
+
+	user := basicAuth("user", "password")
+
+	// The parts have the permission of the artifact path of their upload, in experiment 0.
+	resp := send(t, app, fiber.MethodPut, "/api/2.0/mlflow-artifacts/mpu/parts/upload/1", user, "part")
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPut, "/api/2.0/mlflow-artifacts/mpu/parts/unknown/1", user, "part")
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+	resp = send(
+		t, app, fiber.MethodPost, "/api/2.0/mlflow/experiments/permissions/create", basicAuth("admin", "password"),
+		`{"experiment_id": "0", "username": "user", "permission": "EDIT"}`,
+	)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	resp = send(t, app, fiber.MethodPut, "/api/2.0/mlflow-artifacts/mpu/parts/upload/1", user, "part")
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,59 @@
+package auth
+
+// The auth tables live in the tracking database, next to the MLflow schema.
+// They are not part of the MLflow alembic revisions and are created by the auth store.
+
+//nolint:lll
+type User struct {
+	ID           int32  `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Username     string `gorm:"column:username;size:255;not null;uniqueIndex:unique_username" json:"username"`
+	PasswordHash string `gorm:"column:password_hash;size:255;not null" json:"-"`
+	IsAdmin      bool   `gorm:"column:is_admin;not null;default:false" json:"is_admin"`
+}
+
+func (User) TableName() string {
+	return "users"
+}
+
+//nolint:lll
+type ExperimentPermission struct {
+	ID           int32      `gorm:"column:id;primaryKey;autoIncrement" json:"-"`
+	ExperimentID string     `gorm:"column:experiment_id;size:255;not null;uniqueIndex:unique_experiment_user,priority:1" json:"experiment_id"`
+	UserID       int32      `gorm:"column:user_id;not null;uniqueIndex:unique_experiment_user,priority:2" json:"user_id"`
+	Permission   Permission `gorm:"column:permission;size:255" json:"permission"`
+}
+
+func (ExperimentPermission) TableName() string {
+	return "experiment_permissions"
+}
+
+//nolint:lll
+type RegisteredModelPermission struct {
+	ID         int32      `gorm:"column:id;primaryKey;autoIncrement" json:"-"`
+	Name       string     `gorm:"column:name;size:255;not null;uniqueIndex:unique_name_user,priority:1" json:"name"`
+	UserID     int32      `gorm:"column:user_id;not null;uniqueIndex:unique_name_user,priority:2" json:"user_id"`
+	Permission Permission `gorm:"column:permission;size:255" json:"permission"`
+}
+
+func (RegisteredModelPermission) TableName() string {
+	return "registered_model_permissions"
+}
+
+// AccessToken is an API token of a user, only the SHA-256 hash of the token is stored.
+//
+//nolint:lll
+type AccessToken struct {
+	ID           int32  `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID       int32  `gorm:"column:user_id;not null;index:index_access_tokens_user_id" json:"user_id"`
+	Name         string `gorm:"column:name;size:255;not null" json:"name"`
+	TokenHash    string `gorm:"column:token_hash;size:64;not null;uniqueIndex:unique_token_hash" json:"-"`
+	CreationTime int64  `gorm:"column:creation_time" json:"creation_time"`
+}
+
+func (AccessToken) TableName() string {
+	return "access_tokens"
+}
+
+func tables() []any {
+	return []any{&User{}, &ExperimentPermission{}, &RegisteredModelPermission{}, &AccessToken{}}
+}
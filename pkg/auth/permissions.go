@@ -0,0 +1,51 @@
+// Package auth authenticates the requests of the server with basic auth or API tokens,
+// and authorizes them against per-experiment and per-registered-model permissions,
+// the same way the basic-auth app of MLflow does.
+package auth
+
+import (
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+type Permission string
+
+const (
+	PermissionRead          Permission = "READ"
+	PermissionEdit          Permission = "EDIT"
+	PermissionManage        Permission = "MANAGE"
+	PermissionNoPermissions Permission = "NO_PERMISSIONS"
+)
+
+func ParsePermission(name string) (Permission, *contract.Error) {
+	switch permission := Permission(name); permission {
+	case PermissionRead, PermissionEdit, PermissionManage, PermissionNoPermissions:
+		return permission, nil
+	default:
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid permission %q, valid permissions are: %s, %s, %s, %s",
+				name, PermissionRead, PermissionEdit, PermissionManage, PermissionNoPermissions,
+			),
+		)
+	}
+}
+
+func (p Permission) CanRead() bool {
+	return p == PermissionRead || p == PermissionEdit || p == PermissionManage
+}
+
+func (p Permission) CanUpdate() bool {
+	return p == PermissionEdit || p == PermissionManage
+}
+
+func (p Permission) CanDelete() bool {
+	return p == PermissionManage
+}
+
+func (p Permission) CanManage() bool {
+	return p == PermissionManage
+}
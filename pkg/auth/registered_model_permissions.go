@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func (s Store) CreateRegisteredModelPermission(
+	ctx context.Context, name, username string, permission Permission,
+) (*RegisteredModelPermission, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	registeredModelPermission := RegisteredModelPermission{
+		Name:       name,
+		UserID:     user.ID,
+		Permission: permission,
+	}
+	if err := s.db.WithContext(ctx).Create(&registeredModelPermission).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+				fmt.Sprintf("Registered model permission (name=%s, username=%s) already exists", name, username),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to create registered model permission", err,
+		)
+	}
+
+	return &registeredModelPermission, nil
+}
+
+func (s Store) GetRegisteredModelPermission(
+	ctx context.Context, name, username string,
+) (*RegisteredModelPermission, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	return s.getRegisteredModelPermission(ctx, name, user)
+}
+
+func (s Store) getRegisteredModelPermission(
+	ctx context.Context, name string, user *User,
+) (*RegisteredModelPermission, *contract.Error) {
+	var registeredModelPermission RegisteredModelPermission
+	if err := s.db.WithContext(ctx).
+		Where("name = ? AND user_id = ?", name, user.ID).
+		First(&registeredModelPermission).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Registered model permission with name=%s and username=%s not found", name, user.Username),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to get registered model permission", err,
+		)
+	}
+
+	return &registeredModelPermission, nil
+}
+
+func (s Store) ListRegisteredModelPermissions(
+	ctx context.Context, user *User,
+) ([]RegisteredModelPermission, *contract.Error) {
+	var registeredModelPermissions []RegisteredModelPermission
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ?", user.ID).
+		Order("id").
+		Find(&registeredModelPermissions).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to list registered model permissions", err,
+		)
+	}
+
+	return registeredModelPermissions, nil
+}
+
+func (s Store) UpdateRegisteredModelPermission(
+	ctx context.Context, name, username string, permission Permission,
+) *contract.Error {
+	registeredModelPermission, contractErr := s.GetRegisteredModelPermission(ctx, name, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Model(registeredModelPermission).Update("permission", permission).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to update registered model permission", err,
+		)
+	}
+
+	return nil
+}
+
+func (s Store) DeleteRegisteredModelPermission(ctx context.Context, name, username string) *contract.Error {
+	registeredModelPermission, contractErr := s.GetRegisteredModelPermission(ctx, name, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Delete(registeredModelPermission).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to delete registered model permission", err,
+		)
+	}
+
+	return nil
+}
+
+// RenameRegisteredModelPermissions moves the permissions of a registered model to its new name.
+func (s Store) RenameRegisteredModelPermissions(ctx context.Context, name, newName string) *contract.Error {
+	if err := s.db.WithContext(ctx).
+		Model(&RegisteredModelPermission{}).
+		Where("name = ?", name).
+		Update("name", newName).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to rename registered model permissions", err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelPermissions deletes the permissions of all the users on a registered model.
+func (s Store) DeleteRegisteredModelPermissions(ctx context.Context, name string) *contract.Error {
+	if err := s.db.WithContext(ctx).Where("name = ?", name).Delete(&RegisteredModelPermission{}).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR, "failed to delete registered model permissions", err,
+		)
+	}
+
+	return nil
+}
+
+// RegisteredModelPermissionOf returns the permission of a user on a registered model,
+// the default permission applies when none was granted.
+func (s Store) RegisteredModelPermissionOf(
+	ctx context.Context, name string, user *User,
+) (Permission, *contract.Error) {
+	registeredModelPermission, contractErr := s.getRegisteredModelPermission(ctx, name, user)
+	if contractErr != nil {
+		if protos.ErrorCode(contractErr.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+			return s.defaultPermission, nil
+		}
+
+		return "", contractErr
+	}
+
+	return registeredModelPermission.Permission, nil
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// body is the response of a request, read and rewritten by the after hooks of the rules.
+type body interface {
+	Body() []byte
+	SetBody(body []byte)
+}
+
+// request gives the rules access to the parameters and the response of a request,
+// whether it was received by the HTTP server or the gRPC one.
+type request struct {
+	context    context.Context //nolint:containedctx
+	store      *Store
+	user       *User
+	pathParams map[string]string
+	// params returns the values of a parameter which is not part of the path.
+	params   func(name string) []string
+	response body
+	// uploadPath is only set for the HTTP requests, the parts of the multipart uploads are not sent over gRPC.
+	uploadPath MultipartUploadPathLookup
+}
+
+// httpParams reads the query string of GET requests and the JSON body of the others.
+// The body is only read when a rule needs it, the artifact uploads stream their body.
+func httpParams(c *fiber.Ctx) func(name string) []string {
+	var (
+		body       map[string]any
+		bodyParsed bool
+	)
+
+	return func(name string) []string {
+		if c.Method() != fiber.MethodGet && len(c.Body()) > 0 {
+			if !bodyParsed {
+				bodyParsed = true
+
+				// An invalid body is reported by the route itself.
+				_ = json.Unmarshal(c.Body(), &body)
+			}
+
+			return bodyValues(body[name])
+		}
+
+		rawValues := c.Context().QueryArgs().PeekMulti(name)
+		values := make([]string, 0, len(rawValues))
+
+		for _, value := range rawValues {
+			values = append(values, string(value))
+		}
+
+		return values
+	}
+}
+
+func bodyValues(value any) []string {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{value}
+	case float64:
+		return []string{strconv.FormatFloat(value, 'f', -1, 64)}
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			values = append(values, bodyValues(item)...)
+		}
+
+		return values
+	default:
+		return []string{fmt.Sprint(value)}
+	}
+}
+
+func (r *request) values(name string) []string {
+	if value, ok := r.pathParams[name]; ok {
+		return []string{value}
+	}
+
+	return r.params(name)
+}
+
+// value returns the first parameter set among its names, they are aliases like run_id and run_uuid.
+func (r *request) value(names ...string) (string, *contract.Error) {
+	for _, name := range names {
+		if values := r.values(name); len(values) > 0 && values[0] != "" {
+			return values[0], nil
+		}
+	}
+
+	return "", contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Missing value for required parameter '%s'. See the API docs for more information about request parameters.",
+			names[0],
+		),
+	)
+}
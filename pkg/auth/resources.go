@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// The permissions of runs, traces and logged models are the ones of their experiment,
+// which is read from the tracking tables sharing the database of the auth tables.
+
+func (s Store) experimentIDOf(
+	ctx context.Context, table, column, value, notFoundMessage string,
+) (string, *contract.Error) {
+	var experimentIDs []string
+	if err := s.db.WithContext(ctx).
+		Table(table).
+		Where(column+" = ?", value).
+		Limit(1).
+		Pluck("experiment_id", &experimentIDs).Error; err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get experiment id", err)
+	}
+
+	if len(experimentIDs) == 0 {
+		return "", contract.NewError(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, notFoundMessage)
+	}
+
+	return experimentIDs[0], nil
+}
+
+func (s Store) ExperimentIDOfRun(ctx context.Context, runID string) (string, *contract.Error) {
+	return s.experimentIDOf(ctx, "runs", "run_uuid", runID, fmt.Sprintf("Run with id=%s not found", runID))
+}
+
+func (s Store) ExperimentIDOfTrace(ctx context.Context, requestID string) (string, *contract.Error) {
+	return s.experimentIDOf(
+		ctx, "trace_info", "request_id", requestID, fmt.Sprintf("Trace with request_id '%s' not found.", requestID),
+	)
+}
+
+func (s Store) ExperimentIDOfLoggedModel(ctx context.Context, modelID string) (string, *contract.Error) {
+	return s.experimentIDOf(
+		ctx, "logged_models", "model_id", modelID, fmt.Sprintf("Logged model with ID '%s' not found.", modelID),
+	)
+}
+
+func (s Store) ExperimentIDByName(ctx context.Context, name string) (string, *contract.Error) {
+	return s.experimentIDOf(
+		ctx, "experiments", "name", name, fmt.Sprintf("Could not find experiment with name %s", name),
+	)
+}
@@ -0,0 +1,366 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type userInput struct {
+	Username string `json:"username" query:"username"`
+	Password string `json:"password" query:"password"`
+	IsAdmin  bool   `json:"is_admin" query:"is_admin"`
+}
+
+type accessTokenInput struct {
+	ID   int32  `json:"id"   query:"id"`
+	Name string `json:"name" query:"name"`
+}
+
+type experimentPermissionInput struct {
+	ExperimentID string `json:"experiment_id" query:"experiment_id"`
+	Username     string `json:"username"      query:"username"`
+	Permission   string `json:"permission"    query:"permission"`
+}
+
+type registeredModelPermissionInput struct {
+	Name       string `json:"name"       query:"name"`
+	Username   string `json:"username"   query:"username"`
+	Permission string `json:"permission" query:"permission"`
+}
+
+// parseInput reads the query string of GET requests and the JSON body of the others.
+func parseInput(c *fiber.Ctx, input any) *contract.Error {
+	if c.Method() == fiber.MethodGet || len(c.Body()) == 0 {
+		if err := c.QueryParser(input); err != nil {
+			return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+		}
+
+		return nil
+	}
+
+	if err := json.Unmarshal(c.Body(), input); err != nil {
+		return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+	}
+
+	return nil
+}
+
+func requireParameters(parameters ...string) *contract.Error {
+	for index := 0; index < len(parameters); index += 2 {
+		if parameters[index+1] == "" {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Missing value for required parameter '%s'.", parameters[index]),
+			)
+		}
+	}
+
+	return nil
+}
+
+// RegisterRoutes registers the routes managing the users, their access tokens and their permissions,
+// which are the ones of the MLflow basic-auth app along with the access tokens.
+//
+//nolint:funlen,cyclop
+func RegisterRoutes(store *Store, app *fiber.App) {
+	app.Post("/mlflow/users/create", func(ctx *fiber.Ctx) error {
+		var input userInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		user, err := store.CreateUser(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Username, input.Password, false,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"user": user})
+	})
+	app.Get("/mlflow/users/get", func(ctx *fiber.Ctx) error {
+		var input userInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		userContext := utils.NewContextWithLoggerFromFiberContext(ctx)
+
+		user, err := store.GetUser(userContext, input.Username)
+		if err != nil {
+			return err
+		}
+
+		experimentPermissions, err := store.ListExperimentPermissions(userContext, user)
+		if err != nil {
+			return err
+		}
+
+		registeredModelPermissions, err := store.ListRegisteredModelPermissions(userContext, user)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"user": fiber.Map{
+			"id":                           user.ID,
+			"username":                     user.Username,
+			"is_admin":                     user.IsAdmin,
+			"experiment_permissions":       experimentPermissions,
+			"registered_model_permissions": registeredModelPermissions,
+		}})
+	})
+	app.Patch("/mlflow/users/update-password", func(ctx *fiber.Ctx) error {
+		var input userInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		if err := store.UpdateUserPassword(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Username, input.Password,
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+	app.Patch("/mlflow/users/update-admin", func(ctx *fiber.Ctx) error {
+		var input userInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		if err := store.UpdateUserAdmin(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Username, input.IsAdmin,
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+	app.Delete("/mlflow/users/delete", func(ctx *fiber.Ctx) error {
+		var input userInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		if err := store.DeleteUser(utils.NewContextWithLoggerFromFiberContext(ctx), input.Username); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+
+	// The access tokens are the ones of the authenticated user.
+	app.Post("/mlflow/users/access-tokens/create", func(ctx *fiber.Ctx) error {
+		var input accessTokenInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		token, accessToken, err := store.CreateAccessToken(
+			utils.NewContextWithLoggerFromFiberContext(ctx), CurrentUser(ctx), input.Name,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"access_token": accessToken, "token": token})
+	})
+	app.Get("/mlflow/users/access-tokens/list", func(ctx *fiber.Ctx) error {
+		accessTokens, err := store.ListAccessTokens(utils.NewContextWithLoggerFromFiberContext(ctx), CurrentUser(ctx))
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"access_tokens": accessTokens})
+	})
+	app.Delete("/mlflow/users/access-tokens/delete", func(ctx *fiber.Ctx) error {
+		var input accessTokenInput
+		if err := parseInput(ctx, &input); err != nil {
+			return err
+		}
+
+		if err := store.DeleteAccessToken(
+			utils.NewContextWithLoggerFromFiberContext(ctx), CurrentUser(ctx), input.ID,
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+
+	registerExperimentPermissionRoutes(store, app)
+	registerRegisteredModelPermissionRoutes(store, app)
+}
+
+//nolint:funlen
+func registerExperimentPermissionRoutes(store *Store, app *fiber.App) {
+	parse := func(ctx *fiber.Ctx, withPermission bool) (*experimentPermissionInput, *contract.Error) {
+		var input experimentPermissionInput
+		if err := parseInput(ctx, &input); err != nil {
+			return nil, err
+		}
+
+		if err := requireParameters("experiment_id", input.ExperimentID, "username", input.Username); err != nil {
+			return nil, err
+		}
+
+		if withPermission {
+			if _, err := ParsePermission(input.Permission); err != nil {
+				return nil, err
+			}
+		}
+
+		return &input, nil
+	}
+
+	app.Post("/mlflow/experiments/permissions/create", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		experimentPermission, err := store.CreateExperimentPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx),
+			input.ExperimentID, input.Username, Permission(input.Permission),
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"experiment_permission": experimentPermission})
+	})
+	app.Get("/mlflow/experiments/permissions/get", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		experimentPermission, err := store.GetExperimentPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.ExperimentID, input.Username,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"experiment_permission": experimentPermission})
+	})
+	app.Patch("/mlflow/experiments/permissions/update", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		if err := store.UpdateExperimentPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx),
+			input.ExperimentID, input.Username, Permission(input.Permission),
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+	app.Delete("/mlflow/experiments/permissions/delete", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		if err := store.DeleteExperimentPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.ExperimentID, input.Username,
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+}
+
+//nolint:funlen
+func registerRegisteredModelPermissionRoutes(store *Store, app *fiber.App) {
+	parse := func(ctx *fiber.Ctx, withPermission bool) (*registeredModelPermissionInput, *contract.Error) {
+		var input registeredModelPermissionInput
+		if err := parseInput(ctx, &input); err != nil {
+			return nil, err
+		}
+
+		if err := requireParameters("name", input.Name, "username", input.Username); err != nil {
+			return nil, err
+		}
+
+		if withPermission {
+			if _, err := ParsePermission(input.Permission); err != nil {
+				return nil, err
+			}
+		}
+
+		return &input, nil
+	}
+
+	app.Post("/mlflow/registered-models/permissions/create", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		registeredModelPermission, err := store.CreateRegisteredModelPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx),
+			input.Name, input.Username, Permission(input.Permission),
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"registered_model_permission": registeredModelPermission})
+	})
+	app.Get("/mlflow/registered-models/permissions/get", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		registeredModelPermission, err := store.GetRegisteredModelPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Name, input.Username,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{"registered_model_permission": registeredModelPermission})
+	})
+	app.Patch("/mlflow/registered-models/permissions/update", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		if err := store.UpdateRegisteredModelPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx),
+			input.Name, input.Username, Permission(input.Permission),
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+	app.Delete("/mlflow/registered-models/permissions/delete", func(ctx *fiber.Ctx) error {
+		input, err := parse(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		if err := store.DeleteRegisteredModelPermission(
+			utils.NewContextWithLoggerFromFiberContext(ctx), input.Name, input.Username,
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(fiber.Map{})
+	})
+}
@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// rule protects the route matching its method and path,
+// paths are relative to the API root and support `:param` segments and a trailing `+` wildcard.
+type rule struct {
+	method    string
+	path      string
+	validator validator
+	// after runs once the route succeeded, to keep the permissions in sync or filter the response.
+	after func(r *request) error
+}
+
+var (
+	canRead   = Permission.CanRead
+	canUpdate = Permission.CanUpdate
+	canDelete = Permission.CanDelete
+	canManage = Permission.CanManage
+)
+
+// The API routes without a rule are only allowed to the admins, the ones open to every authenticated user
+// have a rule with the everyUser validator or an after hook filtering their response.
+//
+//nolint:funlen,lll
+func rules() []rule {
+	return []rule{
+		// Experiments.
+		{method: fiber.MethodPost, path: "/mlflow/experiments/create", after: grantExperimentCreator},
+		{method: fiber.MethodGet, path: "/mlflow/experiments/get", validator: requires(experimentPermission, canRead, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/experiments/get-by-name", validator: requires(experimentByNamePermission, canRead, "experiment_name")},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/delete", validator: requires(experimentPermission, canDelete, "experiment_id")},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/restore", validator: requires(experimentPermission, canDelete, "experiment_id")},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/update", validator: requires(experimentPermission, canUpdate, "experiment_id")},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/set-experiment-tag", validator: requires(experimentPermission, canUpdate, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/experiments/search", after: filterExperiments},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/search", after: filterExperiments},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/search-datasets", validator: requiresAll(experimentPermission, canRead, "experiment_ids")},
+		// Runs.
+		{method: fiber.MethodPost, path: "/mlflow/runs/create", validator: requires(experimentPermission, canUpdate, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/runs/get", validator: requires(runPermission, canRead, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/delete", validator: requires(runPermission, canDelete, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/restore", validator: requires(runPermission, canDelete, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/update", validator: requires(runPermission, canUpdate, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/log-metric", validator: requires(runPermission, canUpdate, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/log-parameter", validator: requires(runPermission, canUpdate, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/log-batch", validator: requires(runPermission, canUpdate, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/log-inputs", validator: requires(runPermission, canUpdate, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/outputs", validator: requires(runPermission, canUpdate, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/log-model", validator: requires(runPermission, canUpdate, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/set-tag", validator: requires(runPermission, canUpdate, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/delete-tag", validator: requires(runPermission, canUpdate, "run_id")},
+		{method: fiber.MethodPost, path: "/mlflow/runs/search", validator: requiresAll(experimentPermission, canRead, "experiment_ids")},
+		{method: fiber.MethodGet, path: "/mlflow/metrics/get-history", validator: requires(runPermission, canRead, "run_id", "run_uuid")},
+		{method: fiber.MethodGet, path: "/mlflow/metrics/get-history-bulk", validator: requiresAll(runPermission, canRead, "run_id")},
+		{method: fiber.MethodGet, path: "/mlflow/metrics/get-history-bulk-interval", validator: requiresAll(runPermission, canRead, "run_ids")},
+		{method: fiber.MethodGet, path: "/mlflow/artifacts/list", validator: requires(runPermission, canRead, "run_id", "run_uuid")},
+		{method: fiber.MethodGet, path: "/get-artifact", validator: requires(runPermission, canRead, "run_id", "run_uuid")},
+		{method: fiber.MethodPost, path: "/mlflow/upload-artifact", validator: requires(runPermission, canUpdate, "run_uuid", "run_id")},
+		{method: fiber.MethodGet, path: "/mlflow/gateway-proxy", validator: everyUser},
+		{method: fiber.MethodPost, path: "/mlflow/gateway-proxy", validator: everyUser},
+		// Traces.
+		{method: fiber.MethodPost, path: "/mlflow/traces", validator: requires(experimentPermission, canUpdate, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/traces", validator: requiresAll(experimentPermission, canRead, "experiment_ids")},
+		{method: fiber.MethodPost, path: "/mlflow/traces/delete-traces", validator: requires(experimentPermission, canDelete, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/traces/:request_id/info", validator: requires(tracePermission, canRead, "request_id")},
+		{method: fiber.MethodGet, path: "/mlflow/traces/:trace_id", validator: requires(tracePermission, canRead, "trace_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/traces/:request_id", validator: requires(tracePermission, canUpdate, "request_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/traces/:request_id/tags", validator: requires(tracePermission, canUpdate, "request_id")},
+		{method: fiber.MethodDelete, path: "/mlflow/traces/:request_id/tags", validator: requires(tracePermission, canUpdate, "request_id")},
+		{method: fiber.MethodPost, path: "/mlflow/traces/:trace_id/assessments", validator: requires(tracePermission, canUpdate, "trace_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/traces/:trace_id/assessments/:assessment_id", validator: requires(tracePermission, canUpdate, "trace_id")},
+		{method: fiber.MethodDelete, path: "/mlflow/traces/:trace_id/assessments/:assessment_id", validator: requires(tracePermission, canDelete, "trace_id")},
+		{method: fiber.MethodGet, path: "/mlflow/get-trace-artifact", validator: requires(tracePermission, canRead, "request_id")},
+		// Logged models.
+		{method: fiber.MethodPost, path: "/mlflow/logged-models", validator: requires(experimentPermission, canUpdate, "experiment_id")},
+		{method: fiber.MethodPost, path: "/mlflow/logged-models/search", validator: requiresAll(experimentPermission, canRead, "experiment_ids")},
+		{method: fiber.MethodGet, path: "/mlflow/logged-models/:model_id", validator: requires(loggedModelPermission, canRead, "model_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/logged-models/:model_id", validator: requires(loggedModelPermission, canUpdate, "model_id")},
+		{method: fiber.MethodDelete, path: "/mlflow/logged-models/:model_id", validator: requires(loggedModelPermission, canDelete, "model_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/logged-models/:model_id/tags", validator: requires(loggedModelPermission, canUpdate, "model_id")},
+		{method: fiber.MethodDelete, path: "/mlflow/logged-models/:model_id/tags/:tag_key", validator: requires(loggedModelPermission, canUpdate, "model_id")},
+		{method: fiber.MethodPost, path: "/mlflow/logged-models/:model_id/params", validator: requires(loggedModelPermission, canUpdate, "model_id")},
+		{method: fiber.MethodGet, path: "/mlflow/logged-models/:model_id/artifacts/directories", validator: requires(loggedModelPermission, canRead, "model_id")},
+		{method: fiber.MethodGet, path: "/mlflow/logged-models/:model_id/artifacts/files", validator: requires(loggedModelPermission, canRead, "model_id")},
+		// Registered models.
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/create", after: grantRegisteredModelCreator},
+		{method: fiber.MethodGet, path: "/mlflow/registered-models/get", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/registered-models/get-latest-versions", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/get-latest-versions", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodPatch, path: "/mlflow/registered-models/update", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/rename", validator: requires(registeredModelPermission, canUpdate, "name"), after: renameRegisteredModel},
+		{method: fiber.MethodDelete, path: "/mlflow/registered-models/delete", validator: requires(registeredModelPermission, canDelete, "name"), after: deleteRegisteredModel},
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/set-tag", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodDelete, path: "/mlflow/registered-models/delete-tag", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/registered-models/alias", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/alias", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodDelete, path: "/mlflow/registered-models/alias", validator: requires(registeredModelPermission, canDelete, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/registered-models/search", after: filterRegisteredModels},
+		// Model versions.
+		{method: fiber.MethodPost, path: "/mlflow/model-versions/create", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/model-versions/get", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/model-versions/get-download-uri", validator: requires(registeredModelPermission, canRead, "name")},
+		{method: fiber.MethodPatch, path: "/mlflow/model-versions/update", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodPost, path: "/mlflow/model-versions/transition-stage", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodDelete, path: "/mlflow/model-versions/delete", validator: requires(registeredModelPermission, canDelete, "name")},
+		{method: fiber.MethodPost, path: "/mlflow/model-versions/set-tag", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodDelete, path: "/mlflow/model-versions/delete-tag", validator: requires(registeredModelPermission, canUpdate, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/model-versions/search", after: filterModelVersions},
+		{method: fiber.MethodGet, path: "/model-versions/get-artifact", validator: requires(registeredModelPermission, canRead, "name")},
+		// Artifacts proxied by the server.
+		{method: fiber.MethodGet, path: "/mlflow-artifacts/artifacts", validator: requiresArtifact(canRead, "path")},
+		{method: fiber.MethodGet, path: "/mlflow-artifacts/artifacts/+", validator: requiresArtifact(canRead, "+")},
+		{method: fiber.MethodPut, path: "/mlflow-artifacts/artifacts/+", validator: requiresArtifact(canUpdate, "+")},
+		{method: fiber.MethodDelete, path: "/mlflow-artifacts/artifacts/+", validator: requiresArtifact(canDelete, "+")},
+		{method: fiber.MethodPost, path: "/mlflow-artifacts/mpu/create/+", validator: requiresArtifact(canUpdate, "+")},
+		{method: fiber.MethodPost, path: "/mlflow-artifacts/mpu/complete/+", validator: requiresArtifact(canUpdate, "+")},
+		{method: fiber.MethodPost, path: "/mlflow-artifacts/mpu/abort/+", validator: requiresArtifact(canUpdate, "+")},
+		{method: fiber.MethodPut, path: "/mlflow-artifacts/mpu/parts/:upload_id/:part_number", validator: requiresMultipartUpload(canUpdate, "upload_id")},
+		// Users, access tokens and permissions.
+		{method: fiber.MethodPost, path: "/mlflow/users/create", validator: adminOnly},
+		{method: fiber.MethodGet, path: "/mlflow/users/get", validator: sameUser},
+		{method: fiber.MethodPatch, path: "/mlflow/users/update-password", validator: sameUser},
+		{method: fiber.MethodPatch, path: "/mlflow/users/update-admin", validator: adminOnly},
+		{method: fiber.MethodDelete, path: "/mlflow/users/delete", validator: adminOnly},
+		{method: fiber.MethodPost, path: "/mlflow/users/access-tokens/create", validator: everyUser},
+		{method: fiber.MethodGet, path: "/mlflow/users/access-tokens/list", validator: everyUser},
+		{method: fiber.MethodDelete, path: "/mlflow/users/access-tokens/delete", validator: everyUser},
+		{method: fiber.MethodPost, path: "/mlflow/experiments/permissions/create", validator: requires(experimentPermission, canManage, "experiment_id")},
+		{method: fiber.MethodGet, path: "/mlflow/experiments/permissions/get", validator: requires(experimentPermission, canManage, "experiment_id")},
+		{method: fiber.MethodPatch, path: "/mlflow/experiments/permissions/update", validator: requires(experimentPermission, canManage, "experiment_id")},
+		{method: fiber.MethodDelete, path: "/mlflow/experiments/permissions/delete", validator: requires(experimentPermission, canManage, "experiment_id")},
+		{method: fiber.MethodPost, path: "/mlflow/registered-models/permissions/create", validator: requires(registeredModelPermission, canManage, "name")},
+		{method: fiber.MethodGet, path: "/mlflow/registered-models/permissions/get", validator: requires(registeredModelPermission, canManage, "name")},
+		{method: fiber.MethodPatch, path: "/mlflow/registered-models/permissions/update", validator: requires(registeredModelPermission, canManage, "name")},
+		{method: fiber.MethodDelete, path: "/mlflow/registered-models/permissions/delete", validator: requires(registeredModelPermission, canManage, "name")},
+	}
+}
+
+// match returns the parameters of the path when it matches the pattern.
+func match(pattern, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	params := map[string]string{}
+
+	for index, segment := range patternSegments {
+		switch {
+		case segment == "+":
+			if index >= len(pathSegments) {
+				return nil, false
+			}
+
+			value := strings.Join(pathSegments[index:], "/")
+			if unescaped, err := url.PathUnescape(value); err == nil {
+				value = unescaped
+			}
+
+			params[segment] = value
+
+			return params, true
+		case index >= len(pathSegments):
+			return nil, false
+		case strings.HasPrefix(segment, ":"):
+			params[segment[1:]] = pathSegments[index]
+		case segment != pathSegments[index]:
+			return nil, false
+		}
+	}
+
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	return params, true
+}
+
+func findRule(rules []rule, method, path string) (*rule, map[string]string) {
+	for index := range rules {
+		if rules[index].method != method {
+			continue
+		}
+
+		if params, ok := match(rules[index].path, path); ok {
+			return &rules[index], params
+		}
+	}
+
+	return nil, map[string]string{}
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var (
+	errFileStore       = errors.New("authentication requires a database tracking store")
+	errNoAdminPassword = errors.New("authentication is enabled but no admin password is configured")
+)
+
+// Store reads and writes the users, the access tokens and the permissions.
+type Store struct {
+	db                *gorm.DB
+	defaultPermission Permission
+	// certificateAuthentication authenticates the requests without credentials with their client certificate.
+	certificateAuthentication bool
+}
+
+func NewStore(ctx context.Context, config *config.Config) (*Store, error) {
+	if _, ok := utils.LocalPathFromURI(config.TrackingStoreURI); ok {
+		return nil, fmt.Errorf("%w, got %q", errFileStore, config.TrackingStoreURI)
+	}
+
+	defaultPermission, contractErr := ParsePermission(config.Auth.DefaultPermission)
+	if contractErr != nil {
+		return nil, fmt.Errorf("invalid default permission: %w", contractErr)
+	}
+
+	database, err := sql.NewDatabase(ctx, config.TrackingStoreURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database %q: %w", config.TrackingStoreURI, err)
+	}
+
+	if err := database.WithContext(ctx).AutoMigrate(tables()...); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create auth tables: %w", err), sql.CloseDatabase(database))
+	}
+
+	store := &Store{
+		db:                        database,
+		defaultPermission:         defaultPermission,
+		certificateAuthentication: config.Auth.CertificateAuthentication,
+	}
+
+	if err := store.ensureAdminUser(ctx, config.Auth); err != nil {
+		return nil, errors.Join(err, sql.CloseDatabase(database))
+	}
+
+	return store, nil
+}
+
+// DB returns the database of the store, for the stats of its connection pool.
+func (s Store) DB() *gorm.DB {
+	return s.db
+}
+
+func (s Store) Destroy() error {
+	if err := sql.CloseDatabase(s.db); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+
+	return nil
+}
+
+func (s Store) ensureAdminUser(ctx context.Context, config config.AuthConfig) error {
+	_, contractErr := s.GetUser(ctx, config.AdminUsername)
+	if contractErr == nil {
+		return nil
+	}
+
+	if protos.ErrorCode(contractErr.Code) != protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+		return contractErr
+	}
+
+	if config.AdminPassword == "" {
+		return errNoAdminPassword
+	}
+
+	utils.GetLoggerFromContext(ctx).Infof("Creating the admin user %q", config.AdminUsername)
+
+	if _, contractErr := s.CreateUser(ctx, config.AdminUsername, config.AdminPassword, true); contractErr != nil {
+		return contractErr
+	}
+
+	return nil
+}
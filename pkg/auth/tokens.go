@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const tokenBytes = 32
+
+var errInvalidAuthToken = errors.New("invalid access token")
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// AuthenticateToken returns the user owning the access token.
+func (s Store) AuthenticateToken(ctx context.Context, token string) (*User, *contract.Error) {
+	var user User
+	if err := s.db.WithContext(ctx).
+		Joins("JOIN access_tokens ON access_tokens.user_id = users.id").
+		Where("access_tokens.token_hash = ?", hashToken(token)).
+		First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(protos.ErrorCode_UNAUTHENTICATED, errInvalidAuthToken.Error())
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get access token", err)
+	}
+
+	return &user, nil
+}
+
+// CreateAccessToken creates an API token for a user, the token itself is only returned here.
+func (s Store) CreateAccessToken(
+	ctx context.Context, user *User, name string,
+) (string, *AccessToken, *contract.Error) {
+	if name == "" {
+		return "", nil, contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Token name cannot be empty")
+	}
+
+	secret := make([]byte, tokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to generate access token", err)
+	}
+
+	token := hex.EncodeToString(secret)
+	accessToken := AccessToken{
+		UserID:       user.ID,
+		Name:         name,
+		TokenHash:    hashToken(token),
+		CreationTime: time.Now().UnixMilli(),
+	}
+
+	if err := s.db.WithContext(ctx).Create(&accessToken).Error; err != nil {
+		return "", nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create access token", err)
+	}
+
+	return token, &accessToken, nil
+}
+
+func (s Store) ListAccessTokens(ctx context.Context, user *User) ([]AccessToken, *contract.Error) {
+	var accessTokens []AccessToken
+	if err := s.db.WithContext(ctx).Where("user_id = ?", user.ID).Order("id").Find(&accessTokens).Error; err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to list access tokens", err)
+	}
+
+	return accessTokens, nil
+}
+
+func (s Store) DeleteAccessToken(ctx context.Context, user *User, id int32) *contract.Error {
+	result := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, user.ID).Delete(&AccessToken{})
+	if result.Error != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to delete access token", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Access token with id=%d not found", id),
+		)
+	}
+
+	return nil
+}
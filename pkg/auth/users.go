@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+var errInvalidUser = errors.New("invalid username or password")
+
+func hashPassword(password string) (string, *contract.Error) {
+	if password == "" {
+		return "", contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Password cannot be empty")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", contract.NewErrorWith(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Invalid password", err)
+	}
+
+	return string(hash), nil
+}
+
+func (s Store) CreateUser(
+	ctx context.Context, username, password string, isAdmin bool,
+) (*User, *contract.Error) {
+	if username == "" {
+		return nil, contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Username cannot be empty")
+	}
+
+	passwordHash, contractErr := hashPassword(password)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	user := User{
+		Username:     username,
+		PasswordHash: passwordHash,
+		IsAdmin:      isAdmin,
+	}
+	if err := s.db.WithContext(ctx).Create(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+				fmt.Sprintf("User %q already exists", username),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create user", err)
+	}
+
+	return &user, nil
+}
+
+func (s Store) GetUser(ctx context.Context, username string) (*User, *contract.Error) {
+	var user User
+	if err := s.db.WithContext(ctx).Where("username = ?", username).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("User with username=%s not found", username),
+			)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get user", err)
+	}
+
+	return &user, nil
+}
+
+// AuthenticateUser returns the user matching the basic auth credentials.
+func (s Store) AuthenticateUser(ctx context.Context, username, password string) (*User, *contract.Error) {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		if protos.ErrorCode(contractErr.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+			return nil, contract.NewErrorWith(protos.ErrorCode_UNAUTHENTICATED, errInvalidUser.Error(), contractErr)
+		}
+
+		return nil, contractErr
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_UNAUTHENTICATED, errInvalidUser.Error(), err)
+	}
+
+	return user, nil
+}
+
+func (s Store) UpdateUserPassword(ctx context.Context, username, password string) *contract.Error {
+	passwordHash, contractErr := hashPassword(password)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	return s.updateUser(ctx, username, "password_hash", passwordHash)
+}
+
+func (s Store) UpdateUserAdmin(ctx context.Context, username string, isAdmin bool) *contract.Error {
+	return s.updateUser(ctx, username, "is_admin", isAdmin)
+}
+
+func (s Store) updateUser(ctx context.Context, username, column string, value any) *contract.Error {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Model(user).Update(column, value).Error; err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to update user", err)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a user along with its access tokens and permissions.
+func (s Store) DeleteUser(ctx context.Context, username string) *contract.Error {
+	user, contractErr := s.GetUser(ctx, username)
+	if contractErr != nil {
+		return contractErr
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		for _, table := range []any{&AccessToken{}, &ExperimentPermission{}, &RegisteredModelPermission{}} {
+			if err := transaction.Where("user_id = ?", user.ID).Delete(table).Error; err != nil {
+				return fmt.Errorf("failed to delete user references: %w", err)
+			}
+		}
+
+		if err := transaction.Delete(user).Error; err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to delete user", err)
+	}
+
+	return nil
+}
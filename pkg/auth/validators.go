@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+)
+
+// validator reports whether the user of a request is allowed to run it, admins are never validated.
+type validator func(r *request) (bool, *contract.Error)
+
+// lookup returns the permission of the user of a request on a resource.
+type lookup func(r *request, id string) (Permission, *contract.Error)
+
+func experimentPermission(r *request, experimentID string) (Permission, *contract.Error) {
+	return r.store.ExperimentPermissionOf(r.context, experimentID, r.user)
+}
+
+func experimentByNamePermission(r *request, name string) (Permission, *contract.Error) {
+	experimentID, err := r.store.ExperimentIDByName(r.context, name)
+	if err != nil {
+		return "", err
+	}
+
+	return experimentPermission(r, experimentID)
+}
+
+// The runs, traces and logged models have the permission of their experiment.
+
+func runPermission(r *request, runID string) (Permission, *contract.Error) {
+	experimentID, err := r.store.ExperimentIDOfRun(r.context, runID)
+	if err != nil {
+		return "", err
+	}
+
+	return experimentPermission(r, experimentID)
+}
+
+func tracePermission(r *request, requestID string) (Permission, *contract.Error) {
+	experimentID, err := r.store.ExperimentIDOfTrace(r.context, requestID)
+	if err != nil {
+		return "", err
+	}
+
+	return experimentPermission(r, experimentID)
+}
+
+func loggedModelPermission(r *request, modelID string) (Permission, *contract.Error) {
+	experimentID, err := r.store.ExperimentIDOfLoggedModel(r.context, modelID)
+	if err != nil {
+		return "", err
+	}
+
+	return experimentPermission(r, experimentID)
+}
+
+func registeredModelPermission(r *request, name string) (Permission, *contract.Error) {
+	return r.store.RegisteredModelPermissionOf(r.context, name, r.user)
+}
+
+var experimentArtifactPath = regexp.MustCompile(`^(\d+)/`)
+
+// The artifacts proxied by the server have the permission of the experiment their path starts with,
+// which is the case of the run artifacts, and the default permission otherwise.
+func artifactPermission(r *request, artifactPath string) (Permission, *contract.Error) {
+	match := experimentArtifactPath.FindStringSubmatch(strings.TrimPrefix(artifactPath, "/"))
+	if match == nil {
+		return r.store.defaultPermission, nil
+	}
+
+	return experimentPermission(r, match[1])
+}
+
+// requires validates the resource identified by the first parameter set among names,
+// they are aliases like run_id and run_uuid.
+func requires(lookup lookup, allowed func(Permission) bool, names ...string) validator {
+	return func(r *request) (bool, *contract.Error) {
+		id, err := r.value(names...)
+		if err != nil {
+			return false, err
+		}
+
+		permission, err := lookup(r, id)
+		if err != nil {
+			return false, err
+		}
+
+		return allowed(permission), nil
+	}
+}
+
+// requiresAll validates every value of a list parameter, like the experiments of a search.
+func requiresAll(lookup lookup, allowed func(Permission) bool, name string) validator {
+	return func(r *request) (bool, *contract.Error) {
+		for _, id := range r.values(name) {
+			permission, err := lookup(r, id)
+			if err != nil {
+				return false, err
+			}
+
+			if !allowed(permission) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// requiresArtifact validates the artifact path, which is optional when listing the root artifacts.
+func requiresArtifact(allowed func(Permission) bool, name string) validator {
+	return func(r *request) (bool, *contract.Error) {
+		artifactPath, _ := r.value(name)
+
+		permission, err := artifactPermission(r, artifactPath)
+		if err != nil {
+			return false, err
+		}
+
+		return allowed(permission), nil
+	}
+}
+
+// requiresMultipartUpload validates the artifact path of the multipart upload of a part.
+func requiresMultipartUpload(allowed func(Permission) bool, name string) validator {
+	return func(r *request) (bool, *contract.Error) {
+		uploadID, err := r.value(name)
+		if err != nil {
+			return false, err
+		}
+
+		artifactPath, err := r.uploadPath(r.context, uploadID)
+		if err != nil {
+			return false, err
+		}
+
+		permission, err := artifactPermission(r, artifactPath)
+		if err != nil {
+			return false, err
+		}
+
+		return allowed(permission), nil
+	}
+}
+
+func adminOnly(*request) (bool, *contract.Error) {
+	return false, nil
+}
+
+// everyUser allows the routes acting on the resources of the authenticated user, like its access tokens.
+func everyUser(*request) (bool, *contract.Error) {
+	return true, nil
+}
+
+func sameUser(r *request) (bool, *contract.Error) {
+	username, err := r.value("username")
+	if err != nil {
+		return false, err
+	}
+
+	return username == r.user.Username, nil
+}
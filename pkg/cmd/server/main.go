@@ -1,6 +1,8 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -10,9 +12,13 @@ import (
 )
 
 func main() {
-	cfg, err := config.NewConfigFromString(os.Getenv("MLFLOW_GO_CONFIG"))
+	cfg, err := config.Load(os.Args[1:], os.Environ())
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+
 	if err != nil {
-		logrus.Fatal("Failed to read config from MLFLOW_GO_CONFIG environment variable: ", err)
+		logrus.Fatal("Failed to load config: ", err)
 	}
 
 	if err := server.LaunchWithSignalHandler(cfg); err != nil {
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -20,6 +22,8 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	}
 
 	switch value := v.(type) {
+	case nil:
+		return nil
 	case float64:
 		d.Duration = time.Duration(value)
 
@@ -38,18 +42,160 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 	}
 }
 
+// ByteSize is a number of bytes, given as an integer or a string with a unit like "16MiB" or "512KB".
+type ByteSize int
+
+var (
+	ErrByteSize = errors.New("invalid byte size")
+	byteUnits   = map[string]int{
+		"": 1, "B": 1,
+		"KB": 1000, "MB": 1000 * 1000, "GB": 1000 * 1000 * 1000,
+		"KiB": 1 << 10, "MiB": 1 << 20, "GiB": 1 << 30,
+	}
+)
+
+func parseByteSize(value string) (ByteSize, error) {
+	value = strings.TrimSpace(value)
+	number, unit := value, ""
+
+	if index := strings.IndexFunc(value, func(r rune) bool { return r < '0' || r > '9' }); index >= 0 {
+		number, unit = value[:index], strings.TrimSpace(value[index:])
+	}
+
+	multiplier, ok := byteUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("%w %q: unknown unit %q", ErrByteSize, value, unit)
+	}
+
+	size, err := strconv.Atoi(number)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q: %w", ErrByteSize, value, err)
+	}
+
+	return ByteSize(size * multiplier), nil
+}
+
+func (s *ByteSize) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("failed to unmarshal byte size: %w", err)
+	}
+
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case float64:
+		*s = ByteSize(value)
+
+		return nil
+	case string:
+		size, err := parseByteSize(value)
+		if err != nil {
+			return err
+		}
+
+		*s = size
+
+		return nil
+	default:
+		return ErrByteSize
+	}
+}
+
+// RouteConfig overrides the limits of the HTTP server for the requests matching Method and Path,
+// the zero values keep the limits of the server.
+type RouteConfig struct {
+	// Method matches any method when it is empty.
+	Method string `json:"method"`
+	// Path is relative to the API prefixes /api/2.0 and /ajax-api/2.0 for the API routes,
+	// a trailing * matches any suffix.
+	Path string `json:"path"`
+	// BodyLimit also applies to the artifact uploads, which have no body limit otherwise.
+	BodyLimit    ByteSize `json:"body_limit"`
+	ReadTimeout  Duration `json:"read_timeout"`
+	WriteTimeout Duration `json:"write_timeout"`
+}
+
+// HTTPConfig tunes the HTTP server, the read timeout covers the headers of a request
+// and the one of its route then applies to its body.
+type HTTPConfig struct {
+	// BodyLimit is the maximum size of the request bodies, larger bodies are rejected
+	// except the artifact uploads which are streamed.
+	BodyLimit        ByteSize `json:"body_limit"`
+	ReadBufferSize   ByteSize `json:"read_buffer_size"`
+	WriteBufferSize  ByteSize `json:"write_buffer_size"`
+	ReadTimeout      Duration `json:"read_timeout"`
+	WriteTimeout     Duration `json:"write_timeout"`
+	IdleTimeout      Duration `json:"idle_timeout"`
+	DisableKeepalive bool     `json:"disable_keepalive"`
+	// Concurrency is the maximum number of connections served at once.
+	Concurrency int `json:"concurrency"`
+	// Routes are matched in order, the first matching one applies. They default to longer
+	// read timeouts for LogBatch and the artifact uploads when they are not set.
+	Routes []RouteConfig `json:"routes"`
+}
+
+// AuthConfig configures the authentication of the server,
+// the admin user is created on startup when it does not exist yet.
+type AuthConfig struct {
+	Enabled       bool   `json:"enabled"`
+	AdminUsername string `json:"admin_username"`
+	AdminPassword string `json:"admin_password"`
+	// DefaultPermission applies to the experiments and registered models a user has no permission on.
+	DefaultPermission string `json:"default_permission"`
+	// CertificateAuthentication authenticates the requests without credentials as the user named after
+	// the common name of their verified client certificate.
+	CertificateAuthentication bool `json:"certificate_authentication"`
+}
+
+// TLSConfig serves the HTTP and gRPC servers over TLS when CertFile and KeyFile are set,
+// the files are reloaded every ReloadInterval when they changed.
+type TLSConfig struct {
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// ClientCAFile is the PEM bundle of the CAs verifying the client certificates.
+	ClientCAFile string `json:"client_ca_file"`
+	// ClientAuth is require to reject the clients without a valid certificate, optional to only verify
+	// the certificates given, or none. It defaults to require when ClientCAFile is set.
+	ClientAuth     string   `json:"client_auth"`
+	ReloadInterval Duration `json:"reload_interval"`
+}
+
+// TracingConfig configures the export of the OpenTelemetry traces of the server,
+// the spans are not recorded without an exporter.
+type TracingConfig struct {
+	// Exporter is otlp to send the spans to a collector, or file to write them to File as JSON.
+	Exporter string `json:"exporter"`
+	// Endpoint is the URL of the OTLP collector, OTEL_EXPORTER_OTLP_ENDPOINT applies when it is empty.
+	Endpoint    string `json:"endpoint"`
+	File        string `json:"file"`
+	ServiceName string `json:"service_name"`
+}
+
 type Config struct {
-	Address               string                 `json:"address"`
-	DefaultArtifactRoot   string                 `json:"default_artifact_root"`
-	LogLevel              string                 `json:"log_level"`
-	ModelRegistryStoreURI string                 `json:"model_registry_store_uri"`
+	Address              string     `json:"address"`
+	Auth                 AuthConfig `json:"auth"`
+	ArtifactsDestination string     `json:"artifacts_destination"`
+	DefaultArtifactRoot  string     `json:"default_artifact_root"`
+	ExposePrometheus     bool       `json:"expose_prometheus"`
+	GRPCAddress          string     `json:"grpc_address"`
+	HTTP                 HTTPConfig `json:"http"`
+	LogLevel             string     `json:"log_level"`
+	// MigrateDatabase creates or upgrades the schema of the SQL stores on startup, it is otherwise only checked.
+	MigrateDatabase       bool   `json:"migrate_database"`
+	ModelRegistryStoreURI string `json:"model_registry_store_uri"`
+	// MultipartUploadExpiry aborts the multipart uploads started by this server and left unfinished
+	// for longer, the cleanup is disabled when it is zero.
+	MultipartUploadExpiry Duration               `json:"multipart_upload_expiry"`
 	PythonEnv             []string               `json:"python_env"`
 	PythonAddress         string                 `json:"python_address"`
 	PythonCommand         []string               `json:"python_command"`
 	PythonTestsENV        map[string]interface{} `json:"python_tests_env"`
 	ShutdownTimeout       Duration               `json:"shutdown_timeout"`
 	StaticFolder          string                 `json:"static_folder"`
+	TLS                   TLSConfig              `json:"tls"`
 	TrackingStoreURI      string                 `json:"tracking_store_uri"`
+	Tracing               TracingConfig          `json:"tracing"`
 	Version               string                 `json:"version"`
 }
 
@@ -77,10 +223,20 @@ func (c *Config) applyDefaults() {
 		c.Address = "localhost:5000"
 	}
 
+	if c.Auth.AdminUsername == "" {
+		c.Auth.AdminUsername = "admin"
+	}
+
+	if c.Auth.DefaultPermission == "" {
+		c.Auth.DefaultPermission = "READ"
+	}
+
 	if c.DefaultArtifactRoot == "" {
 		c.DefaultArtifactRoot = "mlflow-artifacts:/"
 	}
 
+	c.HTTP.applyDefaults()
+
 	if c.LogLevel == "" {
 		c.LogLevel = "INFO"
 	}
@@ -89,6 +245,22 @@ func (c *Config) applyDefaults() {
 		c.ShutdownTimeout.Duration = time.Minute
 	}
 
+	if c.TLS.ClientAuth == "" {
+		if c.TLS.ClientCAFile != "" {
+			c.TLS.ClientAuth = "require"
+		} else {
+			c.TLS.ClientAuth = "none"
+		}
+	}
+
+	if c.TLS.ReloadInterval.Duration == 0 {
+		c.TLS.ReloadInterval.Duration = 10 * time.Second
+	}
+
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = "mlflow"
+	}
+
 	if c.TrackingStoreURI == "" {
 		if c.ModelRegistryStoreURI != "" {
 			c.TrackingStoreURI = c.ModelRegistryStoreURI
@@ -105,3 +277,41 @@ func (c *Config) applyDefaults() {
 		c.Version = "dev"
 	}
 }
+
+//nolint:mnd
+func (c *HTTPConfig) applyDefaults() {
+	if c.BodyLimit == 0 {
+		c.BodyLimit = 16 << 20
+	}
+
+	if c.ReadBufferSize == 0 {
+		c.ReadBufferSize = 16 << 10
+	}
+
+	if c.WriteBufferSize == 0 {
+		c.WriteBufferSize = 4 << 10
+	}
+
+	if c.ReadTimeout.Duration == 0 {
+		c.ReadTimeout.Duration = 5 * time.Second
+	}
+
+	if c.WriteTimeout.Duration == 0 {
+		c.WriteTimeout.Duration = 600 * time.Second
+	}
+
+	if c.IdleTimeout.Duration == 0 {
+		c.IdleTimeout.Duration = 120 * time.Second
+	}
+
+	if c.Concurrency == 0 {
+		c.Concurrency = 256 * 1024
+	}
+
+	if c.Routes == nil {
+		c.Routes = []RouteConfig{
+			{Method: "POST", Path: "/mlflow/runs/log-batch", ReadTimeout: Duration{time.Minute}},
+			{Method: "PUT", Path: "/mlflow-artifacts/*", ReadTimeout: Duration{10 * time.Minute}},
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envPrefix = "MLFLOW_GO_"
+	// envConfig holds the whole config as JSON, like the config given by the Python server.
+	envConfig = "MLFLOW_GO_CONFIG"
+	// envConfigFile is the path of the config file when the --config flag is not set.
+	envConfigFile = "MLFLOW_GO_CONFIG_FILE"
+)
+
+var (
+	ErrConfigFile = errors.New("unsupported config file")
+	durationType  = reflect.TypeOf(Duration{})
+	byteSizeType  = reflect.TypeOf(ByteSize(0))
+)
+
+// setting is a field of the config which can be set with an environment variable and a command-line flag,
+// both named after its JSON keys: auth.admin_username is MLFLOW_GO_AUTH_ADMIN_USERNAME and --auth-admin-username.
+type setting struct {
+	keys []string
+	typ  reflect.Type
+}
+
+func (s setting) envName() string {
+	return envPrefix + strings.ToUpper(strings.Join(s.keys, "_"))
+}
+
+func (s setting) flagName() string {
+	return strings.ReplaceAll(strings.Join(s.keys, "-"), "_", "-")
+}
+
+// value converts the string of an environment variable or a flag to the JSON value of the field,
+// lists are comma separated.
+func (s setting) value(raw string) (any, error) {
+	switch {
+	case s.typ == durationType, s.typ == byteSizeType, s.typ.Kind() == reflect.String:
+		return raw, nil
+	case s.typ.Kind() == reflect.Int:
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q: %w", raw, err)
+		}
+
+		return value, nil
+	case s.typ.Kind() == reflect.Bool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q: %w", raw, err)
+		}
+
+		return value, nil
+	default:
+		if raw == "" {
+			return []string{}, nil
+		}
+
+		return strings.Split(raw, ","), nil
+	}
+}
+
+// settings lists the fields of the config which are strings, booleans, integers, durations, byte sizes
+// or lists of strings.
+func settings(typ reflect.Type, keys []string) []setting {
+	result := make([]setting, 0, typ.NumField())
+
+	for index := range typ.NumField() {
+		field := typ.Field(index)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fieldKeys := append(append([]string{}, keys...), name)
+
+		switch {
+		case field.Type == durationType:
+			result = append(result, setting{fieldKeys, field.Type})
+		case field.Type.Kind() == reflect.Struct:
+			result = append(result, settings(field.Type, fieldKeys)...)
+		case field.Type.Kind() == reflect.String, field.Type.Kind() == reflect.Bool, field.Type.Kind() == reflect.Int,
+			field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+			result = append(result, setting{fieldKeys, field.Type})
+		}
+	}
+
+	return result
+}
+
+// layer holds the values of a configuration source, with the structure of the JSON config.
+type layer map[string]any
+
+func (l layer) set(keys []string, value any) {
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := l[key].(map[string]any)
+		if !ok {
+			nested = make(map[string]any)
+			l[key] = nested
+		}
+
+		l = nested
+	}
+
+	l[keys[len(keys)-1]] = value
+}
+
+// apply overrides the fields of the config set in the layer.
+func (c *Config) apply(l layer) error {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	return nil
+}
+
+func readConfigFile(path string) (layer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	values := make(layer)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	case ".json":
+		err = json.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("%w %q, expected a .yaml, .yml, .toml or .json file", ErrConfigFile, path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %q: %w", path, err)
+	}
+
+	return values, nil
+}
+
+// Load builds the config of the standalone server from its sources, each one overriding the previous ones:
+// the config file (--config or MLFLOW_GO_CONFIG_FILE), the JSON config of MLFLOW_GO_CONFIG,
+// the MLFLOW_GO_* environment variables, then the command-line flags. The defaults fill the fields left unset.
+func Load(args, environ []string) (*Config, error) {
+	env := make(map[string]string, len(environ))
+
+	for _, variable := range environ {
+		if name, value, ok := strings.Cut(variable, "="); ok {
+			env[name] = value
+		}
+	}
+
+	settings := settings(reflect.TypeOf(Config{}), nil)
+	flags := make(layer)
+	flagSet := flag.NewFlagSet("mlflow-go", flag.ContinueOnError)
+	configFile := flagSet.String("config", env[envConfigFile], "path of a YAML, TOML or JSON config file")
+
+	for _, setting := range settings {
+		set := func(raw string) error {
+			value, err := setting.value(raw)
+			if err != nil {
+				return err
+			}
+
+			flags.set(setting.keys, value)
+
+			return nil
+		}
+
+		usage := fmt.Sprintf("sets %s, overrides %s", strings.Join(setting.keys, "."), setting.envName())
+
+		if setting.typ.Kind() == reflect.Bool {
+			flagSet.BoolFunc(setting.flagName(), usage, set)
+		} else {
+			flagSet.Func(setting.flagName(), usage, set)
+		}
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, fmt.Errorf("failed to parse command-line flags: %w", err)
+	}
+
+	var cfg Config
+
+	if *configFile != "" {
+		values, err := readConfigFile(*configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cfg.apply(values); err != nil {
+			return nil, fmt.Errorf("invalid config file %q: %w", *configFile, err)
+		}
+	}
+
+	if data := env[envConfig]; data != "" {
+		if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", envConfig, err)
+		}
+	}
+
+	variables := make(layer)
+
+	for _, setting := range settings {
+		raw, ok := env[setting.envName()]
+		if !ok {
+			continue
+		}
+
+		value, err := setting.value(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", setting.envName(), err)
+		}
+
+		variables.set(setting.keys, value)
+	}
+
+	for _, values := range []layer{variables, flags} {
+		if err := cfg.apply(values); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.applyDefaults()
+
+	return &cfg, nil
+}
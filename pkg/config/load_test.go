@@ -0,0 +1,181 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, "config.yaml", `
+address: localhost:6000
+log_level: DEBUG
+shutdown_timeout: 30s
+python_command: [mlflow, server]
+auth:
+  enabled: true
+  admin_password: file
+`)
+
+	cfg, err := config.Load(
+		[]string{"--config", path, "--log-level", "WARN", "--auth-enabled=false"},
+		[]string{
+			"MLFLOW_GO_ADDRESS=localhost:7000",
+			"MLFLOW_GO_LOG_LEVEL=ERROR",
+			"MLFLOW_GO_AUTH_ADMIN_PASSWORD=env",
+			"MLFLOW_GO_PYTHON_ENV=A=1,B=2",
+		},
+	)
+	require.NoError(t, err)
+
+	// The environment variables override the file, the flags override both.
+	assert.Equal(t, "localhost:7000", cfg.Address)
+	assert.Equal(t, "WARN", cfg.LogLevel)
+	assert.False(t, cfg.Auth.Enabled)
+	assert.Equal(t, "env", cfg.Auth.AdminPassword)
+	assert.Equal(t, 30*time.Second, cfg.ShutdownTimeout.Duration)
+	assert.Equal(t, []string{"mlflow", "server"}, cfg.PythonCommand)
+	assert.Equal(t, []string{"A=1", "B=2"}, cfg.PythonEnv)
+
+	// The defaults fill the fields left unset.
+	assert.Equal(t, "admin", cfg.Auth.AdminUsername)
+	assert.Equal(t, "sqlite:///mlflow.db", cfg.TrackingStoreURI)
+}
+
+func TestLoadTOML(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, "config.toml", `
+tracking_store_uri = "postgresql://localhost/mlflow"
+
+[tracing]
+exporter = "otlp"
+`)
+
+	cfg, err := config.Load(nil, []string{
+		"MLFLOW_GO_CONFIG_FILE=" + path,
+		`MLFLOW_GO_CONFIG={"log_level": "DEBUG"}`,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "postgresql://localhost/mlflow", cfg.ModelRegistryStoreURI)
+	assert.Equal(t, "otlp", cfg.Tracing.Exporter)
+	assert.Equal(t, "DEBUG", cfg.LogLevel)
+}
+
+func TestLoadHTTP(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, "config.yaml", `
+http:
+  body_limit: 64MiB
+  routes:
+    - method: POST
+      path: /mlflow/runs/log-batch
+      body_limit: 256MB
+      read_timeout: 2m
+`)
+
+	cfg, err := config.Load(
+		[]string{"--config", path, "--http-read-timeout", "30s"},
+		[]string{"MLFLOW_GO_HTTP_CONCURRENCY=1024", "MLFLOW_GO_HTTP_WRITE_BUFFER_SIZE=8KiB"},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, config.ByteSize(64<<20), cfg.HTTP.BodyLimit)
+	assert.Equal(t, config.ByteSize(8<<10), cfg.HTTP.WriteBufferSize)
+	assert.Equal(t, config.ByteSize(16<<10), cfg.HTTP.ReadBufferSize)
+	assert.Equal(t, 30*time.Second, cfg.HTTP.ReadTimeout.Duration)
+	assert.Equal(t, 1024, cfg.HTTP.Concurrency)
+	assert.Equal(t, []config.RouteConfig{{
+		Method:      "POST",
+		Path:        "/mlflow/runs/log-batch",
+		BodyLimit:   256_000_000,
+		ReadTimeout: config.Duration{Duration: 2 * time.Minute},
+	}}, cfg.HTTP.Routes)
+
+	_, err = config.Load(nil, []string{"MLFLOW_GO_HTTP_BODY_LIMIT=16 parsecs"})
+	require.ErrorIs(t, err, config.ErrByteSize)
+}
+
+func TestLoadUnsupportedFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := config.Load([]string{"--config", writeFile(t, "config.ini", "")}, nil)
+	require.ErrorIs(t, err, config.ErrConfigFile)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Load([]string{
+		"--address", "localhost",
+		"--log-level", "LOUD",
+		"--tracking-store-uri", "databricks://profile",
+		"--grpc-address", "localhost:port",
+		"--http-idle-timeout", "-1s",
+		"--tls-cert-file", "server.crt",
+	}, nil)
+	require.NoError(t, err)
+
+	err = cfg.Validate()
+	require.Error(t, err)
+
+	fields := make([]string, 0)
+
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() { //nolint:errorlint,forcetypeassert
+		var fieldError *config.FieldError
+		if errors.As(err, &fieldError) {
+			fields = append(fields, fieldError.Field)
+		}
+	}
+
+	// The model registry store defaults to the tracking one.
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"address", "log_level", "tracking_store_uri", "model_registry_store_uri", "grpc_address", "http.idle_timeout",
+			"tls",
+		},
+		fields,
+	)
+
+	valid, err := config.NewConfigFromString(`{"tracking_store_uri": "mysql+pymysql://localhost/mlflow"}`)
+	require.NoError(t, err)
+	require.NoError(t, valid.Validate())
+}
+
+func TestValidateInMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Load([]string{"--tracking-store-uri", "sqlite:///:memory:"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+
+	// The requests forwarded to the Python server would not see the in-memory database of the Go stores.
+	cfg.PythonAddress = "localhost:5001"
+
+	var fieldError *config.FieldError
+
+	require.ErrorAs(t, cfg.Validate(), &fieldError)
+	assert.Equal(t, "tracking_store_uri", fieldError.Field)
+	assert.ErrorContains(t, cfg.Validate(), "model_registry_store_uri")
+}
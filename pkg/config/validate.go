@@ -0,0 +1,219 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FieldError reports an invalid field of the config, named after its JSON keys.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+var (
+	// The schemes of the database URIs, with an optional driver like mysql+pymysql, and of the file stores.
+	storeSchemes = []string{"", "file", "mssql", "mysql", "postgres", "postgresql", "sqlite"}
+	// The permissions of pkg/auth, which depends on this package.
+	permissions      = []string{"READ", "EDIT", "MANAGE", "NO_PERMISSIONS"}
+	tracingExporters = []string{"", "otlp", "file"}
+	clientAuths      = []string{"none", "optional", "require"}
+)
+
+func validateAddress(field, address string) *FieldError {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return &FieldError{field, fmt.Sprintf("malformed address %q, expected host:port", address)}
+	}
+
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return &FieldError{field, fmt.Sprintf("invalid port %q", port)}
+	}
+
+	return nil
+}
+
+func validateStoreURI(field, uri string) *FieldError {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return &FieldError{field, fmt.Sprintf("malformed store URI %q", uri)}
+	}
+
+	scheme, _, _ := strings.Cut(parsed.Scheme, "+")
+
+	// Windows paths like C:\mlruns are parsed with their drive as scheme.
+	if len(scheme) == 1 || slices.Contains(storeSchemes, scheme) {
+		return nil
+	}
+
+	return &FieldError{field, fmt.Sprintf("unsupported store URI scheme %q", parsed.Scheme)}
+}
+
+// IsInMemoryStoreURI reports whether the store URI points to an in-memory SQLite database.
+func IsInMemoryStoreURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+
+	scheme, _, _ := strings.Cut(parsed.Scheme, "+")
+
+	return scheme == "sqlite" && parsed.Path == "/:memory:"
+}
+
+func validateHTTP(cfg HTTPConfig) []*FieldError {
+	var errs []*FieldError
+
+	limits := []struct {
+		field string
+		value int
+	}{
+		{"http.body_limit", int(cfg.BodyLimit)},
+		{"http.read_buffer_size", int(cfg.ReadBufferSize)},
+		{"http.write_buffer_size", int(cfg.WriteBufferSize)},
+		{"http.concurrency", cfg.Concurrency},
+		{"http.read_timeout", int(cfg.ReadTimeout.Duration)},
+		{"http.write_timeout", int(cfg.WriteTimeout.Duration)},
+		{"http.idle_timeout", int(cfg.IdleTimeout.Duration)},
+	}
+
+	for _, limit := range limits {
+		if limit.value < 0 {
+			errs = append(errs, &FieldError{limit.field, "must not be negative"})
+		}
+	}
+
+	for index, route := range cfg.Routes {
+		field := fmt.Sprintf("http.routes[%d]", index)
+
+		if !strings.HasPrefix(route.Path, "/") {
+			errs = append(errs, &FieldError{field + ".path", fmt.Sprintf("expected an absolute path, got %q", route.Path)})
+		}
+
+		if route.BodyLimit < 0 || route.ReadTimeout.Duration < 0 || route.WriteTimeout.Duration < 0 {
+			errs = append(errs, &FieldError{field, "limits must not be negative"})
+		}
+	}
+
+	return errs
+}
+
+func validateTLS(cfg TLSConfig, auth AuthConfig) []*FieldError {
+	var errs []*FieldError
+
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		errs = append(errs, &FieldError{"tls", "cert_file and key_file must be set together"})
+	}
+
+	if cfg.ClientCAFile != "" && cfg.CertFile == "" {
+		errs = append(errs, &FieldError{"tls.client_ca_file", "requires cert_file and key_file"})
+	}
+
+	switch {
+	case !slices.Contains(clientAuths, cfg.ClientAuth):
+		errs = append(errs, &FieldError{
+			"tls.client_auth",
+			fmt.Sprintf("unknown client auth %q, expected one of %s", cfg.ClientAuth, strings.Join(clientAuths, ", ")),
+		})
+	case cfg.ClientAuth != "none" && cfg.ClientCAFile == "":
+		errs = append(errs, &FieldError{"tls.client_ca_file", "required to verify the client certificates"})
+	}
+
+	if cfg.ReloadInterval.Duration < 0 {
+		errs = append(errs, &FieldError{"tls.reload_interval", "must not be negative"})
+	}
+
+	if auth.CertificateAuthentication && (cfg.ClientCAFile == "" || cfg.ClientAuth == "none") {
+		errs = append(errs, &FieldError{
+			"auth.certificate_authentication", "requires tls.client_ca_file to verify the client certificates",
+		})
+	}
+
+	return errs
+}
+
+// Validate reports every invalid field of the config at once, the defaults are expected to be applied.
+//
+//nolint:cyclop
+func (c *Config) Validate() error {
+	errs := []*FieldError{
+		validateAddress("address", c.Address),
+		validateStoreURI("tracking_store_uri", c.TrackingStoreURI),
+		validateStoreURI("model_registry_store_uri", c.ModelRegistryStoreURI),
+	}
+
+	if c.GRPCAddress != "" {
+		errs = append(errs, validateAddress("grpc_address", c.GRPCAddress))
+	}
+
+	if c.PythonAddress != "" {
+		errs = append(errs, validateAddress("python_address", c.PythonAddress))
+
+		// The Python server would open its own in-memory database, not the one of the Go stores.
+		for _, store := range []struct{ field, uri string }{
+			{"tracking_store_uri", c.TrackingStoreURI},
+			{"model_registry_store_uri", c.ModelRegistryStoreURI},
+		} {
+			if IsInMemoryStoreURI(store.uri) {
+				errs = append(errs, &FieldError{store.field, "an in-memory database is not shared with the Python server"})
+			}
+		}
+	}
+
+	if len(c.PythonCommand) > 0 && c.PythonAddress == "" {
+		errs = append(errs, &FieldError{"python_address", "required to launch python_command"})
+	}
+
+	if _, err := logrus.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, &FieldError{"log_level", fmt.Sprintf("unknown log level %q", c.LogLevel)})
+	}
+
+	if !slices.Contains(permissions, c.Auth.DefaultPermission) {
+		errs = append(errs, &FieldError{
+			"auth.default_permission",
+			fmt.Sprintf("unknown permission %q, expected one of %s", c.Auth.DefaultPermission, strings.Join(permissions, ", ")),
+		})
+	}
+
+	if !slices.Contains(tracingExporters, c.Tracing.Exporter) {
+		errs = append(errs, &FieldError{
+			"tracing.exporter", fmt.Sprintf("unknown exporter %q, expected otlp or file", c.Tracing.Exporter),
+		})
+	}
+
+	if c.Tracing.Exporter == "file" && c.Tracing.File == "" {
+		errs = append(errs, &FieldError{"tracing.file", "required by the file exporter"})
+	}
+
+	if c.ShutdownTimeout.Duration < 0 {
+		errs = append(errs, &FieldError{"shutdown_timeout", "must not be negative"})
+	}
+
+	if c.MultipartUploadExpiry.Duration < 0 {
+		errs = append(errs, &FieldError{"multipart_upload_expiry", "must not be negative"})
+	}
+
+	errs = append(errs, validateHTTP(c.HTTP)...)
+	errs = append(errs, validateTLS(c.TLS, c.Auth)...)
+
+	joined := make([]error, 0, len(errs))
+
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+
+	return errors.Join(joined...)
+}
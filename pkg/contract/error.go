@@ -4,9 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 )
 
+// errorDomain is the domain of the gRPC error details holding the MLflow error codes.
+const errorDomain = "mlflow.org"
+
 type ErrorCode protos.ErrorCode
 
 func (e ErrorCode) String() string {
@@ -80,3 +87,55 @@ func (e *Error) StatusCode() int {
 		return 500
 	}
 }
+
+//nolint:cyclop
+func (e *Error) grpcCode() codes.Code {
+	//nolint:exhaustive
+	switch protos.ErrorCode(e.Code) {
+	case protos.ErrorCode_BAD_REQUEST, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode_MALFORMED_REQUEST:
+		return codes.InvalidArgument
+	case protos.ErrorCode_RESOURCE_ALREADY_EXISTS, protos.ErrorCode_ALREADY_EXISTS:
+		return codes.AlreadyExists
+	case protos.ErrorCode_CUSTOMER_UNAUTHORIZED, protos.ErrorCode_UNAUTHENTICATED:
+		return codes.Unauthenticated
+	case protos.ErrorCode_PERMISSION_DENIED:
+		return codes.PermissionDenied
+	case protos.ErrorCode_NOT_FOUND, protos.ErrorCode_RESOURCE_DOES_NOT_EXIST:
+		return codes.NotFound
+	case protos.ErrorCode_ENDPOINT_NOT_FOUND, protos.ErrorCode_NOT_IMPLEMENTED:
+		return codes.Unimplemented
+	case protos.ErrorCode_ABORTED, protos.ErrorCode_RESOURCE_CONFLICT:
+		return codes.Aborted
+	case protos.ErrorCode_RESOURCE_EXHAUSTED, protos.ErrorCode_RESOURCE_LIMIT_EXCEEDED,
+		protos.ErrorCode_REQUEST_LIMIT_EXCEEDED:
+		return codes.ResourceExhausted
+	case protos.ErrorCode_CANCELLED:
+		return codes.Canceled
+	case protos.ErrorCode_DATA_LOSS:
+		return codes.DataLoss
+	case protos.ErrorCode_INVALID_STATE:
+		return codes.FailedPrecondition
+	case protos.ErrorCode_TEMPORARILY_UNAVAILABLE, protos.ErrorCode_SERVICE_UNDER_MAINTENANCE:
+		return codes.Unavailable
+	case protos.ErrorCode_DEADLINE_EXCEEDED:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// GRPCStatus is the status the gRPC server answers the error with,
+// the MLflow error code is the reason of its ErrorInfo details.
+func (e *Error) GRPCStatus() *status.Status {
+	grpcStatus := status.New(e.grpcCode(), e.Message)
+
+	withDetails, err := grpcStatus.WithDetails(&errdetails.ErrorInfo{
+		Reason: e.Code.String(),
+		Domain: errorDomain,
+	})
+	if err != nil {
+		return grpcStatus
+	}
+
+	return withDetails
+}
@@ -2,8 +2,13 @@
 
 package service
 
-import "github.com/mlflow/mlflow-go-backend/pkg/contract"
+import (
+	"context"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+)
 
 type ArtifactsService interface {
 	contract.Destroyer
+	ListArtifacts(ctx context.Context, input *artifacts.ListArtifacts) (*artifacts.ListArtifacts_Response, *contract.Error)
 }
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+)
+
+// ArtifactsStreamingService holds the artifact endpoints that can't be generated,
+// because the artifact path is part of the URL and the file contents are streamed as is.
+type ArtifactsStreamingService interface {
+	DownloadArtifact(ctx context.Context, artifactPath string) (io.ReadCloser, *contract.Error)
+	UploadArtifact(ctx context.Context, artifactPath string, content io.Reader) *contract.Error
+	DeleteArtifact(ctx context.Context, artifactPath string) *contract.Error
+	DownloadArtifactFromURI(ctx context.Context, artifactURI, artifactPath string) (io.ReadCloser, *contract.Error)
+}
+
+// ArtifactsMultipartUploadService holds the multipart upload endpoints, which take the directory
+// to upload into from the URL. UploadMultipartUploadPart has no proto counterpart, it receives the
+// parts for the artifact repositories the client can't send them to directly.
+type ArtifactsMultipartUploadService interface {
+	CreateMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.CreateMultipartUpload,
+	) (*artifacts.CreateMultipartUpload_Response, *contract.Error)
+	CompleteMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.CompleteMultipartUpload,
+	) (*artifacts.CompleteMultipartUpload_Response, *contract.Error)
+	AbortMultipartUpload(
+		ctx context.Context, artifactPath string, input *artifacts.AbortMultipartUpload,
+	) (*artifacts.AbortMultipartUpload_Response, *contract.Error)
+	UploadMultipartUploadPart(
+		ctx context.Context, uploadID string, partNumber int64, content io.Reader,
+	) (string, *contract.Error)
+}
@@ -31,10 +31,26 @@ type TrackingService interface {
 	GetRun(ctx context.Context, input *protos.GetRun) (*protos.GetRun_Response, *contract.Error)
 	SearchRuns(ctx context.Context, input *protos.SearchRuns) (*protos.SearchRuns_Response, *contract.Error)
 	GetMetricHistory(ctx context.Context, input *protos.GetMetricHistory) (*protos.GetMetricHistory_Response, *contract.Error)
+	GetMetricHistoryBulkInterval(ctx context.Context, input *protos.GetMetricHistoryBulkInterval) (*protos.GetMetricHistoryBulkInterval_Response, *contract.Error)
 	LogBatch(ctx context.Context, input *protos.LogBatch) (*protos.LogBatch_Response, *contract.Error)
 	LogInputs(ctx context.Context, input *protos.LogInputs) (*protos.LogInputs_Response, *contract.Error)
+	LogOutputs(ctx context.Context, input *protos.LogOutputs) (*protos.LogOutputs_Response, *contract.Error)
+	SearchDatasets(ctx context.Context, input *protos.SearchDatasets) (*protos.SearchDatasets_Response, *contract.Error)
 	StartTrace(ctx context.Context, input *protos.StartTrace) (*protos.StartTrace_Response, *contract.Error)
 	EndTrace(ctx context.Context, input *protos.EndTrace) (*protos.EndTrace_Response, *contract.Error)
 	GetTraceInfo(ctx context.Context, input *protos.GetTraceInfo) (*protos.GetTraceInfo_Response, *contract.Error)
+	GetTraceInfoV3(ctx context.Context, input *protos.GetTraceInfoV3) (*protos.GetTraceInfoV3_Response, *contract.Error)
+	SearchTraces(ctx context.Context, input *protos.SearchTraces) (*protos.SearchTraces_Response, *contract.Error)
 	DeleteTraces(ctx context.Context, input *protos.DeleteTraces) (*protos.DeleteTraces_Response, *contract.Error)
+	CreateLoggedModel(ctx context.Context, input *protos.CreateLoggedModel) (*protos.CreateLoggedModel_Response, *contract.Error)
+	FinalizeLoggedModel(ctx context.Context, input *protos.FinalizeLoggedModel) (*protos.FinalizeLoggedModel_Response, *contract.Error)
+	GetLoggedModel(ctx context.Context, input *protos.GetLoggedModel) (*protos.GetLoggedModel_Response, *contract.Error)
+	DeleteLoggedModel(ctx context.Context, input *protos.DeleteLoggedModel) (*protos.DeleteLoggedModel_Response, *contract.Error)
+	SearchLoggedModels(ctx context.Context, input *protos.SearchLoggedModels) (*protos.SearchLoggedModels_Response, *contract.Error)
+	SetLoggedModelTags(ctx context.Context, input *protos.SetLoggedModelTags) (*protos.SetLoggedModelTags_Response, *contract.Error)
+	DeleteLoggedModelTag(ctx context.Context, input *protos.DeleteLoggedModelTag) (*protos.DeleteLoggedModelTag_Response, *contract.Error)
+	LogLoggedModelParams(ctx context.Context, input *protos.LogLoggedModelParamsRequest) (*protos.LogLoggedModelParamsRequest_Response, *contract.Error)
+	CreateAssessment(ctx context.Context, input *protos.CreateAssessment) (*protos.CreateAssessment_Response, *contract.Error)
+	UpdateAssessment(ctx context.Context, input *protos.UpdateAssessment) (*protos.UpdateAssessment_Response, *contract.Error)
+	DeleteAssessment(ctx context.Context, input *protos.DeleteAssessment) (*protos.DeleteAssessment_Response, *contract.Error)
 }
@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	AssessmentTypeFeedback    = "feedback"
+	AssessmentTypeExpectation = "expectation"
+)
+
+type AssessmentError struct {
+	ErrorCode    string `json:"error_code"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	StackTrace   string `json:"stack_trace,omitempty"`
+}
+
+func (e AssessmentError) ToProto() *protos.AssessmentError {
+	assessmentError := protos.AssessmentError{
+		ErrorCode: &e.ErrorCode,
+	}
+
+	if e.ErrorMessage != "" {
+		assessmentError.ErrorMessage = &e.ErrorMessage
+	}
+
+	if e.StackTrace != "" {
+		assessmentError.StackTrace = &e.StackTrace
+	}
+
+	return &assessmentError
+}
+
+func NewAssessmentErrorFromProto(proto *protos.AssessmentError) *AssessmentError {
+	if proto == nil {
+		return nil
+	}
+
+	return &AssessmentError{
+		ErrorCode:    proto.GetErrorCode(),
+		ErrorMessage: proto.GetErrorMessage(),
+		StackTrace:   proto.GetStackTrace(),
+	}
+}
+
+// Assessment is a feedback or an expectation logged on a trace.
+// Value holds the JSON compatible value of the feedback or the expectation.
+type Assessment struct {
+	AssessmentID     string
+	TraceID          string
+	Name             string
+	Type             string
+	Value            any
+	Error            *AssessmentError
+	SourceType       string
+	SourceID         string
+	SpanID           string
+	Rationale        string
+	Metadata         map[string]string
+	CreateTimeMS     int64
+	LastUpdateTimeMS int64
+}
+
+//nolint:funlen
+func (a Assessment) ToProto() (*protos.Assessment, error) {
+	value, err := structpb.NewValue(a.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := protos.Assessment{
+		AssessmentId:   &a.AssessmentID,
+		AssessmentName: &a.Name,
+		TraceId:        &a.TraceID,
+		Source: &protos.AssessmentSource{
+			SourceType: utils.PtrTo(protos.AssessmentSource_SourceType(
+				protos.AssessmentSource_SourceType_value[a.SourceType],
+			)),
+		},
+		CreateTime:     timestamppb.New(time.UnixMilli(a.CreateTimeMS)),
+		LastUpdateTime: timestamppb.New(time.UnixMilli(a.LastUpdateTimeMS)),
+		Metadata:       a.Metadata,
+	}
+
+	if a.SourceID != "" {
+		assessment.Source.SourceId = &a.SourceID
+	}
+
+	if a.SpanID != "" {
+		assessment.SpanId = &a.SpanID
+	}
+
+	if a.Rationale != "" {
+		assessment.Rationale = &a.Rationale
+	}
+
+	if a.Type == AssessmentTypeExpectation {
+		assessment.Value = &protos.Assessment_Expectation{
+			Expectation: &protos.Expectation{Value: value},
+		}
+	} else {
+		feedback := protos.Feedback{Value: value}
+		if a.Error != nil {
+			feedback.Error = a.Error.ToProto()
+		}
+
+		assessment.Value = &protos.Assessment_Feedback{Feedback: &feedback}
+	}
+
+	return &assessment, nil
+}
+
+// NewAssessmentValueFromProto returns the type, value and error of the feedback or expectation of an assessment.
+func NewAssessmentValueFromProto(proto *protos.Assessment) (string, any, *AssessmentError) {
+	if expectation := proto.GetExpectation(); expectation != nil {
+		return AssessmentTypeExpectation, expectation.GetValue().AsInterface(), nil
+	}
+
+	feedback := proto.GetFeedback()
+
+	return AssessmentTypeFeedback, feedback.GetValue().AsInterface(), NewAssessmentErrorFromProto(feedback.GetError())
+}
+
+func NewAssessmentFromProto(proto *protos.Assessment) *Assessment {
+	assessmentType, value, assessmentError := NewAssessmentValueFromProto(proto)
+
+	assessment := Assessment{
+		TraceID:    proto.GetTraceId(),
+		Name:       proto.GetAssessmentName(),
+		Type:       assessmentType,
+		Value:      value,
+		Error:      assessmentError,
+		SourceType: proto.GetSource().GetSourceType().String(),
+		SourceID:   proto.GetSource().GetSourceId(),
+		SpanID:     proto.GetSpanId(),
+		Rationale:  proto.GetRationale(),
+		Metadata:   proto.GetMetadata(),
+	}
+
+	if proto.CreateTime != nil {
+		assessment.CreateTimeMS = proto.GetCreateTime().AsTime().UnixMilli()
+	}
+
+	if proto.LastUpdateTime != nil {
+		assessment.LastUpdateTimeMS = proto.GetLastUpdateTime().AsTime().UnixMilli()
+	}
+
+	return &assessment
+}
+
+// AssessmentUpdate holds the fields of an assessment to update, nil fields are left untouched.
+// The metadata is merged into the existing one.
+type AssessmentUpdate struct {
+	Name      *string
+	Type      *string
+	Value     any
+	Error     *AssessmentError
+	Rationale *string
+	Metadata  map[string]string
+}
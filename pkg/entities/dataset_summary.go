@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"strconv"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type DatasetSummary struct {
+	ExperimentID int32
+	Name         string
+	Digest       string
+	Context      string
+}
+
+func (d *DatasetSummary) ToProto() *protos.DatasetSummary {
+	var context *string
+	if d.Context != "" {
+		context = &d.Context
+	}
+
+	return &protos.DatasetSummary{
+		ExperimentId: utils.PtrTo(strconv.Itoa(int(d.ExperimentID))),
+		Name:         &d.Name,
+		Digest:       &d.Digest,
+		Context:      context,
+	}
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type FileInfo struct {
+	Path     string
+	IsDir    bool
+	FileSize int64
+}
+
+func (fi FileInfo) ToProto() *artifacts.FileInfo {
+	fileInfo := artifacts.FileInfo{
+		Path:  utils.PtrTo(fi.Path),
+		IsDir: utils.PtrTo(fi.IsDir),
+	}
+
+	// file size is left unset for directories.
+	if !fi.IsDir {
+		fileInfo.FileSize = utils.PtrTo(fi.FileSize)
+	}
+
+	return &fileInfo
+}
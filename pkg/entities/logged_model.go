@@ -0,0 +1,168 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type LoggedModel struct {
+	ModelID                string
+	ExperimentID           string
+	Name                   string
+	ArtifactURI            string
+	CreationTimestampMS    int64
+	LastUpdatedTimestampMS int64
+	Status                 protos.LoggedModelStatus
+	StatusMessage          string
+	ModelType              string
+	SourceRunID            string
+	Tags                   []*LoggedModelTag
+	Params                 []*LoggedModelParameter
+	Metrics                []*MetricWithRunID
+}
+
+func (lm LoggedModel) ToProto() *protos.LoggedModel {
+	info := protos.LoggedModelInfo{
+		ModelId:                &lm.ModelID,
+		ExperimentId:           &lm.ExperimentID,
+		Name:                   &lm.Name,
+		CreationTimestampMs:    &lm.CreationTimestampMS,
+		LastUpdatedTimestampMs: &lm.LastUpdatedTimestampMS,
+		ArtifactUri:            &lm.ArtifactURI,
+		Status:                 utils.PtrTo(lm.Status),
+		Tags:                   make([]*protos.LoggedModelTag, len(lm.Tags)),
+	}
+
+	if lm.ModelType != "" {
+		info.ModelType = &lm.ModelType
+	}
+
+	if lm.SourceRunID != "" {
+		info.SourceRunId = &lm.SourceRunID
+	}
+
+	if lm.StatusMessage != "" {
+		info.StatusMessage = &lm.StatusMessage
+	}
+
+	for i, tag := range lm.Tags {
+		info.Tags[i] = tag.ToProto()
+	}
+
+	data := protos.LoggedModelData{
+		Params:  make([]*protos.LoggedModelParameter, len(lm.Params)),
+		Metrics: make([]*protos.Metric, len(lm.Metrics)),
+	}
+
+	for i, param := range lm.Params {
+		data.Params[i] = param.ToProto()
+	}
+
+	for i, metric := range lm.Metrics {
+		data.Metrics[i] = metric.Metric.ToProto()
+		data.Metrics[i].ModelId = &lm.ModelID
+		data.Metrics[i].RunId = utils.PtrTo(metric.RunID)
+
+		if metric.DatasetName != "" {
+			data.Metrics[i].DatasetName = utils.PtrTo(metric.DatasetName)
+			data.Metrics[i].DatasetDigest = utils.PtrTo(metric.DatasetDigest)
+		}
+	}
+
+	return &protos.LoggedModel{
+		Info: &info,
+		Data: &data,
+	}
+}
+
+type LoggedModelTag struct {
+	Key   string
+	Value string
+}
+
+func (t LoggedModelTag) ToProto() *protos.LoggedModelTag {
+	return &protos.LoggedModelTag{
+		Key:   &t.Key,
+		Value: &t.Value,
+	}
+}
+
+func NewLoggedModelTagsFromProto(protoTags []*protos.LoggedModelTag) []*LoggedModelTag {
+	tags := make([]*LoggedModelTag, 0, len(protoTags))
+	for _, tag := range protoTags {
+		tags = append(tags, &LoggedModelTag{
+			Key:   tag.GetKey(),
+			Value: tag.GetValue(),
+		})
+	}
+
+	return tags
+}
+
+type LoggedModelParameter struct {
+	Key   string
+	Value string
+}
+
+func (p LoggedModelParameter) ToProto() *protos.LoggedModelParameter {
+	return &protos.LoggedModelParameter{
+		Key:   &p.Key,
+		Value: &p.Value,
+	}
+}
+
+func NewLoggedModelParametersFromProto(protoParams []*protos.LoggedModelParameter) []*LoggedModelParameter {
+	params := make([]*LoggedModelParameter, 0, len(protoParams))
+	for _, param := range protoParams {
+		params = append(params, &LoggedModelParameter{
+			Key:   param.GetKey(),
+			Value: param.GetValue(),
+		})
+	}
+
+	return params
+}
+
+// LoggedModelDataset restricts the metrics of a logged model search to those logged on a dataset.
+type LoggedModelDataset struct {
+	Name   string
+	Digest string
+}
+
+// LoggedModelOrderBy is an order_by clause of a logged model search.
+type LoggedModelOrderBy struct {
+	FieldName string
+	Ascending bool
+	Dataset   *LoggedModelDataset
+}
+
+func NewLoggedModelSearchFromProto(
+	input *protos.SearchLoggedModels,
+) ([]*LoggedModelDataset, []*LoggedModelOrderBy) {
+	datasets := make([]*LoggedModelDataset, 0, len(input.GetDatasets()))
+	for _, dataset := range input.GetDatasets() {
+		datasets = append(datasets, &LoggedModelDataset{
+			Name:   dataset.GetDatasetName(),
+			Digest: dataset.GetDatasetDigest(),
+		})
+	}
+
+	orderBy := make([]*LoggedModelOrderBy, 0, len(input.GetOrderBy()))
+	for _, order := range input.GetOrderBy() {
+		clause := LoggedModelOrderBy{
+			FieldName: order.GetFieldName(),
+			Ascending: order.GetAscending(),
+		}
+
+		if order.DatasetName != nil {
+			clause.Dataset = &LoggedModelDataset{
+				Name:   order.GetDatasetName(),
+				Digest: order.GetDatasetDigest(),
+			}
+		}
+
+		orderBy = append(orderBy, &clause)
+	}
+
+	return datasets, orderBy
+}
@@ -36,6 +36,23 @@ func (m Metric) ToProto() *protos.Metric {
 	return &metric
 }
 
+type MetricWithRunID struct {
+	Metric
+	RunID string
+}
+
+func (m MetricWithRunID) ToProto() *protos.MetricWithRunId {
+	metric := m.Metric.ToProto()
+
+	return &protos.MetricWithRunId{
+		Key:       metric.Key,
+		Value:     metric.Value,
+		Timestamp: metric.Timestamp,
+		Step:      metric.Step,
+		RunId:     &m.RunID,
+	}
+}
+
 func MetricFromProto(proto *protos.Metric) *Metric {
 	return &Metric{
 		Key:           proto.GetKey(),
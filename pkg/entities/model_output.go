@@ -16,3 +16,10 @@ func (mo ModelOutput) ToProto() *protos.ModelOutput {
 		ModelId: utils.PtrTo(mo.ModelID),
 	}
 }
+
+func NewModelOutputFromProto(proto *protos.ModelOutput) *ModelOutput {
+	return &ModelOutput{
+		Step:    proto.GetStep(),
+		ModelID: proto.GetModelId(),
+	}
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type MultipartUploadCredential struct {
+	URL        string
+	PartNumber int64
+	Headers    map[string]string
+}
+
+func (c MultipartUploadCredential) ToProto() *artifacts.MultipartUploadCredential {
+	return &artifacts.MultipartUploadCredential{
+		Url:        utils.PtrTo(c.URL),
+		PartNumber: utils.PtrTo(c.PartNumber),
+		Headers:    c.Headers,
+	}
+}
+
+type MultipartUpload struct {
+	UploadID    string
+	Credentials []*MultipartUploadCredential
+}
+
+func (u MultipartUpload) ToProto() *artifacts.CreateMultipartUpload_Response {
+	credentials := make([]*artifacts.MultipartUploadCredential, 0, len(u.Credentials))
+	for _, credential := range u.Credentials {
+		credentials = append(credentials, credential.ToProto())
+	}
+
+	return &artifacts.CreateMultipartUpload_Response{
+		UploadId:    utils.PtrTo(u.UploadID),
+		Credentials: credentials,
+	}
+}
+
+type MultipartUploadPart struct {
+	PartNumber int64
+	ETag       string
+	URL        string
+}
+
+func NewMultipartUploadPartFromProto(proto *artifacts.MultipartUploadPart) *MultipartUploadPart {
+	return &MultipartUploadPart{
+		PartNumber: proto.GetPartNumber(),
+		ETag:       proto.GetEtag(),
+		URL:        proto.GetUrl(),
+	}
+}
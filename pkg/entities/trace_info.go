@@ -1,6 +1,11 @@
 package entities
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
@@ -13,6 +18,7 @@ type TraceInfo struct {
 	ExecutionTimeMS      *int64
 	Tags                 []*TraceTag
 	TraceRequestMetadata []*TraceRequestMetadata
+	Assessments          []*Assessment
 }
 
 func (ti TraceInfo) ToProto() *protos.TraceInfo {
@@ -36,3 +42,45 @@ func (ti TraceInfo) ToProto() *protos.TraceInfo {
 
 	return &traceInfo
 }
+
+func (ti TraceInfo) ToProtoV3() (*protos.TraceInfoV3, error) {
+	traceInfo := protos.TraceInfoV3{
+		TraceId: &ti.RequestID,
+		TraceLocation: &protos.TraceLocation{
+			Type: utils.PtrTo(protos.TraceLocation_MLFLOW_EXPERIMENT),
+			Identifier: &protos.TraceLocation_MlflowExperiment{
+				MlflowExperiment: &protos.TraceLocation_MlflowExperimentLocation{
+					ExperimentId: &ti.ExperimentID,
+				},
+			},
+		},
+		RequestTime:   timestamppb.New(time.UnixMilli(ti.TimestampMS)),
+		State:         utils.PtrTo(protos.TraceInfoV3_State(protos.TraceInfoV3_State_value[ti.Status])),
+		TraceMetadata: make(map[string]string, len(ti.TraceRequestMetadata)),
+		Tags:          make(map[string]string, len(ti.Tags)),
+		Assessments:   make([]*protos.Assessment, 0, len(ti.Assessments)),
+	}
+
+	if ti.ExecutionTimeMS != nil {
+		traceInfo.ExecutionDuration = durationpb.New(time.Duration(*ti.ExecutionTimeMS) * time.Millisecond)
+	}
+
+	for _, tag := range ti.Tags {
+		traceInfo.Tags[tag.Key] = tag.Value
+	}
+
+	for _, metadata := range ti.TraceRequestMetadata {
+		traceInfo.TraceMetadata[metadata.Key] = metadata.Value
+	}
+
+	for _, assessment := range ti.Assessments {
+		proto, err := assessment.ToProto()
+		if err != nil {
+			return nil, err
+		}
+
+		traceInfo.Assessments = append(traceInfo.Assessments, proto)
+	}
+
+	return &traceInfo, nil
+}
@@ -0,0 +1,146 @@
+// Package filestore contains the helpers shared by the file stores, which read and write
+// the directory layout of the Python FileStore: one meta.yaml per entity and one file per key.
+package filestore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MetaFileName = "meta.yaml"
+
+	DirPermissions  = 0o755
+	FilePermissions = 0o644
+)
+
+func ReadYAML(path string, out any) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(content, out); err != nil {
+		return fmt.Errorf("failed to parse %q: %w", path, err)
+	}
+
+	return nil
+}
+
+func WriteYAML(path string, in any) error {
+	content, err := yaml.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to serialize %q: %w", path, err)
+	}
+
+	return WriteFile(path, content)
+}
+
+// WriteFile writes a file, creating its parent directories.
+func WriteFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), DirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory of %q: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, content, FilePermissions); err != nil {
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+
+	return nil
+}
+
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
+
+// KeyPath is the file of a key, keys containing slashes are nested directories.
+func KeyPath(dir, key string) string {
+	return filepath.Join(dir, filepath.FromSlash(key))
+}
+
+// ReadKeyValues reads the files below dir, the key of a value is its slash separated path relative to dir.
+// A missing dir has no values.
+func ReadKeyValues(dir string) (map[string]string, error) {
+	values := make(map[string]string)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return fmt.Errorf("failed to get key of %q: %w", path, err)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %q: %w", path, err)
+		}
+
+		values[filepath.ToSlash(relativePath)] = string(content)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", dir, err)
+	}
+
+	return values, nil
+}
+
+// SortedKeys returns the keys of values in lexical order, so that the responses are stable.
+func SortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ListDirectories returns the names of the sub directories of dir, ignoring a missing dir.
+func ListDirectories(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list %q: %w", dir, err)
+	}
+
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// IsValidName reports whether name can be used as a single path element.
+func IsValidName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,210 @@
+package filestore
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// The file stores have no query engine, the searches load the entities
+// and evaluate the filter and the ordering in memory.
+
+// Paginate returns the page of items starting at the offset of the page token,
+// the next page token is only set when there are more items.
+func Paginate[T any](items []T, maxResults int, token string) ([]T, string, *contract.Error) {
+	offset, err := utils.DecodePageToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	start := min(offset, len(items))
+	end := min(start+maxResults, len(items))
+
+	if end == len(items) {
+		return items[start:end], "", nil
+	}
+
+	nextPageToken, err := utils.EncodePageToken(end)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items[start:end], nextPageToken, nil
+}
+
+// MatchesFilter evaluates a filter tree, the comparisons are evaluated by match.
+func MatchesFilter(expr parser.ValidExpr, match func(condition *parser.ValidCompareExpr) bool) bool {
+	switch expr := expr.(type) {
+	case *parser.ValidCompareExpr:
+		return match(expr)
+	case *parser.ValidBooleanExpr:
+		isAnd := expr.Operator == parser.And
+
+		for _, e := range expr.Exprs {
+			// A false operand decides a conjunction, a true operand decides a disjunction.
+			if MatchesFilter(e, match) != isAnd {
+				return !isAnd
+			}
+		}
+
+		return isAnd
+	default:
+		return false
+	}
+}
+
+// likePattern translates a SQL LIKE pattern to a regular expression.
+func likePattern(pattern string, caseInsensitive bool) *regexp.Regexp {
+	var expression strings.Builder
+
+	if caseInsensitive {
+		expression.WriteString("(?i)")
+	}
+
+	expression.WriteString("^")
+
+	for _, char := range pattern {
+		switch char {
+		case '%':
+			expression.WriteString(".*")
+		case '_':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	expression.WriteString("$")
+
+	return regexp.MustCompile("(?s)" + expression.String())
+}
+
+// CompareStrings evaluates a comparison against a string, a missing value never matches.
+func CompareStrings(actual *string, operator parser.OperatorKind, expected any) bool {
+	if actual == nil {
+		return false
+	}
+
+	switch expected := expected.(type) {
+	case string:
+		//nolint:exhaustive
+		switch operator {
+		case parser.Equals:
+			return *actual == expected
+		case parser.NotEquals:
+			return *actual != expected
+		case parser.Like:
+			return likePattern(expected, false).MatchString(*actual)
+		case parser.ILike:
+			return likePattern(expected, true).MatchString(*actual)
+		}
+	case []string:
+		//nolint:exhaustive
+		switch operator {
+		case parser.In:
+			return slices.Contains(expected, *actual)
+		case parser.NotIn:
+			return !slices.Contains(expected, *actual)
+		}
+	}
+
+	return false
+}
+
+// CompareNumbers evaluates a comparison against a number, a missing value never matches.
+func CompareNumbers(actual *float64, operator parser.OperatorKind, expected any) bool {
+	value, ok := expected.(float64)
+	if actual == nil || !ok {
+		return false
+	}
+
+	//nolint:exhaustive
+	switch operator {
+	case parser.Equals:
+		return *actual == value
+	case parser.NotEquals:
+		return *actual != value
+	case parser.Less:
+		return *actual < value
+	case parser.LessEquals:
+		return *actual <= value
+	case parser.Greater:
+		return *actual > value
+	case parser.GreaterEquals:
+		return *actual >= value
+	default:
+		return false
+	}
+}
+
+// SortValue is the value of an entity for an order_by clause, missing values are sorted last.
+type SortValue struct {
+	Missing bool
+	Number  float64
+	Text    string
+}
+
+// SortClause is an order_by clause, Value returns the value of the item at an index.
+type SortClause struct {
+	Descending bool
+	Value      func(index int) SortValue
+}
+
+func (c SortClause) compare(a, b int) int {
+	left, right := c.Value(a), c.Value(b)
+
+	switch {
+	case left.Missing || right.Missing:
+		if left.Missing == right.Missing {
+			return 0
+		}
+
+		if left.Missing {
+			return 1
+		}
+
+		return -1
+	case left.Number != right.Number:
+		if (left.Number < right.Number) != c.Descending {
+			return -1
+		}
+
+		return 1
+	default:
+		result := strings.Compare(left.Text, right.Text)
+		if c.Descending {
+			return -result
+		}
+
+		return result
+	}
+}
+
+// SortByClauses orders items by the clauses, the first clause has the highest priority.
+func SortByClauses[T any](items []T, clauses []SortClause) []T {
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		for _, clause := range clauses {
+			if result := clause.compare(a, b); result != 0 {
+				return result
+			}
+		}
+
+		return 0
+	})
+
+	sorted := make([]T, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+
+	return sorted
+}
@@ -0,0 +1,30 @@
+package filestore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	items := make([]int, 12)
+	for i := range items {
+		items[i] = i
+	}
+
+	// The token of the second page, {"offset":10}, is not a multiple of three bytes and ends with padding.
+	page, pageToken, err := filestore.Paginate(items, 10, "")
+	require.Nil(t, err)
+	assert.Equal(t, items[:10], page)
+	assert.Equal(t, "eyJvZmZzZXQiOjEwfQ==", pageToken)
+
+	page, pageToken, err = filestore.Paginate(items, 10, pageToken)
+	require.Nil(t, err)
+	assert.Equal(t, items[10:], page)
+	assert.Empty(t, pageToken)
+}
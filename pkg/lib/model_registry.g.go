@@ -47,6 +47,14 @@ func ModelRegistryServiceGetRegisteredModel(serviceID int64, requestData unsafe.
 	}
 	return invokeServiceMethod(service.GetRegisteredModel, new(protos.GetRegisteredModel), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceSearchRegisteredModels
+func ModelRegistryServiceSearchRegisteredModels(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchRegisteredModels, new(protos.SearchRegisteredModels), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceGetLatestVersions
 func ModelRegistryServiceGetLatestVersions(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -55,6 +63,14 @@ func ModelRegistryServiceGetLatestVersions(serviceID int64, requestData unsafe.P
 	}
 	return invokeServiceMethod(service.GetLatestVersions, new(protos.GetLatestVersions), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceCreateModelVersion
+func ModelRegistryServiceCreateModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateModelVersion, new(protos.CreateModelVersion), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceUpdateModelVersion
 func ModelRegistryServiceUpdateModelVersion(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
@@ -87,6 +103,14 @@ func ModelRegistryServiceGetModelVersion(serviceID int64, requestData unsafe.Poi
 	}
 	return invokeServiceMethod(service.GetModelVersion, new(protos.GetModelVersion), requestData, requestSize, responseSize)
 }
+//export ModelRegistryServiceSearchModelVersions
+func ModelRegistryServiceSearchModelVersions(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := modelRegistryServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchModelVersions, new(protos.SearchModelVersions), requestData, requestSize, responseSize)
+}
 //export ModelRegistryServiceGetModelVersionDownloadUri
 func ModelRegistryServiceGetModelVersionDownloadUri(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := modelRegistryServices.Get(serviceID)
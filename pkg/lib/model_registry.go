@@ -3,8 +3,10 @@ package main
 import "C"
 
 import (
+	"context"
 	"unsafe"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/service"
 )
 
@@ -13,7 +15,13 @@ var modelRegistryServices = newInstanceMap[*service.ModelRegistryService]()
 //export CreateModelRegistryService
 func CreateModelRegistryService(configData unsafe.Pointer, configSize C.int) int64 {
 	//nolint:nlreturn
-	return modelRegistryServices.Create(service.NewModelRegistryService, C.GoBytes(configData, configSize))
+	return modelRegistryServices.Create(
+		func(ctx context.Context, cfg *config.Config) (*service.ModelRegistryService, error) {
+			// The Python store validates the runs of the model versions itself.
+			return service.NewModelRegistryService(ctx, cfg, nil)
+		},
+		C.GoBytes(configData, configSize),
+	)
 }
 
 //export DestroyModelRegistryService
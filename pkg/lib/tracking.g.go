@@ -175,6 +175,14 @@ func TrackingServiceGetMetricHistory(serviceID int64, requestData unsafe.Pointer
 	}
 	return invokeServiceMethod(service.GetMetricHistory, new(protos.GetMetricHistory), requestData, requestSize, responseSize)
 }
+//export TrackingServiceGetMetricHistoryBulkInterval
+func TrackingServiceGetMetricHistoryBulkInterval(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetMetricHistoryBulkInterval, new(protos.GetMetricHistoryBulkInterval), requestData, requestSize, responseSize)
+}
 //export TrackingServiceLogBatch
 func TrackingServiceLogBatch(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -191,6 +199,22 @@ func TrackingServiceLogInputs(serviceID int64, requestData unsafe.Pointer, reque
 	}
 	return invokeServiceMethod(service.LogInputs, new(protos.LogInputs), requestData, requestSize, responseSize)
 }
+//export TrackingServiceLogOutputs
+func TrackingServiceLogOutputs(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.LogOutputs, new(protos.LogOutputs), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSearchDatasets
+func TrackingServiceSearchDatasets(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchDatasets, new(protos.SearchDatasets), requestData, requestSize, responseSize)
+}
 //export TrackingServiceStartTrace
 func TrackingServiceStartTrace(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -215,6 +239,22 @@ func TrackingServiceGetTraceInfo(serviceID int64, requestData unsafe.Pointer, re
 	}
 	return invokeServiceMethod(service.GetTraceInfo, new(protos.GetTraceInfo), requestData, requestSize, responseSize)
 }
+//export TrackingServiceGetTraceInfoV3
+func TrackingServiceGetTraceInfoV3(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetTraceInfoV3, new(protos.GetTraceInfoV3), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSearchTraces
+func TrackingServiceSearchTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchTraces, new(protos.SearchTraces), requestData, requestSize, responseSize)
+}
 //export TrackingServiceDeleteTraces
 func TrackingServiceDeleteTraces(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
 	service, err := trackingServices.Get(serviceID)
@@ -223,3 +263,91 @@ func TrackingServiceDeleteTraces(serviceID int64, requestData unsafe.Pointer, re
 	}
 	return invokeServiceMethod(service.DeleteTraces, new(protos.DeleteTraces), requestData, requestSize, responseSize)
 }
+//export TrackingServiceCreateLoggedModel
+func TrackingServiceCreateLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateLoggedModel, new(protos.CreateLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceFinalizeLoggedModel
+func TrackingServiceFinalizeLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.FinalizeLoggedModel, new(protos.FinalizeLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceGetLoggedModel
+func TrackingServiceGetLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.GetLoggedModel, new(protos.GetLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceDeleteLoggedModel
+func TrackingServiceDeleteLoggedModel(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteLoggedModel, new(protos.DeleteLoggedModel), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSearchLoggedModels
+func TrackingServiceSearchLoggedModels(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SearchLoggedModels, new(protos.SearchLoggedModels), requestData, requestSize, responseSize)
+}
+//export TrackingServiceSetLoggedModelTags
+func TrackingServiceSetLoggedModelTags(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.SetLoggedModelTags, new(protos.SetLoggedModelTags), requestData, requestSize, responseSize)
+}
+//export TrackingServiceDeleteLoggedModelTag
+func TrackingServiceDeleteLoggedModelTag(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteLoggedModelTag, new(protos.DeleteLoggedModelTag), requestData, requestSize, responseSize)
+}
+//export TrackingServiceLogLoggedModelParams
+func TrackingServiceLogLoggedModelParams(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.LogLoggedModelParams, new(protos.LogLoggedModelParamsRequest), requestData, requestSize, responseSize)
+}
+//export TrackingServiceCreateAssessment
+func TrackingServiceCreateAssessment(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.CreateAssessment, new(protos.CreateAssessment), requestData, requestSize, responseSize)
+}
+//export TrackingServiceUpdateAssessment
+func TrackingServiceUpdateAssessment(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.UpdateAssessment, new(protos.UpdateAssessment), requestData, requestSize, responseSize)
+}
+//export TrackingServiceDeleteAssessment
+func TrackingServiceDeleteAssessment(serviceID int64, requestData unsafe.Pointer, requestSize C.int, responseSize *C.int) unsafe.Pointer {
+	service, err := trackingServices.Get(serviceID)
+	if err != nil {
+		return makePointerFromError(err, responseSize)
+	}
+	return invokeServiceMethod(service.DeleteAssessment, new(protos.DeleteAssessment), requestData, requestSize, responseSize)
+}
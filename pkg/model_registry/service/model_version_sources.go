@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var multipleSlashesRegex = regexp.MustCompile(`/+`)
+
+// Resolve where the artifacts of a new model version are stored and check that the source may be registered.
+// Port of _validate_source_run in the Python server handlers and of the models:/ resolution in the SQL store.
+func (m *ModelRegistryService) resolveModelVersionSource(
+	ctx context.Context, source, runID string,
+) (string, *contract.Error) {
+	uri, err := url.Parse(source)
+	if err != nil {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid model version source: '%s'.", source),
+		)
+	}
+
+	switch uri.Scheme {
+	case "models":
+		return m.resolveModelsSource(ctx, source, uri)
+	case "runs":
+		return m.resolveRunsSource(ctx, source, uri, runID)
+	case "", "file":
+		return source, m.validateLocalSource(ctx, source, uri, runID)
+	default:
+		return source, validateNonLocalSource(source)
+	}
+}
+
+// A models:/ source registers another model version, its artifacts are stored at the location of that version.
+func (m *ModelRegistryService) resolveModelsSource(
+	ctx context.Context, source string, uri *url.URL,
+) (string, *contract.Error) {
+	var modelVersion *entities.ModelVersion
+
+	var err *contract.Error
+
+	modelPath := strings.Trim(uri.Path, "/")
+
+	if name, alias, ok := strings.Cut(modelPath, "@"); ok {
+		modelVersion, err = m.store.GetModelVersionByAlias(ctx, name, alias)
+	} else {
+		name, suffix, _ := strings.Cut(modelPath, "/")
+
+		switch _, convErr := strconv.Atoi(suffix); {
+		case name == "" || suffix == "" || strings.Contains(suffix, "/"):
+			err = contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				"Not a proper models:/ URI. Models URIs must be of the form 'models:/model_name/suffix' "+
+					"or 'models:/model_name@alias'.",
+			)
+		case convErr == nil:
+			modelVersion, err = m.store.GetModelVersion(ctx, name, suffix, false)
+		default:
+			modelVersion, err = m.getLatestModelVersion(ctx, name, suffix)
+		}
+	}
+
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Unable to fetch model from model URI source artifact location '%s'.Error: %s", source, err.Message,
+			),
+			err,
+		)
+	}
+
+	if modelVersion.StorageLocation != "" {
+		return modelVersion.StorageLocation, nil
+	}
+
+	return modelVersion.Source, nil
+}
+
+// Resolve models:/name/latest and models:/name/<stage> to the latest version (in that stage).
+func (m *ModelRegistryService) getLatestModelVersion(
+	ctx context.Context, name, stage string,
+) (*entities.ModelVersion, *contract.Error) {
+	var stages []string
+	if !strings.EqualFold(stage, "latest") {
+		stages = []string{stage}
+	}
+
+	modelVersions, err := m.store.GetLatestVersions(ctx, name, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *entities.ModelVersion
+
+	for _, modelVersion := range modelVersions {
+		if latest == nil || modelVersion.Version > latest.Version {
+			latest = modelVersion
+		}
+	}
+
+	if latest == nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No versions of model with name '%s' and stage '%s' found", name, stage),
+		)
+	}
+
+	return latest, nil
+}
+
+// A runs:/ source must reference an existing run, its artifacts are stored in the artifact location of that run.
+// Without a tracking store, the source is registered as it is.
+func (m *ModelRegistryService) resolveRunsSource(
+	ctx context.Context, source string, uri *url.URL, runID string,
+) (string, *contract.Error) {
+	sourceRunID, artifactPath, _ := strings.Cut(strings.TrimPrefix(uri.Path, "/"), "/")
+	if sourceRunID == "" {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid model version source: '%s'. Runs URIs must be of the form 'runs:/<run_id>/run-relative/path'.",
+				source,
+			),
+		)
+	}
+
+	if runID != "" && runID != sourceRunID {
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid model version source: '%s'. The run of the source does not match the run_id '%s'.",
+				source, runID,
+			),
+		)
+	}
+
+	if m.trackingStore == nil {
+		return source, nil
+	}
+
+	run, err := m.trackingStore.GetRun(ctx, sourceRunID)
+	if err != nil {
+		return "", err
+	}
+
+	if artifactPath == "" {
+		return run.Info.ArtifactURI, nil
+	}
+
+	storageLocation, appendErr := utils.AppendToURIPath(run.Info.ArtifactURI, artifactPath)
+	if appendErr != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to resolve the artifact location of source '%s'", source),
+			appendErr,
+		)
+	}
+
+	return storageLocation, nil
+}
+
+// A local source must live inside the artifact directory of the run given by run_id.
+// Without a tracking store, like when the service is used by the Python store, the check is left to the caller.
+func (m *ModelRegistryService) validateLocalSource(
+	ctx context.Context, source string, uri *url.URL, runID string,
+) *contract.Error {
+	if m.trackingStore == nil {
+		return nil
+	}
+
+	invalidSourceError := contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Invalid model version source: '%s'. To use a local path as a model version source, "+
+				"the run_id request parameter has to be specified and the local path has to be contained "+
+				"within the artifact directory of the run specified by the run_id.",
+			source,
+		),
+	)
+
+	if runID == "" {
+		return invalidSourceError
+	}
+
+	run, err := m.trackingStore.GetRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	artifactURI, parseErr := url.Parse(run.Info.ArtifactURI)
+	if parseErr != nil || (artifactURI.Scheme != "" && artifactURI.Scheme != "file") {
+		return invalidSourceError
+	}
+
+	sourcePath, absErr := filepath.Abs(uri.Path)
+	if absErr != nil {
+		return invalidSourceError
+	}
+
+	artifactDir, absErr := filepath.Abs(artifactURI.Path)
+	if absErr != nil {
+		return invalidSourceError
+	}
+
+	if sourcePath != artifactDir && !strings.HasPrefix(sourcePath, artifactDir+string(filepath.Separator)) {
+		return invalidSourceError
+	}
+
+	return nil
+}
+
+// Remote sources must be absolute, relative path references could escape the intended location.
+func validateNonLocalSource(source string) *contract.Error {
+	invalidSourceError := contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Invalid model version source: '%s'. If supplying a source as an http, https, local file path, "+
+				"ftp, objectstore, or mlflow-artifacts uri, an absolute path must be provided without relative "+
+				"path references present. Please provide an absolute path.",
+			source,
+		),
+	)
+
+	unquoted := source
+
+	for {
+		next, err := url.QueryUnescape(unquoted)
+		if err != nil {
+			return invalidSourceError
+		}
+
+		if next == unquoted {
+			break
+		}
+
+		unquoted = next
+	}
+
+	uri, err := url.Parse(unquoted)
+	if err != nil {
+		return invalidSourceError
+	}
+
+	sourcePath := multipleSlashesRegex.ReplaceAllString(strings.TrimRight(uri.Path, "/"), "/")
+
+	if strings.Contains(sourcePath, "\x00") {
+		return invalidSourceError
+	}
+
+	for _, part := range strings.Split(unquoted, "/") {
+		if part == ".." {
+			return invalidSourceError
+		}
+	}
+
+	if sourcePath != "" && path.Clean(sourcePath) != sourcePath {
+		return invalidSourceError
+	}
+
+	return nil
+}
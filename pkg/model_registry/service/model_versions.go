@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql"
 	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
@@ -52,6 +54,76 @@ func (m *ModelRegistryService) GetModelVersion(
 	}, nil
 }
 
+func (m *ModelRegistryService) SearchModelVersions(
+	ctx context.Context, input *protos.SearchModelVersions,
+) (*protos.SearchModelVersions_Response, *contract.Error) {
+	modelVersions, nextPageToken, err := m.store.SearchModelVersions(
+		ctx,
+		input.GetFilter(),
+		input.GetMaxResults(),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchModelVersions_Response{
+		ModelVersions: make([]*protos.ModelVersion, len(modelVersions)),
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, modelVersion := range modelVersions {
+		response.ModelVersions[i] = modelVersion.ToProto()
+	}
+
+	return &response, nil
+}
+
+func (m *ModelRegistryService) CreateModelVersion(
+	ctx context.Context, input *protos.CreateModelVersion,
+) (*protos.CreateModelVersion_Response, *contract.Error) {
+	tags := make([]*entities.ModelVersionTag, 0, len(input.GetTags()))
+	isPrompt := false
+
+	for _, tag := range input.GetTags() {
+		tags = append(tags, entities.NewModelVersionTagFromProto(tag))
+		isPrompt = isPrompt || tag.GetKey() == sql.IsPromptTagKey
+	}
+
+	storageLocation := input.GetSource()
+
+	// Prompts don't point to model artifacts, their source is not validated.
+	if !isPrompt {
+		var err *contract.Error
+
+		storageLocation, err = m.resolveModelVersionSource(ctx, input.GetSource(), input.GetRunId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	modelVersion, err := m.store.CreateModelVersion(
+		ctx,
+		input.GetName(),
+		input.GetSource(),
+		input.GetRunId(),
+		tags,
+		input.GetRunLink(),
+		input.GetDescription(),
+		storageLocation,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateModelVersion_Response{
+		ModelVersion: modelVersion.ToProto(),
+	}, nil
+}
+
 func (m *ModelRegistryService) UpdateModelVersion(
 	ctx context.Context, input *protos.UpdateModelVersion,
 ) (*protos.UpdateModelVersion_Response, *contract.Error) {
@@ -64,6 +64,34 @@ func (m *ModelRegistryService) GetRegisteredModel(
 	}, nil
 }
 
+func (m *ModelRegistryService) SearchRegisteredModels(
+	ctx context.Context, input *protos.SearchRegisteredModels,
+) (*protos.SearchRegisteredModels_Response, *contract.Error) {
+	registeredModels, nextPageToken, err := m.store.SearchRegisteredModels(
+		ctx,
+		input.GetFilter(),
+		input.GetMaxResults(),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchRegisteredModels_Response{
+		RegisteredModels: make([]*protos.RegisteredModel, len(registeredModels)),
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, registeredModel := range registeredModels {
+		response.RegisteredModels[i] = registeredModel.ToProto()
+	}
+
+	return &response, nil
+}
+
 func (m *ModelRegistryService) SetRegisteredModelTag(
 	ctx context.Context, input *protos.SetRegisteredModelTag,
 ) (*protos.SetRegisteredModelTag_Response, *contract.Error) {
@@ -6,26 +6,36 @@ import (
 
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store"
-	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql"
+	trackingStore "github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
 )
 
 type ModelRegistryService struct {
 	store  store.ModelRegistryStore
 	config *config.Config
+	// trackingStore validates the runs of new model versions, it is owned by the tracking service of the server
+	// and nil for the Python store which validates them itself.
+	trackingStore trackingStore.TrackingStore
 }
 
-func NewModelRegistryService(ctx context.Context, config *config.Config) (*ModelRegistryService, error) {
-	store, err := sql.NewModelRegistrySQLStore(ctx, config)
+func NewModelRegistryService(
+	ctx context.Context, config *config.Config, tracking trackingStore.TrackingStore,
+) (*ModelRegistryService, error) {
+	store, err := store.NewModelRegistryStore(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+		return nil, fmt.Errorf("failed to create model registry store: %w", err)
 	}
 
 	return &ModelRegistryService{
-		store:  store,
-		config: config,
+		store:         store,
+		config:        config,
+		trackingStore: tracking,
 	}, nil
 }
 
+func (m *ModelRegistryService) Store() store.ModelRegistryStore { //nolint:ireturn
+	return m.store
+}
+
 func (m *ModelRegistryService) Destroy() error {
 	if err := m.store.Destroy(); err != nil {
 		return fmt.Errorf("failed to close store: %w", err)
@@ -0,0 +1,525 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// modelVersionMeta is the content of models/<name>/version-<version>/meta.yaml.
+type modelVersionMeta struct {
+	Name                 string `yaml:"name"`
+	Version              int32  `yaml:"version"`
+	CreationTimestamp    int64  `yaml:"creation_timestamp"`
+	LastUpdatedTimestamp int64  `yaml:"last_updated_timestamp"`
+	Description          string `yaml:"description"`
+	UserID               string `yaml:"user_id"`
+	CurrentStage         string `yaml:"current_stage"`
+	Source               string `yaml:"source"`
+	RunID                string `yaml:"run_id"`
+	RunLink              string `yaml:"run_link"`
+	Status               string `yaml:"status"`
+	StatusMessage        string `yaml:"status_message"`
+	StorageLocation      string `yaml:"storage_location,omitempty"`
+}
+
+func modelVersionNotFound(name, version string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Model Version (name=%s, version=%s) not found", name, version),
+	)
+}
+
+func (m *ModelRegistryFileStore) modelVersionDir(name string, version int32) string {
+	return filepath.Join(m.registeredModelDir(name), modelVersionDirPrefix+strconv.Itoa(int(version)))
+}
+
+// modelVersionNumbers returns the versions of a registered model in ascending order, including the deleted ones.
+func (m *ModelRegistryFileStore) modelVersionNumbers(name string) ([]int32, *contract.Error) {
+	dirs, err := filestore.ListDirectories(m.registeredModelDir(name))
+	if err != nil {
+		return nil, newInternalError("failed to list model versions", err)
+	}
+
+	versions := make([]int32, 0, len(dirs))
+
+	for _, dir := range dirs {
+		number, ok := strings.CutPrefix(dir, modelVersionDirPrefix)
+		if !ok {
+			continue
+		}
+
+		version, err := strconv.ParseInt(number, 10, 32)
+		if err != nil || !filestore.Exists(filepath.Join(m.registeredModelDir(name), dir, filestore.MetaFileName)) {
+			continue
+		}
+
+		versions = append(versions, int32(version))
+	}
+
+	slices.Sort(versions)
+
+	return versions, nil
+}
+
+func (m *ModelRegistryFileStore) readModelVersionMeta(name string, version int32) (*modelVersionMeta, *contract.Error) {
+	var meta modelVersionMeta
+	if err := filestore.ReadYAML(
+		filepath.Join(m.modelVersionDir(name, version), filestore.MetaFileName), &meta,
+	); err != nil {
+		return nil, newInternalError(
+			fmt.Sprintf("failed to get Model Version by name %s and version %d", name, version), err,
+		)
+	}
+
+	return &meta, nil
+}
+
+func (m *ModelRegistryFileStore) writeModelVersionMeta(meta *modelVersionMeta) *contract.Error {
+	if err := filestore.WriteYAML(
+		filepath.Join(m.modelVersionDir(meta.Name, meta.Version), filestore.MetaFileName), meta,
+	); err != nil {
+		return newInternalError(
+			fmt.Sprintf("failed to write Model Version (name=%s, version=%d)", meta.Name, meta.Version), err,
+		)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) updateModelVersionMeta(
+	name string, version int32, update func(meta *modelVersionMeta),
+) *contract.Error {
+	meta, err := m.readModelVersionMeta(name, version)
+	if err != nil {
+		return err
+	}
+
+	update(meta)
+
+	return m.writeModelVersionMeta(meta)
+}
+
+func (m *ModelRegistryFileStore) readModelVersion(
+	name string, version int32, eager bool, aliases []*entities.RegisteredModelAlias,
+) (*entities.ModelVersion, *contract.Error) {
+	meta, err := m.readModelVersionMeta(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersion := &entities.ModelVersion{
+		Name:            meta.Name,
+		Version:         meta.Version,
+		CreationTime:    meta.CreationTimestamp,
+		LastUpdatedTime: meta.LastUpdatedTimestamp,
+		Description:     meta.Description,
+		UserID:          meta.UserID,
+		CurrentStage:    meta.CurrentStage,
+		Source:          meta.Source,
+		RunID:           meta.RunID,
+		Status:          meta.Status,
+		StatusMessage:   meta.StatusMessage,
+		RunLink:         meta.RunLink,
+		StorageLocation: meta.StorageLocation,
+		Tags:            make([]*entities.ModelVersionTag, 0),
+		Aliases:         make([]*entities.RegisteredModelAlias, 0),
+	}
+
+	// Tags are only loaded by demand, like in the SQL store.
+	if eager {
+		values, err := filestore.ReadKeyValues(filepath.Join(m.modelVersionDir(name, version), tagsFolder))
+		if err != nil {
+			return nil, newInternalError("failed to read model version tags", err)
+		}
+
+		for _, key := range filestore.SortedKeys(values) {
+			modelVersion.Tags = append(modelVersion.Tags, &entities.ModelVersionTag{Key: key, Value: values[key]})
+		}
+	}
+
+	for _, alias := range aliases {
+		if alias.Version == strconv.Itoa(int(version)) {
+			modelVersion.Aliases = append(modelVersion.Aliases, alias)
+		}
+	}
+
+	return modelVersion, nil
+}
+
+// readModelVersions returns the versions of a registered model in ascending order, including the deleted ones.
+func (m *ModelRegistryFileStore) readModelVersions(
+	name string, eager bool,
+) ([]*entities.ModelVersion, *contract.Error) {
+	versions, err := m.modelVersionNumbers(name)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := m.readAliases(name)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersions := make([]*entities.ModelVersion, 0, len(versions))
+
+	for _, version := range versions {
+		modelVersion, err := m.readModelVersion(name, version, eager, aliases)
+		if err != nil {
+			return nil, err
+		}
+
+		modelVersions = append(modelVersions, modelVersion)
+	}
+
+	return modelVersions, nil
+}
+
+// activeModelVersion returns the metadata of a model version which is not deleted.
+func (m *ModelRegistryFileStore) activeModelVersion(name, version string) (*modelVersionMeta, *contract.Error) {
+	number, err := strconv.ParseInt(version, 10, 32)
+	if err != nil || !filestore.IsValidName(name) {
+		return nil, modelVersionNotFound(name, version)
+	}
+
+	if !filestore.Exists(filepath.Join(m.modelVersionDir(name, int32(number)), filestore.MetaFileName)) {
+		return nil, modelVersionNotFound(name, version)
+	}
+
+	meta, contractErr := m.readModelVersionMeta(name, int32(number))
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	if meta.CurrentStage == models.StageDeletedInternal {
+		return nil, modelVersionNotFound(name, version)
+	}
+
+	return meta, nil
+}
+
+func (m *ModelRegistryFileStore) getModelVersion(
+	name, version string, eager bool,
+) (*entities.ModelVersion, *contract.Error) {
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := m.readAliases(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.readModelVersion(name, meta.Version, eager, aliases)
+}
+
+func (m *ModelRegistryFileStore) GetModelVersion(
+	_ context.Context, name, version string, eager bool,
+) (*entities.ModelVersion, *contract.Error) {
+	return m.getModelVersion(name, version, eager)
+}
+
+func (m *ModelRegistryFileStore) GetLatestVersions(
+	_ context.Context, name string, stages []string,
+) ([]*entities.ModelVersion, *contract.Error) {
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return nil, err
+	}
+
+	canonicalStages := make([]string, 0, len(stages))
+
+	for _, stage := range stages {
+		canonicalStage, ok := models.CanonicalMapping[strings.ToLower(stage)]
+		if !ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_BAD_REQUEST,
+				fmt.Sprintf(
+					"Invalid Model Version stage: %s. Value must be one of %s.",
+					stage,
+					models.AllModelVersionStages(),
+				),
+			)
+		}
+
+		canonicalStages = append(canonicalStages, canonicalStage.String())
+	}
+
+	modelVersions, err := m.readModelVersions(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return latestVersionsByStage(modelVersions, canonicalStages), nil
+}
+
+func (m *ModelRegistryFileStore) CreateModelVersion(
+	_ context.Context,
+	name, source, runID string,
+	tags []*entities.ModelVersionTag,
+	runLink, description, storageLocation string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return nil, err
+	}
+
+	versions, err := m.modelVersionNumbers(name)
+	if err != nil {
+		return nil, err
+	}
+
+	version := int32(1)
+	if len(versions) > 0 {
+		version = versions[len(versions)-1] + 1
+	}
+
+	creationTime := time.Now().UnixMilli()
+
+	if err := m.writeModelVersionMeta(&modelVersionMeta{
+		Name:                 name,
+		Version:              version,
+		CreationTimestamp:    creationTime,
+		LastUpdatedTimestamp: creationTime,
+		Description:          description,
+		CurrentStage:         models.ModelVersionStageNone,
+		Source:               source,
+		RunID:                runID,
+		RunLink:              runLink,
+		Status:               protos.ModelVersionStatus_READY.String(),
+		StorageLocation:      storageLocation,
+	}); err != nil {
+		return nil, err
+	}
+
+	// The last value of a duplicated key wins, like in the Python store.
+	for _, tag := range tags {
+		if err := m.writeModelVersionTag(name, version, tag.Key, tag.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := m.touchRegisteredModel(name, creationTime); err != nil {
+		return nil, err
+	}
+
+	return m.getModelVersion(name, strconv.Itoa(int(version)), true)
+}
+
+func (m *ModelRegistryFileStore) DeleteModelVersion(_ context.Context, name, version string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	lastUpdatedTime := time.Now().UnixMilli()
+
+	if err := m.touchRegisteredModel(name, lastUpdatedTime); err != nil {
+		return err
+	}
+
+	aliases, err := m.readAliases(name)
+	if err != nil {
+		return err
+	}
+
+	for _, alias := range aliases {
+		if alias.Version != version {
+			continue
+		}
+
+		if err := removeKey(filepath.Join(m.registeredModelDir(name), aliasesFolder), alias.Alias); err != nil {
+			return newInternalError("error deleting model version", err)
+		}
+	}
+
+	// The version is kept, so that its number is never reused.
+	return m.writeModelVersionMeta(&modelVersionMeta{
+		Name:                 meta.Name,
+		Version:              meta.Version,
+		CreationTimestamp:    meta.CreationTimestamp,
+		LastUpdatedTimestamp: lastUpdatedTime,
+		CurrentStage:         models.StageDeletedInternal,
+		Source:               "REDACTED-SOURCE-PATH",
+		RunID:                "REDACTED-RUN-ID",
+		RunLink:              "REDACTED-RUN-LINK",
+		Status:               meta.Status,
+		StorageLocation:      meta.StorageLocation,
+	})
+}
+
+func (m *ModelRegistryFileStore) UpdateModelVersion(
+	_ context.Context, name, version, description string,
+) (*entities.ModelVersion, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.Description = description
+	meta.LastUpdatedTimestamp = time.Now().UnixMilli()
+
+	if err := m.writeModelVersionMeta(meta); err != nil {
+		return nil, err
+	}
+
+	return m.getModelVersion(name, version, false)
+}
+
+func (m *ModelRegistryFileStore) TransitionModelVersionStage(
+	_ context.Context, name, version string, stage models.ModelVersionStage, archiveExistingVersions bool,
+) (*entities.ModelVersion, *contract.Error) {
+	if _, ok := models.DefaultStagesForGetLatestVersions[strings.ToLower(stage.String())]; archiveExistingVersions && !ok {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				`Model version transition cannot archive existing model versions because '%s' is not an Active stage. 
+Valid stages are %s`,
+				stage, models.AllModelVersionStages(),
+			),
+		)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	lastUpdatedTime := time.Now().UnixMilli()
+
+	if err := m.touchRegisteredModel(name, lastUpdatedTime); err != nil {
+		return nil, err
+	}
+
+	if archiveExistingVersions {
+		versions, err := m.modelVersionNumbers(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range versions {
+			if other == meta.Version {
+				continue
+			}
+
+			if err := m.updateModelVersionMeta(name, other, func(otherMeta *modelVersionMeta) {
+				if otherMeta.CurrentStage == stage.String() {
+					otherMeta.CurrentStage = models.ModelVersionStageArchived
+					otherMeta.LastUpdatedTimestamp = lastUpdatedTime
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	meta.CurrentStage = stage.String()
+	meta.LastUpdatedTimestamp = lastUpdatedTime
+
+	if err := m.writeModelVersionMeta(meta); err != nil {
+		return nil, err
+	}
+
+	return m.getModelVersion(name, version, false)
+}
+
+func (m *ModelRegistryFileStore) writeModelVersionTag(name string, version int32, key, value string) *contract.Error {
+	path := filestore.KeyPath(filepath.Join(m.modelVersionDir(name, version), tagsFolder), key)
+	if err := filestore.WriteFile(path, []byte(value)); err != nil {
+		return newInternalError("error setting model version tag", err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) SetModelVersionTag(
+	_ context.Context, name, version, key, value string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	return m.writeModelVersionTag(name, meta.Version, key, value)
+}
+
+func (m *ModelRegistryFileStore) DeleteModelVersionTag(
+	_ context.Context, name, version, key string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.activeModelVersion(name, version)
+	if err != nil {
+		return err
+	}
+
+	if err := removeKey(filepath.Join(m.modelVersionDir(name, meta.Version), tagsFolder), key); err != nil {
+		return newInternalError("error deleting model version tag", err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) GetModelVersionByAlias(
+	_ context.Context, name, alias string,
+) (*entities.ModelVersion, *contract.Error) {
+	aliases, err := m.readAliases(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, registeredModelAlias := range aliases {
+		if registeredModelAlias.Alias == alias {
+			return m.getModelVersion(name, registeredModelAlias.Version, false)
+		}
+	}
+
+	return nil, contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf("Registered model alias %s not found.", alias),
+	)
+}
+
+func (m *ModelRegistryFileStore) GetModelVersionDownloadURI(
+	_ context.Context, name, version string,
+) (string, *contract.Error) {
+	modelVersion, err := m.getModelVersion(name, version, false)
+	if err != nil {
+		return "", err
+	}
+
+	if modelVersion.StorageLocation != "" {
+		return modelVersion.StorageLocation, nil
+	}
+
+	return modelVersion.Source, nil
+}
@@ -0,0 +1,411 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// registeredModelMeta is the content of models/<name>/meta.yaml.
+type registeredModelMeta struct {
+	Name                 string  `yaml:"name"`
+	CreationTimestamp    int64   `yaml:"creation_timestamp"`
+	LastUpdatedTimestamp int64   `yaml:"last_updated_timestamp"`
+	Description          *string `yaml:"description"`
+}
+
+func registeredModelNotFound(name string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Registered Model with name=%s not found", name),
+	)
+}
+
+func (m *ModelRegistryFileStore) registeredModelDir(name string) string {
+	return filepath.Join(m.root, modelsFolder, name)
+}
+
+func (m *ModelRegistryFileStore) readRegisteredModelMeta(name string) (*registeredModelMeta, *contract.Error) {
+	if !filestore.IsValidName(name) {
+		return nil, registeredModelNotFound(name)
+	}
+
+	path := filepath.Join(m.registeredModelDir(name), filestore.MetaFileName)
+	if !filestore.Exists(path) {
+		return nil, registeredModelNotFound(name)
+	}
+
+	var meta registeredModelMeta
+	if err := filestore.ReadYAML(path, &meta); err != nil {
+		//nolint:perfsprint
+		return nil, newInternalError(fmt.Sprintf("failed to get Registered Model by name %s", name), err)
+	}
+
+	return &meta, nil
+}
+
+func (m *ModelRegistryFileStore) writeRegisteredModelMeta(meta *registeredModelMeta) *contract.Error {
+	if err := filestore.WriteYAML(
+		filepath.Join(m.registeredModelDir(meta.Name), filestore.MetaFileName), meta,
+	); err != nil {
+		return newInternalError(fmt.Sprintf("failed to write Registered Model %s", meta.Name), err)
+	}
+
+	return nil
+}
+
+// touchRegisteredModel updates the last update of a registered model.
+func (m *ModelRegistryFileStore) touchRegisteredModel(name string, lastUpdatedTime int64) *contract.Error {
+	meta, err := m.readRegisteredModelMeta(name)
+	if err != nil {
+		return err
+	}
+
+	meta.LastUpdatedTimestamp = lastUpdatedTime
+
+	return m.writeRegisteredModelMeta(meta)
+}
+
+// readAliases returns the aliases of a registered model, each alias file contains its version.
+func (m *ModelRegistryFileStore) readAliases(name string) ([]*entities.RegisteredModelAlias, *contract.Error) {
+	if !filestore.IsValidName(name) {
+		return make([]*entities.RegisteredModelAlias, 0), nil
+	}
+
+	values, err := filestore.ReadKeyValues(filepath.Join(m.registeredModelDir(name), aliasesFolder))
+	if err != nil {
+		return nil, newInternalError("failed to read registered model aliases", err)
+	}
+
+	aliases := make([]*entities.RegisteredModelAlias, 0, len(values))
+	for _, alias := range filestore.SortedKeys(values) {
+		aliases = append(aliases, &entities.RegisteredModelAlias{
+			Alias:   alias,
+			Version: strings.TrimSpace(values[alias]),
+		})
+	}
+
+	return aliases, nil
+}
+
+func (m *ModelRegistryFileStore) readRegisteredModel(name string) (*entities.RegisteredModel, *contract.Error) {
+	meta, err := m.readRegisteredModelMeta(name)
+	if err != nil {
+		return nil, err
+	}
+
+	values, readErr := filestore.ReadKeyValues(filepath.Join(m.registeredModelDir(name), tagsFolder))
+	if readErr != nil {
+		return nil, newInternalError("failed to read registered model tags", readErr)
+	}
+
+	tags := make([]*entities.RegisteredModelTag, 0, len(values))
+	for _, key := range filestore.SortedKeys(values) {
+		tags = append(tags, &entities.RegisteredModelTag{Key: key, Value: values[key]})
+	}
+
+	aliases, err := m.readAliases(name)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersions, err := m.readModelVersions(name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.RegisteredModel{
+		Name:            meta.Name,
+		Tags:            tags,
+		Aliases:         aliases,
+		Versions:        latestVersionsByStage(modelVersions, nil),
+		Description:     meta.Description,
+		CreationTime:    meta.CreationTimestamp,
+		LastUpdatedTime: meta.LastUpdatedTimestamp,
+	}, nil
+}
+
+func (m *ModelRegistryFileStore) GetRegisteredModel(
+	_ context.Context, name string,
+) (*entities.RegisteredModel, *contract.Error) {
+	return m.readRegisteredModel(name)
+}
+
+func (m *ModelRegistryFileStore) CreateRegisteredModel(
+	_ context.Context, name, description string, tags []*entities.RegisteredModelTag,
+) (*entities.RegisteredModel, *contract.Error) {
+	if !filestore.IsValidName(name) {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid name: '%s'. Registered model names cannot contain path separators.", name),
+		)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if existing, err := m.readRegisteredModel(name); err == nil {
+		return nil, sql.HandleResourceAlreadyExistError(
+			name,
+			utils.FindElementByProperty(existing.Tags, func(tag *entities.RegisteredModelTag) bool {
+				return tag.Key == sql.IsPromptTagKey
+			}),
+			utils.FindElementByProperty(tags, func(tag *entities.RegisteredModelTag) bool {
+				return tag.Key == sql.IsPromptTagKey
+			}),
+		)
+	}
+
+	creationTime := time.Now().UnixMilli()
+	meta := &registeredModelMeta{
+		Name:                 name,
+		CreationTimestamp:    creationTime,
+		LastUpdatedTimestamp: creationTime,
+	}
+
+	if description != "" {
+		meta.Description = &description
+	}
+
+	if err := m.writeRegisteredModelMeta(meta); err != nil {
+		return nil, err
+	}
+
+	// The first value of a duplicated key wins, like in the SQL store.
+	written := map[string]struct{}{}
+
+	for _, tag := range tags {
+		if _, ok := written[tag.Key]; ok {
+			continue
+		}
+
+		written[tag.Key] = struct{}{}
+
+		if err := m.writeRegisteredModelTag(name, tag.Key, tag.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.readRegisteredModel(name)
+}
+
+func (m *ModelRegistryFileStore) UpdateRegisteredModel(
+	_ context.Context, name, description string,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.readRegisteredModelMeta(name)
+	if err != nil {
+		return nil, err
+	}
+
+	meta.Description = &description
+	meta.LastUpdatedTimestamp = time.Now().UnixMilli()
+
+	if err := m.writeRegisteredModelMeta(meta); err != nil {
+		return nil, err
+	}
+
+	return m.readRegisteredModel(name)
+}
+
+func (m *ModelRegistryFileStore) RenameRegisteredModel(
+	_ context.Context, name, newName string,
+) (*entities.RegisteredModel, *contract.Error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	meta, err := m.readRegisteredModelMeta(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !filestore.IsValidName(newName) {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid name: '%s'. Registered model names cannot contain path separators.", newName),
+		)
+	}
+
+	if filestore.Exists(m.registeredModelDir(newName)) {
+		return nil, contract.NewError(
+			protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+			fmt.Sprintf("Registered Model (name=%s) already exists", newName),
+		)
+	}
+
+	if err := os.Rename(m.registeredModelDir(name), m.registeredModelDir(newName)); err != nil {
+		return nil, newInternalError("failed to rename registered model", err)
+	}
+
+	lastUpdatedTime := time.Now().UnixMilli()
+
+	meta.Name = newName
+	meta.LastUpdatedTimestamp = lastUpdatedTime
+
+	if err := m.writeRegisteredModelMeta(meta); err != nil {
+		return nil, err
+	}
+
+	versions, err := m.modelVersionNumbers(newName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions {
+		if err := m.updateModelVersionMeta(newName, version, func(meta *modelVersionMeta) {
+			meta.Name = newName
+			meta.LastUpdatedTimestamp = lastUpdatedTime
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.readRegisteredModel(newName)
+}
+
+func (m *ModelRegistryFileStore) DeleteRegisteredModel(_ context.Context, name string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(m.registeredModelDir(name)); err != nil {
+		return contract.NewError(
+			protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error deleting registered model: %v", err),
+		)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) writeRegisteredModelTag(name, key, value string) *contract.Error {
+	path := filestore.KeyPath(filepath.Join(m.registeredModelDir(name), tagsFolder), key)
+	if err := filestore.WriteFile(path, []byte(value)); err != nil {
+		return newInternalError("error creating registered model tag", err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) SetRegisteredModelTag(_ context.Context, name, key, value string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	return m.writeRegisteredModelTag(name, key, value)
+}
+
+// removeKey removes the file of a key, a missing key is not an error.
+func removeKey(dir, key string) error {
+	if err := os.Remove(filestore.KeyPath(dir, key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove %q: %w", key, err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) DeleteRegisteredModelTag(_ context.Context, name, key string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	if err := removeKey(filepath.Join(m.registeredModelDir(name), tagsFolder), key); err != nil {
+		return newInternalError("error deleting registered model tag", err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) SetRegisteredModelAlias(
+	_ context.Context, name, alias, version string,
+) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	if _, err := strconv.Atoi(version); err != nil {
+		return newInternalError("failed to parse registered model alias version", err)
+	}
+
+	path := filestore.KeyPath(filepath.Join(m.registeredModelDir(name), aliasesFolder), alias)
+	if err := filestore.WriteFile(path, []byte(version)); err != nil {
+		return newInternalError("failed to create registered model alias", err)
+	}
+
+	return nil
+}
+
+func (m *ModelRegistryFileStore) DeleteRegisteredModelAlias(_ context.Context, name, alias string) *contract.Error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, err := m.readRegisteredModelMeta(name); err != nil {
+		return err
+	}
+
+	if err := removeKey(filepath.Join(m.registeredModelDir(name), aliasesFolder), alias); err != nil {
+		return newInternalError("error deleting registered model alias", err)
+	}
+
+	return nil
+}
+
+// latestVersionsByStage returns the latest version of every stage, ignoring the deleted versions.
+// The stages are optional, all stages are considered without them.
+func latestVersionsByStage(modelVersions []*entities.ModelVersion, stages []string) []*entities.ModelVersion {
+	latest := map[string]*entities.ModelVersion{}
+
+	for _, modelVersion := range modelVersions {
+		stage := modelVersion.CurrentStage
+		if stage == models.StageDeletedInternal {
+			continue
+		}
+
+		if current, ok := latest[stage]; !ok || current.Version < modelVersion.Version {
+			latest[stage] = modelVersion
+		}
+	}
+
+	result := make([]*entities.ModelVersion, 0, len(latest))
+
+	// modelVersions are sorted by version, so are the latest versions.
+	for _, modelVersion := range modelVersions {
+		if latest[modelVersion.CurrentStage] != modelVersion {
+			continue
+		}
+
+		if len(stages) == 0 || slices.Contains(stages, modelVersion.CurrentStage) {
+			result = append(result, modelVersion)
+		}
+	}
+
+	return result
+}
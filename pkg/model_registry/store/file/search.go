@@ -0,0 +1,366 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// The filters and the orderings are ports of the search builders of the SQL store.
+
+//nolint:lll
+var (
+	filterAnd  = regexp.MustCompile(`(?i)\s+AND\s+`)
+	filterCond = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]*"|'[^']*'|[\w\.]+)$`)
+	orderByKey = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+)
+
+var stringOperators = map[string]parser.OperatorKind{
+	"=":     parser.Equals,
+	"!=":    parser.NotEquals,
+	"LIKE":  parser.Like,
+	"ILIKE": parser.ILike,
+}
+
+// matchesTag compares a tag, entities without the prompt tag are not prompts
+// and must match the "tags.`mlflow.prompt.is_prompt` != 'true'" filter of the Python client.
+func matchesTag(value *string, key string, operator parser.OperatorKind, expected any) bool {
+	if value == nil && key == sql.IsPromptTagKey && operator == parser.NotEquals {
+		return true
+	}
+
+	return filestore.CompareStrings(value, operator, expected)
+}
+
+//nolint:mnd
+func parseRegisteredModelsFilter(filter string) ([]*parser.ValidCompareExpr, *contract.Error) {
+	if filter == "" {
+		return nil, nil
+	}
+
+	conditions := make([]*parser.ValidCompareExpr, 0)
+
+	for _, f := range filterAnd.Split(strings.TrimSpace(filter), -1) {
+		parts := filterCond.FindStringSubmatch(f)
+		if len(parts) != 5 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("malformed filter '%s'", f),
+			)
+		}
+
+		entity, key, comparison, value := parts[1], strings.Trim(parts[2], "\"`"), strings.ToUpper(parts[3]), parts[4]
+
+		operator, ok := stringOperators[comparison]
+		if !ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid string comparison operator '%s'", comparison),
+			)
+		}
+
+		if !strings.HasPrefix(value, "'") && !strings.HasPrefix(value, `"`) {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid string value '%s', expected a quoted string", value),
+			)
+		}
+
+		condition := &parser.ValidCompareExpr{Key: key, Operator: operator, Value: value[1 : len(value)-1]}
+
+		switch entity {
+		case "", "attribute", "attributes", "attr":
+			if key != "name" {
+				return nil, contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("Invalid attribute key '%s' specified. Valid keys are '['name']'", key),
+				)
+			}
+
+			condition.Identifier = parser.Attribute
+		case "tag", "tags":
+			condition.Identifier = parser.Tag
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid entity type '%s'. Valid values are ['tag', 'tags', 'attribute']", entity),
+			)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+func registeredModelMatches(registeredModel *entities.RegisteredModel, conditions []*parser.ValidCompareExpr) bool {
+	for _, condition := range conditions {
+		if condition.Identifier == parser.Attribute {
+			if !filestore.CompareStrings(&registeredModel.Name, condition.Operator, condition.Value) {
+				return false
+			}
+
+			continue
+		}
+
+		var value *string
+
+		for _, tag := range registeredModel.Tags {
+			if tag.Key == condition.Key {
+				value = &tag.Value
+			}
+		}
+
+		if !matchesTag(value, condition.Key, condition.Operator, condition.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseOrderBy returns the keys and the directions of the order_by clauses,
+// the keys are resolved by column, which returns an empty string for unknown keys.
+func parseOrderBy(orderBy []string, column func(key string) string) ([]string, []bool, *contract.Error) {
+	columns := make([]string, 0, len(orderBy))
+	descending := make([]bool, 0, len(orderBy))
+
+	for _, o := range orderBy {
+		parts := orderByKey.FindStringSubmatch(strings.TrimSpace(o))
+		if len(parts) == 0 {
+			return nil, nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", o),
+			)
+		}
+
+		name := column(parts[1])
+		if name == "" {
+			return nil, nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order by key '%s' specified.", parts[1]),
+			)
+		}
+
+		if slices.Contains(columns, name) {
+			return nil, nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		columns = append(columns, name)
+		descending = append(descending, strings.ToUpper(parts[2]) == "DESC")
+	}
+
+	return columns, descending, nil
+}
+
+func registeredModelSortValue(registeredModel *entities.RegisteredModel, column string) filestore.SortValue {
+	if column == "name" {
+		return filestore.SortValue{Text: registeredModel.Name}
+	}
+
+	return filestore.SortValue{Number: float64(registeredModel.LastUpdatedTime)}
+}
+
+func (m *ModelRegistryFileStore) SearchRegisteredModels(
+	_ context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.RegisteredModel, string, *contract.Error) {
+	conditions, err := parseRegisteredModelsFilter(filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	columns, descending, err := parseOrderBy(orderBy, func(key string) string {
+		switch key {
+		case "name":
+			return "name"
+		case "timestamp", "last_updated_timestamp":
+			return "last_updated_time"
+		default:
+			return ""
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	names, listErr := filestore.ListDirectories(filepath.Join(m.root, modelsFolder))
+	if listErr != nil {
+		return nil, "", newInternalError("failed to search registered models", listErr)
+	}
+
+	registeredModels := make([]*entities.RegisteredModel, 0, len(names))
+
+	for _, name := range names {
+		registeredModel, err := m.readRegisteredModel(name)
+		if err != nil {
+			if protos.ErrorCode(err.Code) == protos.ErrorCode_RESOURCE_DOES_NOT_EXIST {
+				continue
+			}
+
+			return nil, "", err
+		}
+
+		if registeredModelMatches(registeredModel, conditions) {
+			registeredModels = append(registeredModels, registeredModel)
+		}
+	}
+
+	// The name is always used as tiebreaker.
+	if !slices.Contains(columns, "name") {
+		columns = append(columns, "name")
+		descending = append(descending, false)
+	}
+
+	clauses := make([]filestore.SortClause, 0, len(columns))
+	for i, column := range columns {
+		clauses = append(clauses, filestore.SortClause{
+			Descending: descending[i],
+			Value: func(index int) filestore.SortValue {
+				return registeredModelSortValue(registeredModels[index], column)
+			},
+		})
+	}
+
+	return filestore.Paginate(filestore.SortByClauses(registeredModels, clauses), int(maxResults), pageToken)
+}
+
+func modelVersionMatches(
+	modelVersion *entities.ModelVersion, conditions []*parser.ValidCompareExpr,
+) bool {
+	for _, condition := range conditions {
+		var matches bool
+
+		switch {
+		case condition.Identifier == parser.Tag:
+			var value *string
+
+			for _, tag := range modelVersion.Tags {
+				if tag.Key == condition.Key {
+					value = &tag.Value
+				}
+			}
+
+			matches = matchesTag(value, condition.Key, condition.Operator, condition.Value)
+		case condition.Key == "version":
+			expected, _ := condition.Value.(int64)
+			matches = filestore.CompareNumbers(
+				utils.PtrTo(float64(modelVersion.Version)), condition.Operator, float64(expected),
+			)
+		case condition.Key == "name":
+			matches = filestore.CompareStrings(&modelVersion.Name, condition.Operator, condition.Value)
+		case condition.Key == parser.RunID:
+			matches = filestore.CompareStrings(&modelVersion.RunID, condition.Operator, condition.Value)
+		case condition.Key == "source":
+			matches = filestore.CompareStrings(&modelVersion.Source, condition.Operator, condition.Value)
+		}
+
+		if !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+func modelVersionSortValue(modelVersion *entities.ModelVersion, column string) filestore.SortValue {
+	switch column {
+	case "name":
+		return filestore.SortValue{Text: modelVersion.Name}
+	case "version":
+		return filestore.SortValue{Number: float64(modelVersion.Version)}
+	case "creation_time":
+		return filestore.SortValue{Number: float64(modelVersion.CreationTime)}
+	default:
+		return filestore.SortValue{Number: float64(modelVersion.LastUpdatedTime)}
+	}
+}
+
+//nolint:cyclop
+func (m *ModelRegistryFileStore) SearchModelVersions(
+	_ context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.ModelVersion, string, *contract.Error) {
+	conditions, parseErr := query.ParseModelVersionFilter(filter)
+	if parseErr != nil {
+		return nil, "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", parseErr),
+		)
+	}
+
+	columns, descending, err := parseOrderBy(orderBy, func(key string) string {
+		switch key {
+		case "name":
+			return "name"
+		case "version_number", "version":
+			return "version"
+		case "creation_timestamp":
+			return "creation_time"
+		case "last_updated_timestamp":
+			return "last_updated_time"
+		default:
+			return ""
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	names, listErr := filestore.ListDirectories(filepath.Join(m.root, modelsFolder))
+	if listErr != nil {
+		return nil, "", newInternalError("failed to search model versions", listErr)
+	}
+
+	modelVersions := make([]*entities.ModelVersion, 0)
+
+	for _, name := range names {
+		versions, err := m.readModelVersions(name, true)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, modelVersion := range versions {
+			if modelVersion.CurrentStage != models.StageDeletedInternal && modelVersionMatches(modelVersion, conditions) {
+				modelVersions = append(modelVersions, modelVersion)
+			}
+		}
+	}
+
+	// Model versions are ordered by name ASC and version DESC as tiebreakers.
+	if !slices.Contains(columns, "name") {
+		columns = append(columns, "name")
+		descending = append(descending, false)
+	}
+
+	if !slices.Contains(columns, "version") {
+		columns = append(columns, "version")
+		descending = append(descending, true)
+	}
+
+	clauses := make([]filestore.SortClause, 0, len(columns))
+	for i, column := range columns {
+		clauses = append(clauses, filestore.SortClause{
+			Descending: descending[i],
+			Value: func(index int) filestore.SortValue {
+				return modelVersionSortValue(modelVersions[index], column)
+			},
+		})
+	}
+
+	return filestore.Paginate(filestore.SortByClauses(modelVersions, clauses), int(maxResults), pageToken)
+}
@@ -0,0 +1,68 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	modelsFolder          = "models"
+	tagsFolder            = "tags"
+	aliasesFolder         = "aliases"
+	modelVersionDirPrefix = "version-"
+)
+
+var errNotAFileStoreURI = errors.New("store URI is neither a local path nor a file:// URI")
+
+// ModelRegistryFileStore reads and writes the models directory layout of the Python FileStore.
+//
+//	<root>/models/<name>/meta.yaml
+//	<root>/models/<name>/{tags,aliases}/<key>
+//	<root>/models/<name>/version-<version>/meta.yaml
+//	<root>/models/<name>/version-<version>/tags/<key>
+type ModelRegistryFileStore struct {
+	config *config.Config
+	root   string
+	// mutex serializes the writes, the file store has no transactions.
+	mutex *sync.Mutex
+}
+
+func NewModelRegistryFileStore(_ context.Context, config *config.Config) (*ModelRegistryFileStore, error) {
+	root, ok := utils.LocalPathFromURI(config.ModelRegistryStoreURI)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errNotAFileStoreURI, config.ModelRegistryStoreURI)
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve file store root %q: %w", config.ModelRegistryStoreURI, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, modelsFolder), filestore.DirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create file store root %q: %w", root, err)
+	}
+
+	return &ModelRegistryFileStore{
+		config: config,
+		root:   root,
+		mutex:  &sync.Mutex{},
+	}, nil
+}
+
+func (m *ModelRegistryFileStore) Destroy() error {
+	return nil
+}
+
+func newInternalError(message string, err error) *contract.Error {
+	return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, message, err)
+}
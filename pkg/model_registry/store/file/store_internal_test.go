@@ -0,0 +1,92 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func TestModelVersionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewModelRegistryFileStore(ctx, &config.Config{ModelRegistryStoreURI: t.TempDir()})
+	require.NoError(t, err)
+
+	_, contractErr := store.CreateRegisteredModel(ctx, "model", "", nil)
+	require.Nil(t, contractErr)
+
+	_, contractErr = store.CreateRegisteredModel(ctx, "model", "", nil)
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_ALREADY_EXISTS, protos.ErrorCode(contractErr.Code))
+
+	for _, source := range []string{"a", "b", "c"} {
+		_, contractErr = store.CreateModelVersion(ctx, "model", source, "", nil, "", "", "")
+		require.Nil(t, contractErr)
+	}
+
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, "model", "champion", "3"))
+
+	_, contractErr = store.TransitionModelVersionStage(ctx, "model", "1", models.ModelVersionStageProduction, false)
+	require.Nil(t, contractErr)
+
+	_, contractErr = store.TransitionModelVersionStage(ctx, "model", "2", models.ModelVersionStageProduction, true)
+	require.Nil(t, contractErr)
+
+	latestVersions, contractErr := store.GetLatestVersions(ctx, "model", []string{"production"})
+	require.Nil(t, contractErr)
+	require.Len(t, latestVersions, 1)
+	assert.Equal(t, int32(2), latestVersions[0].Version)
+
+	modelVersion, contractErr := store.GetModelVersion(ctx, "model", "1", false)
+	require.Nil(t, contractErr)
+	assert.Equal(t, models.ModelVersionStageArchived, modelVersion.CurrentStage)
+
+	modelVersion, contractErr = store.GetModelVersionByAlias(ctx, "model", "champion")
+	require.Nil(t, contractErr)
+	assert.Equal(t, "c", modelVersion.Source)
+
+	// Deleting a version removes its aliases, its number is never reused.
+	require.Nil(t, store.DeleteModelVersion(ctx, "model", "3"))
+
+	_, contractErr = store.GetModelVersionByAlias(ctx, "model", "champion")
+	require.NotNil(t, contractErr)
+
+	modelVersion, contractErr = store.CreateModelVersion(
+		ctx, "model", "d", "", []*entities.ModelVersionTag{{Key: "key", Value: "value"}}, "", "", "",
+	)
+	require.Nil(t, contractErr)
+	assert.Equal(t, int32(4), modelVersion.Version)
+
+	modelVersions, _, contractErr := store.SearchModelVersions(ctx, "tags.key = 'value'", 10, nil, "")
+	require.Nil(t, contractErr)
+	require.Len(t, modelVersions, 1)
+	assert.Equal(t, int32(4), modelVersions[0].Version)
+
+	modelVersions, _, contractErr = store.SearchModelVersions(ctx, "", 10, []string{"version_number ASC"}, "")
+	require.Nil(t, contractErr)
+
+	versions := make([]int32, 0, len(modelVersions))
+	for _, modelVersion := range modelVersions {
+		versions = append(versions, modelVersion.Version)
+	}
+
+	assert.Equal(t, []int32{1, 2, 4}, versions)
+
+	registeredModel, contractErr := store.RenameRegisteredModel(ctx, "model", "renamed")
+	require.Nil(t, contractErr)
+	assert.Equal(t, "renamed", registeredModel.Name)
+
+	registeredModels, _, contractErr := store.SearchRegisteredModels(ctx, "name LIKE 'ren%'", 10, nil, "")
+	require.Nil(t, contractErr)
+	require.Len(t, registeredModels, 1)
+	assert.Len(t, registeredModels[0].Versions, 3)
+}
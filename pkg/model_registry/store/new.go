@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/file"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// NewModelRegistryStore returns the file store for file:// URIs and local paths, the SQL store otherwise.
+//
+//nolint:ireturn
+func NewModelRegistryStore(ctx context.Context, config *config.Config) (ModelRegistryStore, error) {
+	if _, ok := utils.LocalPathFromURI(config.ModelRegistryStoreURI); ok {
+		store, err := file.NewModelRegistryFileStore(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new file store: %w", err)
+		}
+
+		return store, nil
+	}
+
+	store, err := sql.NewModelRegistrySQLStore(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+	}
+
+	return store, nil
+}
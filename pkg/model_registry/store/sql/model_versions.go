@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 func (m *ModelRegistrySQLStore) GetLatestVersions(
@@ -134,6 +136,222 @@ func (m *ModelRegistrySQLStore) GetModelVersion(
 	return modelVersion.ToEntity(), nil
 }
 
+// Number of attempts to create a model version when concurrent registrations claim the same version.
+const createModelVersionRetries = 3
+
+//nolint:funlen
+func (m *ModelRegistrySQLStore) CreateModelVersion(
+	ctx context.Context,
+	name, source, runID string,
+	tags []*entities.ModelVersionTag,
+	runLink, description, storageLocation string,
+) (*entities.ModelVersion, *contract.Error) {
+	if _, err := m.GetRegisteredModel(ctx, name); err != nil {
+		return nil, err
+	}
+
+	creationTime := time.Now().UnixMilli()
+
+	modelVersion := models.ModelVersion{
+		Name:            name,
+		CreationTime:    creationTime,
+		LastUpdatedTime: creationTime,
+		CurrentStage:    models.ModelVersionStageNone,
+		Source:          source,
+		RunID:           runID,
+		Status:          protos.ModelVersionStatus_READY.String(),
+		RunLink:         runLink,
+		StorageLocation: storageLocation,
+	}
+	if description != "" {
+		modelVersion.Description = sql.NullString{String: description, Valid: true}
+	}
+
+	for attempt := range createModelVersionRetries {
+		err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+			// Touching the registered model first locks its row, so concurrent registrations
+			// of the same model wait for each other before reading the latest version.
+			if err := transaction.Model(
+				&models.RegisteredModel{},
+			).Where(
+				"name = ?", name,
+			).Update(
+				"last_updated_time", creationTime,
+			).Error; err != nil {
+				return err
+			}
+
+			var latestVersion sql.NullInt32
+			if err := transaction.Model(
+				&models.ModelVersion{},
+			).Where(
+				"name = ?", name,
+			).Select(
+				"MAX(version)",
+			).Scan(
+				&latestVersion,
+			).Error; err != nil {
+				return err
+			}
+
+			modelVersion.Version = latestVersion.Int32 + 1
+
+			modelVersion.Tags = make([]models.ModelVersionTag, 0, len(tags))
+			uniqueTagMap := map[string]int{}
+
+			for _, tag := range tags {
+				// the last value of a duplicated key wins, like in the Python store.
+				if index, ok := uniqueTagMap[tag.Key]; ok {
+					modelVersion.Tags[index].Value = tag.Value
+
+					continue
+				}
+
+				uniqueTagMap[tag.Key] = len(modelVersion.Tags)
+				modelVersion.Tags = append(
+					modelVersion.Tags, models.ModelVersionTagFromEntity(name, modelVersion.Version, tag),
+				)
+			}
+
+			return transaction.Create(&modelVersion).Error
+		})
+
+		switch {
+		case err == nil:
+			return m.GetModelVersion(ctx, name, strconv.Itoa(int(modelVersion.Version)), true)
+		case errors.Is(err, gorm.ErrDuplicatedKey):
+			utils.GetLoggerFromContext(ctx).Infof(
+				"Model Version creation error (name=%s), retrying %d more time(s).",
+				name, createModelVersionRetries-attempt-1,
+			)
+		default:
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to create Model Version (name=%s)", name),
+				err,
+			)
+		}
+	}
+
+	return nil, contract.NewError(
+		protos.ErrorCode_INTERNAL_ERROR,
+		fmt.Sprintf(
+			"Model Version creation error (name=%s). Giving up after %d attempts.", name, createModelVersionRetries,
+		),
+	)
+}
+
+func (m *ModelRegistrySQLStore) SearchModelVersions(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.ModelVersion, string, *contract.Error) {
+	query, limit := applyLimitFilter(m.db.WithContext(ctx), maxResults)
+
+	query, offset, err := applyOffsetFilter(query, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query, err = applyModelVersionsFilter(m.db, query, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query, err = applyModelVersionsOrderBy(query, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var modelVersions []models.ModelVersion
+	if err := query.Where(
+		"model_versions.current_stage != ?", models.StageDeletedInternal,
+	).Preload(
+		"Tags",
+	).Find(
+		&modelVersions,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search model versions",
+			err,
+		)
+	}
+
+	nextPageToken, err := createNextPageToken(len(modelVersions), limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(modelVersions) > limit {
+		modelVersions = modelVersions[:limit]
+	}
+
+	if err := m.attachModelVersionAliases(ctx, modelVersions); err != nil {
+		return nil, "", err
+	}
+
+	data := make([]*entities.ModelVersion, len(modelVersions))
+	for i, modelVersion := range modelVersions {
+		data[i] = modelVersion.ToEntity()
+	}
+
+	return data, nextPageToken, nil
+}
+
+// aliasesBatchSize keeps the queries of the aliases under the limit of 2100 parameters of SQL Server.
+const aliasesBatchSize = 500
+
+// Aliases reference the version by number only, so they are loaded for all models of the versions at once.
+func (m *ModelRegistrySQLStore) attachModelVersionAliases(
+	ctx context.Context, modelVersions []models.ModelVersion,
+) *contract.Error {
+	if len(modelVersions) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(modelVersions))
+	for _, modelVersion := range modelVersions {
+		names = append(names, modelVersion.Name)
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	type modelVersionKey struct {
+		name    string
+		version int32
+	}
+
+	aliases := map[modelVersionKey][]models.RegisteredModelAlias{}
+
+	for batch := range slices.Chunk(names, aliasesBatchSize) {
+		var registeredModelAliases []models.RegisteredModelAlias
+		if err := m.db.WithContext(ctx).Where(
+			"name IN (?)", batch,
+		).Find(
+			&registeredModelAliases,
+		).Error; err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				"failed to get Registered Model Aliases of the model versions",
+				err,
+			)
+		}
+
+		for _, alias := range registeredModelAliases {
+			key := modelVersionKey{alias.Name, alias.Version}
+			aliases[key] = append(aliases[key], alias)
+		}
+	}
+
+	for i := range modelVersions {
+		modelVersions[i].Aliases = append(
+			modelVersions[i].Aliases, aliases[modelVersionKey{modelVersions[i].Name, modelVersions[i].Version}]...,
+		)
+	}
+
+	return nil
+}
+
 func (m *ModelRegistrySQLStore) DeleteModelVersion(ctx context.Context, name, version string) *contract.Error {
 	registeredModel, err := m.GetRegisteredModel(ctx, name)
 	if err != nil {
@@ -247,7 +465,7 @@ Valid stages are %s`,
 		return nil, err
 	}
 
-	if err := m.db.Transaction(func(transaction *gorm.DB) error {
+	if err := m.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
 		lastUpdatedTime := time.Now().UnixMilli()
 		if err := transaction.Model(
 			&models.RegisteredModel{},
@@ -0,0 +1,144 @@
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	trackingSql "github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
+)
+
+var modelVersionOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// Model versions are ordered by the given columns, followed by name ASC and version DESC as tiebreakers.
+func applyModelVersionsOrderBy(transaction *gorm.DB, orderBy []string) (*gorm.DB, *contract.Error) {
+	observedColumns := map[string]struct{}{}
+
+	for _, o := range orderBy {
+		parts := modelVersionOrder.FindStringSubmatch(strings.TrimSpace(o))
+		if len(parts) == 0 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", o),
+			)
+		}
+
+		var column string
+
+		switch parts[1] {
+		case "name":
+			column = "name"
+		case "version_number", "version":
+			column = "version"
+		case "creation_timestamp":
+			column = "creation_time"
+		case "last_updated_timestamp":
+			column = "last_updated_time"
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order by key '%s' specified.", parts[1]),
+			)
+		}
+
+		if _, ok := observedColumns[column]; ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		observedColumns[column] = struct{}{}
+
+		transaction = transaction.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "model_versions", Name: column},
+			Desc:   strings.ToUpper(parts[2]) == "DESC",
+		})
+	}
+
+	if _, ok := observedColumns["name"]; !ok {
+		transaction = transaction.Order("model_versions.name ASC")
+	}
+
+	if _, ok := observedColumns["version"]; !ok {
+		transaction = transaction.Order("model_versions.version DESC")
+	}
+
+	return transaction, nil
+}
+
+//nolint:funlen
+func applyModelVersionsFilter(database, transaction *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
+	filterConditions, err := query.ParseModelVersionFilter(filter)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", err),
+		)
+	}
+
+	for index, condition := range filterConditions {
+		comparison, wrapColumn, value := trackingSql.FilterComparison(database, condition)
+
+		switch condition.Identifier {
+		case parser.Attribute:
+			column := wrapColumn("model_versions." + condition.Key)
+
+			placeholder := "?"
+			if condition.Operator == parser.In {
+				placeholder = "(?)"
+			}
+
+			transaction = transaction.Where(fmt.Sprintf("%s %s %s", column, comparison, placeholder), value)
+		case parser.Tag:
+			where := fmt.Sprintf("%s %s ?", wrapColumn("value"), comparison)
+
+			// Model versions without the prompt tag are not prompts, see applyRegisteredModelsFilter.
+			if condition.Key == IsPromptTagKey && condition.Operator == parser.NotEquals {
+				transaction = transaction.Where(
+					"NOT EXISTS (?)",
+					database.Select("1").Where(
+						"model_version_tags.name = model_versions.name",
+					).Where(
+						"model_version_tags.version = model_versions.version",
+					).Where(
+						"key = ?", condition.Key,
+					).Where(
+						"value = ?", value,
+					).Model(
+						&models.ModelVersionTag{},
+					),
+				)
+
+				continue
+			}
+
+			table := fmt.Sprintf("filter_%d", index)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf(
+					"JOIN (?) AS %s ON model_versions.name = %s.name AND model_versions.version = %s.version",
+					table, table, table,
+				),
+				database.Select("name", "version").Where("key = ?", condition.Key).Where(where, value).Model(
+					&models.ModelVersionTag{},
+				),
+			)
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid token type: %s", condition.Identifier),
+			)
+		}
+	}
+
+	return transaction, nil
+}
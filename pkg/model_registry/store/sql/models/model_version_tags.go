@@ -18,3 +18,12 @@ func (mvt ModelVersionTag) ToEntity() *entities.ModelVersionTag {
 		Value: mvt.Value,
 	}
 }
+
+func ModelVersionTagFromEntity(name string, version int32, tag *entities.ModelVersionTag) ModelVersionTag {
+	return ModelVersionTag{
+		Key:     tag.Key,
+		Value:   tag.Value,
+		Name:    name,
+		Version: version,
+	}
+}
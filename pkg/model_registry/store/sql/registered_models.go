@@ -57,6 +57,60 @@ func (m *ModelRegistrySQLStore) GetRegisteredModel(
 	return registeredModel.ToEntity(), nil
 }
 
+func (m *ModelRegistrySQLStore) SearchRegisteredModels(
+	ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+) ([]*entities.RegisteredModel, string, *contract.Error) {
+	query, limit := applyLimitFilter(m.db.WithContext(ctx), maxResults)
+
+	query, offset, err := applyOffsetFilter(query, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query, err = applyRegisteredModelsFilter(m.db, query, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query, err = applyRegisteredModelsOrderBy(query, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := query.Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions",
+	).Find(
+		&registeredModels,
+	).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search registered models",
+			err,
+		)
+	}
+
+	nextPageToken, err := createNextPageToken(len(registeredModels), limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(registeredModels) > limit {
+		registeredModels = registeredModels[:limit]
+	}
+
+	data := make([]*entities.RegisteredModel, len(registeredModels))
+	for i, registeredModel := range registeredModels {
+		data[i] = registeredModel.ToEntity()
+	}
+
+	return data, nextPageToken, nil
+}
+
 func (m *ModelRegistrySQLStore) UpdateRegisteredModel(
 	ctx context.Context, name, description string,
 ) (*entities.RegisteredModel, *contract.Error) {
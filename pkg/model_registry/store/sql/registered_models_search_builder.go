@@ -0,0 +1,142 @@
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/model_registry/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	trackingSql "github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var registeredModelOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+func applyLimitFilter(query *gorm.DB, maxResults int64) (*gorm.DB, int) {
+	return query.Limit(int(maxResults) + 1), int(maxResults)
+}
+
+func applyOffsetFilter(query *gorm.DB, pageToken string) (*gorm.DB, int, *contract.Error) {
+	offset, err := utils.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return query.Offset(offset), offset, nil
+}
+
+// Registered models are ordered by name or by their last update, the name is always used as tiebreaker.
+func applyRegisteredModelsOrderBy(query *gorm.DB, orderBy []string) (*gorm.DB, *contract.Error) {
+	observedColumns := map[string]struct{}{}
+
+	for _, o := range orderBy {
+		parts := registeredModelOrder.FindStringSubmatch(strings.TrimSpace(o))
+		if len(parts) == 0 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", o),
+			)
+		}
+
+		var column string
+
+		switch parts[1] {
+		case "name":
+			column = "name"
+		case "timestamp", "last_updated_timestamp":
+			column = "last_updated_time"
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid order by key '%s' specified.", parts[1]),
+			)
+		}
+
+		if _, ok := observedColumns[column]; ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		observedColumns[column] = struct{}{}
+
+		query = query.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "registered_models", Name: column},
+			Desc:   strings.ToUpper(parts[2]) == "DESC",
+		})
+	}
+
+	if _, ok := observedColumns["name"]; !ok {
+		query = query.Order("registered_models.name ASC")
+	}
+
+	return query, nil
+}
+
+func applyRegisteredModelsFilter(database, transaction *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
+	filterConditions, err := query.ParseRegisteredModelFilter(filter)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", err),
+		)
+	}
+
+	for index, condition := range filterConditions {
+		comparison, wrapColumn, value := trackingSql.FilterComparison(database, condition)
+
+		switch condition.Identifier {
+		case parser.Attribute:
+			column := wrapColumn("registered_models." + condition.Key)
+
+			transaction = transaction.Where(fmt.Sprintf("%s %s ?", column, comparison), value)
+		case parser.Tag:
+			where := fmt.Sprintf("%s %s ?", wrapColumn("value"), comparison)
+
+			// Models without the prompt tag are not prompts. The Python client excludes prompts by
+			// appending "tags.`mlflow.prompt.is_prompt` != 'true'", which must still match those models.
+			if condition.Key == IsPromptTagKey && condition.Operator == parser.NotEquals {
+				transaction = transaction.Where(
+					"registered_models.name NOT IN (?)",
+					database.Select("name").Where("key = ?", condition.Key).Where("value = ?", value).Model(
+						&models.RegisteredModelTag{},
+					),
+				)
+
+				continue
+			}
+
+			table := fmt.Sprintf("filter_%d", index)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON registered_models.name = %s.name", table, table),
+				database.Select("name").Where("key = ?", condition.Key).Where(where, value).Model(
+					&models.RegisteredModelTag{},
+				),
+			)
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid token type: %s", condition.Identifier),
+			)
+		}
+	}
+
+	return transaction, nil
+}
+
+func createNextPageToken(resultLength, limit, offset int) (string, *contract.Error) {
+	if resultLength > limit {
+		return utils.EncodePageToken(offset + limit)
+	}
+
+	return "", nil
+}
@@ -27,6 +27,11 @@ func NewModelRegistrySQLStore(ctx context.Context, config *config.Config) (*Mode
 	}, nil
 }
 
+// DB returns the database of the store, for the stats of its connection pool.
+func (m *ModelRegistrySQLStore) DB() *gorm.DB {
+	return m.db
+}
+
 func (m *ModelRegistrySQLStore) Destroy() error {
 	if err := sql.CloseDatabase(m.db); err != nil {
 		return fmt.Errorf("failed to close database: %w", err)
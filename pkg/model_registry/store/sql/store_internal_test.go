@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func TestSearchRegisteredModelsPages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewModelRegistrySQLStore(ctx, &config.Config{ModelRegistryStoreURI: "sqlite:///:memory:"})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	expected := make([]string, 0, 12)
+
+	for i := range 12 {
+		expected = append(expected, fmt.Sprintf("paged-%02d", i))
+
+		_, contractErr := store.CreateRegisteredModel(ctx, expected[i], "", nil)
+		require.Nil(t, contractErr)
+	}
+
+	// The token of the second page, {"offset":10}, is not a multiple of three bytes and ends with padding.
+	names := make([]string, 0, 12)
+	pageToken := ""
+
+	for range 3 {
+		registeredModels, nextPageToken, contractErr := store.SearchRegisteredModels(
+			ctx, "name LIKE 'paged-%'", 10, []string{"name"}, pageToken,
+		)
+		require.Nil(t, contractErr)
+
+		for _, registeredModel := range registeredModels {
+			names = append(names, registeredModel.Name)
+		}
+
+		pageToken = nextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	assert.Equal(t, expected, names)
+	assert.Empty(t, pageToken)
+}
+
+func TestSearchModelVersionsAliases(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewModelRegistrySQLStore(ctx, &config.Config{ModelRegistryStoreURI: "sqlite:///:memory:"})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	for _, name := range []string{"aliased-a", "aliased-b"} {
+		_, contractErr := store.CreateRegisteredModel(ctx, name, "", nil)
+		require.Nil(t, contractErr)
+
+		for range 2 {
+			_, contractErr = store.CreateModelVersion(ctx, name, "source", "", nil, "", "", "")
+			require.Nil(t, contractErr)
+		}
+	}
+
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, "aliased-a", "champion", "2"))
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, "aliased-a", "latest", "2"))
+	require.Nil(t, store.SetRegisteredModelAlias(ctx, "aliased-b", "champion", "1"))
+
+	// Every version of a model shares its name, each alias is only attached to its own version.
+	modelVersions, _, contractErr := store.SearchModelVersions(
+		ctx, "name LIKE 'aliased-%'", 10, []string{"name", "version_number"}, "",
+	)
+	require.Nil(t, contractErr)
+
+	aliases := map[string][]*entities.RegisteredModelAlias{}
+	for _, modelVersion := range modelVersions {
+		aliases[fmt.Sprintf("%s/%d", modelVersion.Name, modelVersion.Version)] = modelVersion.Aliases
+	}
+
+	assert.Len(t, aliases, 4)
+	assert.Empty(t, aliases["aliased-a/1"])
+	assert.Len(t, aliases["aliased-a/2"], 2)
+	assert.Len(t, aliases["aliased-b/1"], 1)
+	assert.Empty(t, aliases["aliased-b/2"])
+}
+
+func TestSearchRegisteredModelsFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewModelRegistrySQLStore(ctx, &config.Config{ModelRegistryStoreURI: "sqlite:///:memory:"})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	for name, stage := range map[string]string{
+		"filtered a AND b": "prod",
+		"filtered a":       "dev",
+		"filtered b":       "prod",
+	} {
+		_, contractErr := store.CreateRegisteredModel(
+			ctx, name, "", []*entities.RegisteredModelTag{{Key: "stage", Value: stage}},
+		)
+		require.Nil(t, contractErr)
+	}
+
+	for filter, expected := range map[string][]string{
+		// The AND of a quoted value does not separate two conditions.
+		"name = 'filtered a AND b'":                                 {"filtered a AND b"},
+		"name ILIKE 'FILTERED a%' AND tags.stage = 'prod'":          {"filtered a AND b"},
+		"name LIKE 'filtered %' AND tags.`stage` != 'prod'":         {"filtered a"},
+		"attributes.name LIKE 'filtered %' AND tags.stage = 'prod'": {"filtered a AND b", "filtered b"},
+	} {
+		registeredModels, _, contractErr := store.SearchRegisteredModels(ctx, filter, 10, []string{"name"}, "")
+		require.Nil(t, contractErr, filter)
+
+		names := make([]string, 0, len(registeredModels))
+		for _, registeredModel := range registeredModels {
+			names = append(names, registeredModel.Name)
+		}
+
+		assert.Equal(t, expected, names, filter)
+	}
+
+	_, _, contractErr := store.SearchRegisteredModels(ctx, "name = 'filtered' AND", 10, nil, "")
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(contractErr.Code))
+}
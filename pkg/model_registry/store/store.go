@@ -15,8 +15,17 @@ type ModelRegistryStore interface {
 }
 
 type ModelVersionStore interface {
+	CreateModelVersion(
+		ctx context.Context,
+		name, source, runID string,
+		tags []*entities.ModelVersionTag,
+		runLink, description, storageLocation string,
+	) (*entities.ModelVersion, *contract.Error)
 	GetLatestVersions(ctx context.Context, name string, stages []string) ([]*entities.ModelVersion, *contract.Error)
 	GetModelVersion(ctx context.Context, name, version string, eager bool) (*entities.ModelVersion, *contract.Error)
+	SearchModelVersions(
+		ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+	) ([]*entities.ModelVersion, string, *contract.Error)
 	DeleteModelVersion(ctx context.Context, name, version string) *contract.Error
 	UpdateModelVersion(ctx context.Context, name, version, description string) (*entities.ModelVersion, *contract.Error)
 	TransitionModelVersionStage(
@@ -33,6 +42,9 @@ type RegisteredModelStore interface {
 		ctx context.Context, name, description string, tags []*entities.RegisteredModelTag,
 	) (*entities.RegisteredModel, *contract.Error)
 	GetRegisteredModel(ctx context.Context, name string) (*entities.RegisteredModel, *contract.Error)
+	SearchRegisteredModels(
+		ctx context.Context, filter string, maxResults int64, orderBy []string, pageToken string,
+	) ([]*entities.RegisteredModel, string, *contract.Error)
 	UpdateRegisteredModel(ctx context.Context, name, description string) (*entities.RegisteredModel, *contract.Error)
 	RenameRegisteredModel(ctx context.Context, name, newName string) (*entities.RegisteredModel, *contract.Error)
 	DeleteRegisteredModel(ctx context.Context, name string) *contract.Error
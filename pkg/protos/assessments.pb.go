@@ -356,7 +356,7 @@ type Assessment struct {
 	// Name of the assessment. The name must not contain ".".
 	AssessmentName *string `protobuf:"bytes,2,opt,name=assessment_name,json=assessmentName" json:"assessment_name,omitempty" query:"assessment_name" params:"assessment_name"`
 	// ID of the trace this assessment is associated with.
-	TraceId *string `protobuf:"bytes,3,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id"`
+	TraceId *string `protobuf:"bytes,3,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id" validate:"required"`
 	// ID of the span if the assessment is for a particular span (optional).
 	SpanId *string `protobuf:"bytes,4,opt,name=span_id,json=spanId" json:"span_id,omitempty" query:"span_id" params:"span_id"`
 	// The source this assessment came from.
@@ -957,7 +957,7 @@ type SearchRegisteredModels struct {
 	// Single boolean condition, with string values wrapped in single quotes.
 	Filter *string `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of models desired. Default is 100. Max threshold is 1000.
-	MaxResults *int64 `protobuf:"varint,2,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int64 `protobuf:"varint,2,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,positiveNonZeroInteger,max=1000"`
 	// List of columns for ordering search results, which can include model name and last updated
 	// timestamp with an optional "DESC" or "ASC" annotation, where "ASC" is the default.
 	// Tiebreaks are done by model name ASC.
@@ -1090,14 +1090,14 @@ type CreateModelVersion struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Register model under this name
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" query:"name" params:"name" validate:"notEmpty,required"`
 	// URI indicating the location of the model artifacts.
-	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source"`
+	Source *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty" query:"source" params:"source" validate:"notEmpty,required"`
 	// MLflow run ID for correlation, if “source“ was generated by an experiment run in
 	// MLflow tracking server
 	RunId *string `protobuf:"bytes,3,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
 	// Additional metadata for model version.
-	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
+	Tags []*ModelVersionTag `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags" validate:"omitempty,dive"`
 	// MLflow run link - this is the exact link of the run that generated this model version,
 	// potentially hosted at another instance of MLflow.
 	RunLink *string `protobuf:"bytes,5,opt,name=run_link,json=runLink" json:"run_link,omitempty" query:"run_link" params:"run_link"`
@@ -1446,7 +1446,7 @@ type SearchModelVersions struct {
 	Filter *string `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of models desired. Max threshold is 200K. Backends may choose a lower default
 	// value and maximum threshold.
-	MaxResults *int64 `protobuf:"varint,2,opt,name=max_results,json=maxResults,def=200000" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int64 `protobuf:"varint,2,opt,name=max_results,json=maxResults,def=200000" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,positiveNonZeroInteger,max=200000"`
 	// List of columns to be ordered by including model name, version, stage with an
 	// optional "DESC" or "ASC" annotation, where "ASC" is the default.
 	// Tiebreaks are done by latest stage transition timestamp, followed by name ASC, followed by
@@ -1581,9 +1581,9 @@ type ModelVersionTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The tag key.
-	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key"`
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" query:"key" params:"key" validate:"required,max=250,validMetricParamOrTagName,pathIsUnique"`
 	// The tag value.
-	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value"`
+	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty" query:"value" params:"value" validate:"omitempty,max=5000"`
 }
 
 func (x *ModelVersionTag) Reset() {
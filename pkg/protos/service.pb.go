@@ -1519,7 +1519,7 @@ type ModelOutput struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The unique identifier of the model.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 	// Step at which the model was produced.
 	Step *int64 `protobuf:"varint,2,opt,name=step" json:"step,omitempty" query:"step" params:"step"`
 }
@@ -3073,9 +3073,9 @@ type GetMetricHistoryBulkInterval struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID(s) of the run(s) from which to fetch metric values. Must be provided.
-	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds" json:"run_ids,omitempty" query:"run_ids" params:"run_ids"`
+	RunIds []string `protobuf:"bytes,1,rep,name=run_ids,json=runIds" json:"run_ids,omitempty" query:"run_ids" params:"run_ids" validate:"required,min=1,max=100"`
 	// Name of the metric.
-	MetricKey *string `protobuf:"bytes,2,opt,name=metric_key,json=metricKey" json:"metric_key,omitempty" query:"metric_key" params:"metric_key"`
+	MetricKey *string `protobuf:"bytes,2,opt,name=metric_key,json=metricKey" json:"metric_key,omitempty" query:"metric_key" params:"metric_key" validate:"required"`
 	// Optional start step to only fetch metrics after the specified step. Must be defined if
 	// end_step is defined.
 	StartStep *int32 `protobuf:"varint,3,opt,name=start_step,json=startStep" json:"start_step,omitempty" query:"start_step" params:"start_step"`
@@ -3085,7 +3085,7 @@ type GetMetricHistoryBulkInterval struct {
 	// Maximum number of results to fetch per run specified. Must be set to a positive number.
 	// Note, in reality, the API returns at most (max_results + # of run IDs) x (# run IDs) metric
 	// data points.
-	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,positiveNonZeroInteger,max=2500"`
 }
 
 func (x *GetMetricHistoryBulkInterval) Reset() {
@@ -3355,9 +3355,9 @@ type LogOutputs struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID of the Run from which to log outputs.
-	RunId *string `protobuf:"bytes,1,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id"`
+	RunId *string `protobuf:"bytes,1,opt,name=run_id,json=runId" json:"run_id,omitempty" query:"run_id" params:"run_id" validate:"required,runId"`
 	// Model outputs from the Run.
-	Models []*ModelOutput `protobuf:"bytes,2,rep,name=models" json:"models,omitempty" query:"models" params:"models"`
+	Models []*ModelOutput `protobuf:"bytes,2,rep,name=models" json:"models,omitempty" query:"models" params:"models" validate:"required,dive"`
 }
 
 func (x *LogOutputs) Reset() {
@@ -3456,7 +3456,7 @@ type CreateAssessment struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The assessment to create.
-	Assessment *Assessment `protobuf:"bytes,1,opt,name=assessment" json:"assessment,omitempty" query:"assessment" params:"assessment"`
+	Assessment *Assessment `protobuf:"bytes,1,opt,name=assessment" json:"assessment,omitempty" query:"assessment" params:"assessment" validate:"required"`
 }
 
 func (x *CreateAssessment) Reset() {
@@ -3503,9 +3503,9 @@ type UpdateAssessment struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The Assessment containing the fields which should be updated.
-	Assessment *Assessment `protobuf:"bytes,1,opt,name=assessment" json:"assessment,omitempty" query:"assessment" params:"assessment"`
+	Assessment *Assessment `protobuf:"bytes,1,opt,name=assessment" json:"assessment,omitempty" query:"assessment" params:"assessment" validate:"required"`
 	// The list of the assessment fields to update. These should correspond to the values (or lack thereof) present in `assessment`.
-	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty" query:"update_mask" params:"update_mask"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty" query:"update_mask" params:"update_mask" validate:"required"`
 }
 
 func (x *UpdateAssessment) Reset() {
@@ -3560,9 +3560,9 @@ type DeleteAssessment struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the trace.
-	TraceId *string `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id"`
+	TraceId *string `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id" validate:"required"`
 	// The ID of the assessment.
-	AssessmentId *string `protobuf:"bytes,2,opt,name=assessment_id,json=assessmentId" json:"assessment_id,omitempty" query:"assessment_id" params:"assessment_id"`
+	AssessmentId *string `protobuf:"bytes,2,opt,name=assessment_id,json=assessmentId" json:"assessment_id,omitempty" query:"assessment_id" params:"assessment_id" validate:"required"`
 }
 
 func (x *DeleteAssessment) Reset() {
@@ -4027,7 +4027,7 @@ type GetTraceInfoV3 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID of the trace to fetch. Must be provided.
-	TraceId *string `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id"`
+	TraceId *string `protobuf:"bytes,1,opt,name=trace_id,json=traceId" json:"trace_id,omitempty" query:"trace_id" params:"trace_id" validate:"required"`
 }
 
 func (x *GetTraceInfoV3) Reset() {
@@ -4079,7 +4079,7 @@ type SearchTraces struct {
 	// Example: “trace.status = 'OK' and trace.timestamp_ms > 1711089570679“.
 	Filter *string `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty" query:"filter" params:"filter"`
 	// Maximum number of traces desired. Max threshold is 500.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=100" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,positiveNonZeroInteger,max=50000"`
 	// List of columns for ordering the results, e.g. “["timestamp_ms DESC"]“.
 	OrderBy []string `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of traces to fetch.
@@ -4960,7 +4960,7 @@ type SearchDatasets struct {
 	unknownFields protoimpl.UnknownFields
 
 	// List of experiment IDs to search over.
-	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids"`
+	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids" validate:"required,min=1"`
 }
 
 func (x *SearchDatasets) Reset() {
@@ -5006,7 +5006,7 @@ type CreateLoggedModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// ID of the associated experiment.
-	ExperimentId *string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" query:"experiment_id" params:"experiment_id"`
+	ExperimentId *string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" query:"experiment_id" params:"experiment_id" validate:"required"`
 	// Name of the model. Optional. If not specified, the backend will generate one.
 	Name *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty" query:"name" params:"name"`
 	// The type of model, such as "Agent", "Classifier", "LLM".
@@ -5097,12 +5097,12 @@ type FinalizeLoggedModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the LoggedModel to finalize
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 	// Whether or not the model is ready for use.
 	// Valid values in this message: ENUM<LOGGED_MODEL_READY, LOGGED_MODEL_UPLOAD_FAILED>
 	// ("LOGGED_MODEL_UPLOAD_FAILED" indicates that something went wrong when logging
 	// the model weights / agent code)
-	Status *LoggedModelStatus `protobuf:"varint,2,opt,name=status,enum=mlflow.LoggedModelStatus" json:"status,omitempty" query:"status" params:"status"`
+	Status *LoggedModelStatus `protobuf:"varint,2,opt,name=status,enum=mlflow.LoggedModelStatus" json:"status,omitempty" query:"status" params:"status" validate:"required"`
 }
 
 func (x *FinalizeLoggedModel) Reset() {
@@ -5155,7 +5155,7 @@ type GetLoggedModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the LoggedModel to retrieve.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 }
 
 func (x *GetLoggedModel) Reset() {
@@ -5201,7 +5201,7 @@ type DeleteLoggedModel struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the LoggedModel to delete.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 }
 
 func (x *DeleteLoggedModel) Reset() {
@@ -5247,7 +5247,7 @@ type SearchLoggedModels struct {
 	unknownFields protoimpl.UnknownFields
 
 	// IDs of the Experiments in which to search for Logged Models.
-	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids"`
+	ExperimentIds []string `protobuf:"bytes,1,rep,name=experiment_ids,json=experimentIds" json:"experiment_ids,omitempty" query:"experiment_ids" params:"experiment_ids" validate:"required,min=1"`
 	// A filter expression over Logged Model info and data that allows returning a subset of
 	// Logged Models. The syntax is a subset of SQL that supports ANDing together binary operations
 	// Example: “params.alpha < 0.3 AND metrics.accuracy > 0.9“.
@@ -5259,7 +5259,7 @@ type SearchLoggedModels struct {
 	// If no datasets are specified, then metrics across all datasets are considered in the filter.
 	Datasets []*SearchLoggedModels_Dataset `protobuf:"bytes,6,rep,name=datasets" json:"datasets,omitempty" query:"datasets" params:"datasets"`
 	// Maximum number of Logged Models to return. Max threshold is 50.
-	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=50" json:"max_results,omitempty" query:"max_results" params:"max_results"`
+	MaxResults *int32 `protobuf:"varint,3,opt,name=max_results,json=maxResults,def=50" json:"max_results,omitempty" query:"max_results" params:"max_results" validate:"omitempty,positiveNonZeroInteger,max=50000"`
 	// List of columns for ordering the results, with additional fields for sorting criteria.
 	OrderBy []*SearchLoggedModels_OrderBy `protobuf:"bytes,4,rep,name=order_by,json=orderBy" json:"order_by,omitempty" query:"order_by" params:"order_by"`
 	// Token indicating the page of Logged Models to fetch.
@@ -5349,7 +5349,7 @@ type SetLoggedModelTags struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the LoggedModel to set the tag on.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 	// The tag key.
 	Tags []*LoggedModelTag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" query:"tags" params:"tags"`
 }
@@ -5404,9 +5404,9 @@ type DeleteLoggedModelTag struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the LoggedModel to delete the tag from.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 	// The tag key.
-	TagKey *string `protobuf:"bytes,2,opt,name=tag_key,json=tagKey" json:"tag_key,omitempty" query:"tag_key" params:"tag_key"`
+	TagKey *string `protobuf:"bytes,2,opt,name=tag_key,json=tagKey" json:"tag_key,omitempty" query:"tag_key" params:"tag_key" validate:"required"`
 }
 
 func (x *DeleteLoggedModelTag) Reset() {
@@ -5523,7 +5523,7 @@ type LogLoggedModelParamsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The ID of the logged model to log params for.
-	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id"`
+	ModelId *string `protobuf:"bytes,1,opt,name=model_id,json=modelId" json:"model_id,omitempty" query:"model_id" params:"model_id" validate:"required"`
 	// Parameters attached to the model.
 	Params []*LoggedModelParameter `protobuf:"bytes,2,rep,name=params" json:"params,omitempty" query:"params" params:"params"`
 }
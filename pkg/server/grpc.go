@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/auth"
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/rpc"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// newGRPCServer creates the gRPC server of the services, served over TLS when the reloader is not nil.
+func newGRPCServer(ctx context.Context, services *services, reloader *certificateReloader) *grpc.Server {
+	interceptors := make([]grpc.UnaryServerInterceptor, 0, 1)
+	if services.auth != nil {
+		interceptors = append(interceptors, auth.NewUnaryServerInterceptor(services.auth, rpc.HTTPEndpoint))
+	}
+
+	var options []grpc.ServerOption
+	if reloader != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(reloader.tlsConfig("h2"))))
+	}
+
+	server := rpc.NewServer(ctx, interceptors, options...)
+	rpc.RegisterTrackingServiceServer(server, services.tracking)
+	rpc.RegisterModelRegistryServiceServer(server, services.modelRegistry)
+
+	return server
+}
+
+// launchGRPCServer serves the gRPC API in the background until the context is done,
+// the address is listened on beforehand to report its errors.
+func launchGRPCServer(
+	ctx context.Context, cfg *config.Config, services *services, reloader *certificateReloader,
+) error {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	listenConfig := &net.ListenConfig{}
+
+	listener, err := listenConfig.Listen(ctx, "tcp", cfg.GRPCAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.GRPCAddress, err)
+	}
+
+	server := newGRPCServer(ctx, services, reloader)
+
+	go func() {
+		<-ctx.Done()
+
+		logger.Info("Shutting down MLflow Go gRPC server")
+
+		server.GracefulStop()
+	}()
+
+	go func() {
+		logger.Infof("Launching MLflow Go gRPC server on %s", cfg.GRPCAddress)
+
+		if err := server.Serve(listener); err != nil {
+			logger.Errorf("Failed to serve MLflow Go gRPC server: %v", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/proxy"
+	"github.com/valyala/fasthttp"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// The API app is mounted on several prefixes, the paths of the route configs are relative to them.
+var apiPrefixes = []string{"/api/2.0", "/ajax-api/2.0"}
+
+func matchPath(pattern, path string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(path, prefix)
+	}
+
+	return path == pattern
+}
+
+func matchRoute(route config.RouteConfig, method, path string) bool {
+	if route.Method != "" && !strings.EqualFold(route.Method, method) {
+		return false
+	}
+
+	if matchPath(route.Path, path) {
+		return true
+	}
+
+	for _, prefix := range apiPrefixes {
+		if apiPath, ok := strings.CutPrefix(path, prefix); ok && matchPath(route.Path, apiPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The artifact uploads are streamed to the artifact repository,
+// only the body limit of a route config applies to them.
+var streamedRoutes = []config.RouteConfig{
+	{Method: fiber.MethodPut, Path: "/mlflow-artifacts/artifacts/*"},
+	{Method: fiber.MethodPut, Path: "/mlflow-artifacts/mpu/parts/*"},
+}
+
+// bodyLimit returns the body limit of the first route config matching a request, when it sets one,
+// and the one of the server otherwise.
+func bodyLimit(cfg config.HTTPConfig, method, path string) int {
+	for _, route := range cfg.Routes {
+		if matchRoute(route, method, path) {
+			if route.BodyLimit > 0 {
+				return int(route.BodyLimit)
+			}
+
+			break
+		}
+	}
+
+	for _, route := range streamedRoutes {
+		if matchRoute(route, method, path) {
+			return 0
+		}
+	}
+
+	return int(cfg.BodyLimit)
+}
+
+// rejectBody answers with a 413 and closes the connection, the rest of the body is left unread.
+func rejectBody(c *fiber.Ctx, limit int) error {
+	c.Context().SetConnectionClose()
+
+	return c.Status(fiber.StatusRequestEntityTooLarge).JSON(contract.NewError(
+		protos.ErrorCode_REQUEST_LIMIT_EXCEEDED, fmt.Sprintf("request body is larger than %d bytes", limit),
+	))
+}
+
+// limitBody rejects the requests with a body over the body limit. The server streams the bodies over it
+// for the artifact uploads, so the ones of the other requests are read here until the limit.
+func limitBody(cfg config.HTTPConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limit := bodyLimit(cfg, c.Method(), c.Path())
+		if limit <= 0 {
+			return c.Next()
+		}
+
+		if c.Request().Header.ContentLength() > limit {
+			return rejectBody(c, limit)
+		}
+
+		if stream := c.Context().RequestBodyStream(); stream != nil {
+			body, err := io.ReadAll(io.LimitReader(stream, int64(limit)+1))
+			if err != nil {
+				return fiber.NewError(fiber.StatusBadRequest, "failed to read request body: "+err.Error())
+			}
+
+			if len(body) > limit {
+				return rejectBody(c, limit)
+			}
+
+			c.Request().SetBody(body)
+		}
+
+		return c.Next()
+	}
+}
+
+// newRequestConfig applies the limits of the first route config matching a request once its headers are read,
+// before its body. The limits of the server apply to the other requests.
+// The stores answer NOT_IMPLEMENTED for the features they lack, like the traces and logged models
+// of the file store, those requests are served by the Python server instead.
+func forwardNotImplemented(pythonAddress string) fiber.Handler {
+	forward := proxy.BalancerForward([]string{pythonAddress})
+
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		var contractError *contract.Error
+		if !errors.As(err, &contractError) ||
+			protos.ErrorCode(contractError.Code) != protos.ErrorCode_NOT_IMPLEMENTED {
+			return err
+		}
+
+		c.Locals(originLocalsKey, originPython)
+
+		return forward(c)
+	}
+}
+
+func newRequestConfig(routes []config.RouteConfig) func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+	return func(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+		uri := header.RequestURI()
+		if index := bytes.IndexByte(uri, '?'); index >= 0 {
+			uri = uri[:index]
+		}
+
+		method, path := string(header.Method()), string(uri)
+
+		for _, route := range routes {
+			if matchRoute(route, method, path) {
+				return fasthttp.RequestConfig{
+					ReadTimeout:        route.ReadTimeout.Duration,
+					WriteTimeout:       route.WriteTimeout.Duration,
+					MaxRequestBodySize: int(route.BodyLimit),
+				}
+			}
+		}
+
+		return fasthttp.RequestConfig{}
+	}
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+func TestRequestConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.NewConfigFromString(`{"http": {"routes": [
+		{"method": "POST", "path": "/mlflow/runs/log-batch", "body_limit": "64MiB", "read_timeout": "1m"},
+		{"path": "/mlflow-artifacts/*", "write_timeout": "1h"}
+	]}}`)
+	require.NoError(t, err)
+
+	requestConfig := newRequestConfig(cfg.HTTP.Routes)
+
+	for _, testCase := range []struct {
+		method, uri string
+		expected    fasthttp.RequestConfig
+	}{
+		{
+			"POST", "/api/2.0/mlflow/runs/log-batch",
+			fasthttp.RequestConfig{ReadTimeout: time.Minute, MaxRequestBodySize: 64 << 20},
+		},
+		{
+			"POST", "/ajax-api/2.0/mlflow/runs/log-batch?run_id=1",
+			fasthttp.RequestConfig{ReadTimeout: time.Minute, MaxRequestBodySize: 64 << 20},
+		},
+		{"GET", "/api/2.0/mlflow/runs/log-batch", fasthttp.RequestConfig{}},
+		{"GET", "/api/2.0/mlflow-artifacts/artifacts/0/model.pkl", fasthttp.RequestConfig{WriteTimeout: time.Hour}},
+		{"GET", "/mlflow-artifacts/artifacts", fasthttp.RequestConfig{WriteTimeout: time.Hour}},
+		{"POST", "/api/2.0/mlflow/runs/log-metric", fasthttp.RequestConfig{}},
+	} {
+		var header fasthttp.RequestHeader
+
+		header.SetMethod(testCase.method)
+		header.SetRequestURI(testCase.uri)
+
+		assert.Equal(t, testCase.expected, requestConfig(&header), "%s %s", testCase.method, testCase.uri)
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.NewConfigFromString(`{"http": {"body_limit": "1KiB", "routes": [
+		{"method": "POST", "path": "/mlflow/runs/log-batch", "body_limit": "64KiB"},
+		{"method": "PUT", "path": "/mlflow-artifacts/artifacts/0/limited/*", "body_limit": "4KiB"}
+	]}}`)
+	require.NoError(t, err)
+
+	cfg.TrackingStoreURI = "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db"))
+	cfg.ModelRegistryStoreURI = cfg.TrackingStoreURI
+	cfg.ArtifactsDestination = t.TempDir()
+	cfg.MigrateDatabase = true
+
+	ctx := context.Background()
+
+	services, err := newServices(ctx, cfg)
+	require.NoError(t, err)
+
+	app, err := configureApp(ctx, cfg, services)
+	require.NoError(t, err)
+
+	createExperiment := func(name string) string {
+		return `{"name": "` + name + `"}`
+	}
+	large := strings.Repeat("a", 10<<10)
+
+	for _, testCase := range []struct {
+		name, method, target string
+		body                 io.Reader
+		expected             int
+	}{
+		{
+			"Small", http.MethodPost, "/api/2.0/mlflow/experiments/create",
+			strings.NewReader(createExperiment("small")), http.StatusOK,
+		},
+		{
+			"Large", http.MethodPost, "/api/2.0/mlflow/experiments/create",
+			strings.NewReader(createExperiment(large)), http.StatusRequestEntityTooLarge,
+		},
+		{
+			// A reader of unknown length is sent in chunks, without a Content-Length.
+			"SmallChunked", http.MethodPost, "/api/2.0/mlflow/experiments/create",
+			io.MultiReader(strings.NewReader(createExperiment("chunked"))), http.StatusOK,
+		},
+		{
+			"LargeChunked", http.MethodPost, "/api/2.0/mlflow/experiments/create",
+			io.MultiReader(strings.NewReader(createExperiment(large))), http.StatusRequestEntityTooLarge,
+		},
+		{
+			"ArtifactUpload", http.MethodPut, "/api/2.0/mlflow-artifacts/artifacts/0/model.pkl",
+			strings.NewReader(large), http.StatusOK,
+		},
+		{
+			// The run ID is invalid, once the body is read past the limit of the server.
+			"LargerRouteLimit", http.MethodPost, "/api/2.0/mlflow/runs/log-batch",
+			strings.NewReader(`{"run_id": "` + large + `"}`), http.StatusBadRequest,
+		},
+		{
+			"LargerRouteLimitExceeded", http.MethodPost, "/api/2.0/mlflow/runs/log-batch",
+			strings.NewReader(`{"run_id": "` + strings.Repeat(large, 7) + `"}`), http.StatusRequestEntityTooLarge,
+		},
+		{
+			"SmallerRouteLimit", http.MethodPut, "/api/2.0/mlflow-artifacts/artifacts/0/limited/model.pkl",
+			strings.NewReader(large), http.StatusRequestEntityTooLarge,
+		},
+		{
+			"SmallerRouteLimitChunked", http.MethodPut, "/ajax-api/2.0/mlflow-artifacts/artifacts/0/limited/model.pkl",
+			io.MultiReader(strings.NewReader(large)), http.StatusRequestEntityTooLarge,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := httptest.NewRequest(testCase.method, testCase.target, testCase.body)
+			request.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+			if request.ContentLength < 0 {
+				request.TransferEncoding = []string{"chunked"}
+			}
+
+			resp, err := app.Test(request, -1)
+			require.NoError(t, err)
+
+			defer resp.Body.Close()
+
+			assert.Equal(t, testCase.expected, resp.StatusCode)
+		})
+	}
+
+	content, err := os.ReadFile(filepath.Join(cfg.ArtifactsDestination, "0", "model.pkl"))
+	require.NoError(t, err)
+	assert.Len(t, content, len(large))
+}
+
+func TestForwardNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	python := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"served_by": "python", "path": "` + r.URL.Path + `"}`))
+	}))
+	t.Cleanup(python.Close)
+
+	cfg, err := config.NewConfigFromString(`{}`)
+	require.NoError(t, err)
+
+	cfg.TrackingStoreURI = filepath.Join(t.TempDir(), "mlruns")
+	cfg.ModelRegistryStoreURI = cfg.TrackingStoreURI
+	cfg.PythonAddress = strings.TrimPrefix(python.URL, "http://")
+
+	ctx := context.Background()
+
+	services, err := newServices(ctx, cfg)
+	require.NoError(t, err)
+
+	app, err := configureApp(ctx, cfg, services)
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		method, target, body string
+	}{
+		{http.MethodPost, "/api/2.0/mlflow/traces", `{"experiment_id": "0", "timestamp_ms": 1}`},
+		{http.MethodGet, "/api/2.0/mlflow/traces/tr-1/info", ""},
+		{http.MethodPost, "/api/2.0/mlflow/logged-models", `{"experiment_id": "0"}`},
+	} {
+		req := httptest.NewRequest(testCase.method, testCase.target, strings.NewReader(testCase.body))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "%s %s", testCase.method, testCase.target)
+		assert.JSONEq(
+			t, `{"served_by": "python", "path": "`+testCase.target+`"}`, string(body),
+			"%s %s", testCase.method, testCase.target,
+		)
+	}
+
+	// The experiments are still served by the Go file store.
+	resp, err := app.Test(
+		httptest.NewRequest(http.MethodGet, "/api/2.0/mlflow/experiments/get?experiment_id=0", nil), -1,
+	)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "python")
+}
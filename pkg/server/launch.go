@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"sync"
@@ -64,6 +65,10 @@ func launchCommandAndServer(ctx context.Context, cfg *config.Config) error {
 }
 
 func LaunchWithSignalHandler(cfg *config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+
 	logger := utils.NewLoggerFromConfig(cfg)
 
 	logger.Debugf("Loaded config: %#v", cfg)
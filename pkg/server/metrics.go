@@ -0,0 +1,140 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
+)
+
+// The requests are labeled with the server which answered them,
+// the ones which are not implemented in Go are forwarded to the Python server.
+const (
+	originLocalsKey = "mlflow.origin"
+	originGo        = "go"
+	originPython    = "python"
+)
+
+// database is implemented by the stores backed by a database.
+type database interface {
+	DB() *gorm.DB
+}
+
+type metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+func newMetrics(services *services) (*metrics, error) {
+	labels := []string{"method", "route", "origin"}
+
+	metrics := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mlflow_http_requests_total",
+			Help: "Number of HTTP requests by route, origin and status code.",
+		}, append(labels, "status")),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mlflow_http_request_duration_seconds",
+			Help:    "Duration of the HTTP requests by route and origin.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mlflow_http_request_errors_total",
+			Help: "Number of HTTP requests which failed by route, origin and MLflow error code.",
+		}, append(labels, "error_code")),
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.requests,
+		metrics.duration,
+		metrics.errors,
+	)
+
+	databases := map[string]any{
+		"tracking":       services.tracking.Store,
+		"model_registry": services.modelRegistry.Store(),
+	}
+	if services.auth != nil {
+		databases["auth"] = services.auth
+	}
+
+	for name, store := range databases {
+		database, ok := store.(database)
+		if !ok {
+			continue
+		}
+
+		sqlDB, err := database.DB().DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the %s database: %w", name, err)
+		}
+
+		if err := metrics.registry.Register(collectors.NewDBStatsCollector(sqlDB, name)); err != nil {
+			return nil, fmt.Errorf("failed to register the %s database stats: %w", name, err)
+		}
+	}
+
+	return metrics, nil
+}
+
+// errorCode returns the status code of a request and its MLflow error code, the errors of the routes are
+// only sent after the middlewares ran, the ones sent by the Python server are read from the response.
+func errorCode(c *fiber.Ctx, err error) (int, string) {
+	if err != nil {
+		contractError := newContractError(err)
+
+		return contractError.StatusCode(), contractError.Code.String()
+	}
+
+	status := c.Response().StatusCode()
+	if status < fiber.StatusBadRequest {
+		return status, ""
+	}
+
+	return status, gjson.GetBytes(c.Response().Body(), "error_code").String()
+}
+
+func (m *metrics) middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		err := c.Next()
+
+		origin, ok := c.Locals(originLocalsKey).(string)
+		if !ok {
+			origin = originGo
+		}
+
+		// The method is copied as the label values outlive the request,
+		// the forwarded requests all match the route of the proxy.
+		method := utils.CopyString(c.Method())
+		route := c.Route().Path
+		status, code := errorCode(c, err)
+
+		m.requests.WithLabelValues(method, route, origin, strconv.Itoa(status)).Inc()
+		m.duration.WithLabelValues(method, route, origin).Observe(time.Since(start).Seconds())
+
+		if code != "" {
+			m.errors.WithLabelValues(method, route, origin, code).Inc()
+		}
+
+		return err
+	}
+}
+
+func (m *metrics) handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	python := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code": "RESOURCE_DOES_NOT_EXIST", "message": "not found"}`))
+	}))
+	t.Cleanup(python.Close)
+
+	cfg, err := config.NewConfigFromString(`{}`)
+	require.NoError(t, err)
+
+	cfg.TrackingStoreURI = "sqlite:///" + filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db"))
+	cfg.ModelRegistryStoreURI = cfg.TrackingStoreURI
+	cfg.MigrateDatabase = true
+	cfg.ExposePrometheus = true
+	cfg.PythonAddress = strings.TrimPrefix(python.URL, "http://")
+
+	ctx := context.Background()
+
+	services, err := newServices(ctx, cfg)
+	require.NoError(t, err)
+
+	app, err := configureApp(ctx, cfg, services)
+	require.NoError(t, err)
+
+	for _, target := range []string{
+		"/api/2.0/mlflow/experiments/get?experiment_id=0",
+		"/api/2.0/mlflow/experiments/get?experiment_id=42",
+		"/api/2.0/mlflow/gateway-proxy",
+	} {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil), -1)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/metrics", nil), -1)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	metrics := string(body)
+	assert.Contains(
+		t, metrics,
+		`mlflow_http_requests_total{method="GET",origin="go",route="/api/2.0/mlflow/experiments/get",status="200"} 1`,
+	)
+	assert.Contains(
+		t, metrics,
+		`mlflow_http_request_errors_total{error_code="RESOURCE_DOES_NOT_EXIST",method="GET",origin="go",`+
+			`route="/api/2.0/mlflow/experiments/get"} 1`,
+	)
+	assert.Contains(
+		t, metrics,
+		`mlflow_http_request_errors_total{error_code="RESOURCE_DOES_NOT_EXIST",method="GET",origin="python",route="/"} 1`,
+	)
+	assert.Contains(t, metrics, `go_sql_open_connections{db_name="tracking"}`)
+}
@@ -32,6 +32,11 @@ func NewHTTPRequestParser() (*HTTPRequestParser, error) {
 }
 
 func (p *HTTPRequestParser) ParseBody(ctx *fiber.Ctx, input proto.Message) *contract.Error {
+	// requests addressing a resource by its path, like DELETE, can come without a body.
+	if len(ctx.Body()) == 0 {
+		ctx.Request().SetBody([]byte("{}"))
+	}
+
 	if protojsonErr := protojson.Unmarshal(ctx.Body(), input); protojsonErr != nil {
 		// falling back to JSON, because `protojson` doesn't provide any information
 		// about `field` name for which ut fails. MLFlow tests expect to know the exact
@@ -69,6 +74,11 @@ func (p *HTTPRequestParser) ParseQuery(ctx *fiber.Ctx, input interface{}) *contr
 		return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
 	}
 
+	// parameters from the path, like `/mlflow/logged-models/:model_id`, take precedence over the query.
+	if err := ctx.ParamsParser(input); err != nil {
+		return contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+	}
+
 	if err := p.validator.Struct(input); err != nil {
 		return validation.NewErrorFromValidationError(err)
 	}
@@ -6,7 +6,22 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracing"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
 )
 
 func RegisterArtifactsServiceRoutes(service service.ArtifactsService, parser *parser.HTTPRequestParser, app *fiber.App) {
+	app.Get("/mlflow-artifacts/artifacts", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "listArtifacts").End()
+		input := &artifacts.ListArtifacts{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ArtifactsService.ListArtifacts", service.ListArtifacts, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
 }
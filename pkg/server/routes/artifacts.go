@@ -0,0 +1,243 @@
+package routes
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos/artifacts"
+	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const artifactRoute = "/mlflow-artifacts/artifacts/+"
+
+func getArtifactPath(ctx *fiber.Ctx) (string, *contract.Error) {
+	artifactPath, err := url.PathUnescape(ctx.Params("+"))
+	if err != nil {
+		return "", contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "Invalid path")
+	}
+
+	return artifactPath, nil
+}
+
+// Large bodies are only streamed when the server is configured with StreamRequestBody.
+func getBodyReader(ctx *fiber.Ctx) io.Reader {
+	if content := ctx.Context().RequestBodyStream(); content != nil {
+		return content
+	}
+
+	return bytes.NewReader(ctx.Body())
+}
+
+// Same as `_guess_mime_type` in the Python server.
+func guessMimeType(artifactPath string) string {
+	switch path.Base(artifactPath) {
+	case "MLmodel", "MLproject":
+		return "text/plain"
+	}
+
+	if mimeType := mime.TypeByExtension(path.Ext(artifactPath)); mimeType != "" {
+		return mimeType
+	}
+
+	return "application/octet-stream"
+}
+
+func sendArtifact(ctx *fiber.Ctx, artifactPath string, reader io.ReadCloser) error {
+	ctx.Set(fiber.HeaderContentType, guessMimeType(artifactPath))
+	ctx.Set(
+		fiber.HeaderContentDisposition,
+		mime.FormatMediaType("attachment", map[string]string{"filename": path.Base(artifactPath)}),
+	)
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+
+	// The reader is closed once the response has been sent.
+	return ctx.SendStream(reader)
+}
+
+func RegisterArtifactsServiceStreamingRoutes(service service.ArtifactsStreamingService, app *fiber.App) {
+	app.Get(artifactRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		reader, err := service.DownloadArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath)
+		if err != nil {
+			return err
+		}
+
+		return sendArtifact(ctx, artifactPath, reader)
+	})
+	app.Put(artifactRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := service.UploadArtifact(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, getBodyReader(ctx),
+		); err != nil {
+			return err
+		}
+
+		return ctx.JSON(&artifacts.UploadArtifact_Response{})
+	})
+	app.Delete(artifactRoute, func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := service.DeleteArtifact(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath); err != nil {
+			return err
+		}
+
+		return ctx.JSON(&artifacts.DeleteArtifact_Response{})
+	})
+}
+
+// Make the part upload URLs, which are relative to the API root, absolute.
+func resolvePartUploadURLs(ctx *fiber.Ctx, output *artifacts.CreateMultipartUpload_Response) {
+	// The API is mounted on several prefixes, use the one of this request.
+	apiRoot := ctx.BaseURL() + ctx.Path()[:strings.Index(ctx.Path(), "/mlflow-artifacts/")+1]
+
+	for _, credential := range output.GetCredentials() {
+		if !strings.Contains(credential.GetUrl(), "://") {
+			credential.Url = utils.PtrTo(apiRoot + credential.GetUrl())
+		}
+	}
+}
+
+//nolint:funlen
+func RegisterArtifactsServiceMultipartUploadRoutes(
+	service service.ArtifactsMultipartUploadService, parser *parser.HTTPRequestParser, app *fiber.App,
+) {
+	app.Post("/mlflow-artifacts/mpu/create/+", func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CreateMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.CreateMultipartUpload(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input)
+		if err != nil {
+			return err
+		}
+
+		resolvePartUploadURLs(ctx, output)
+
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow-artifacts/mpu/complete/+", func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.CompleteMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.CompleteMultipartUpload(
+			utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input,
+		)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow-artifacts/mpu/abort/+", func(ctx *fiber.Ctx) error {
+		artifactPath, err := getArtifactPath(ctx)
+		if err != nil {
+			return err
+		}
+
+		input := &artifacts.AbortMultipartUpload{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+
+		output, err := service.AbortMultipartUpload(utils.NewContextWithLoggerFromFiberContext(ctx), artifactPath, input)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(output)
+	})
+	app.Put("/mlflow-artifacts/mpu/parts/:upload_id/:part_number", func(ctx *fiber.Ctx) error {
+		partNumber, convErr := strconv.ParseInt(ctx.Params("part_number"), 10, 64)
+		if convErr != nil {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid part number: %s", ctx.Params("part_number")),
+			)
+		}
+
+		etag, err := service.UploadMultipartUploadPart(
+			utils.NewContextWithLoggerFromFiberContext(ctx), ctx.Params("upload_id"), partNumber, getBodyReader(ctx),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Like S3, the client reads the ETag of the part from the response headers.
+		ctx.Set(fiber.HeaderETag, etag)
+
+		return ctx.SendStatus(fiber.StatusOK)
+	})
+}
+
+// RegisterModelVersionArtifactRoutes registers the route the UI uses to show the files of a model version,
+// which live wherever the download URI of the model version points to.
+func RegisterModelVersionArtifactRoutes(
+	modelRegistryService service.ModelRegistryService,
+	artifactsService service.ArtifactsStreamingService,
+	app *fiber.App,
+) {
+	app.Get("/get-artifact", func(ctx *fiber.Ctx) error {
+		userContext := utils.NewContextWithLoggerFromFiberContext(ctx)
+
+		output, err := modelRegistryService.GetModelVersionDownloadUri(
+			userContext,
+			&protos.GetModelVersionDownloadUri{
+				Name:    utils.PtrTo(ctx.Query("name")),
+				Version: utils.PtrTo(ctx.Query("version")),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		artifactPath := ctx.Query("path")
+
+		reader, err := artifactsService.DownloadArtifactFromURI(userContext, output.GetArtifactUri(), artifactPath)
+		if err != nil {
+			// Leave the artifact URIs without a Go repository to the Python server.
+			if protos.ErrorCode(err.Code) == protos.ErrorCode_NOT_IMPLEMENTED {
+				return ctx.Next()
+			}
+
+			return err
+		}
+
+		return sendArtifact(ctx, artifactPath, reader)
+	})
+}
@@ -7,214 +7,270 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracing"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 )
 
 func RegisterModelRegistryServiceRoutes(service service.ModelRegistryService, parser *parser.HTTPRequestParser, app *fiber.App) {
 	app.Post("/mlflow/registered-models/create", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createRegisteredModel").End()
 		input := &protos.CreateRegisteredModel{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.CreateRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.CreateRegisteredModel", service.CreateRegisteredModel, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/registered-models/rename", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "renameRegisteredModel").End()
 		input := &protos.RenameRegisteredModel{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.RenameRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.RenameRegisteredModel", service.RenameRegisteredModel, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Patch("/mlflow/registered-models/update", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "updateRegisteredModel").End()
 		input := &protos.UpdateRegisteredModel{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.UpdateRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.UpdateRegisteredModel", service.UpdateRegisteredModel, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/registered-models/delete", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteRegisteredModel").End()
 		input := &protos.DeleteRegisteredModel{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.DeleteRegisteredModel", service.DeleteRegisteredModel, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/registered-models/get", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getRegisteredModel").End()
 		input := &protos.GetRegisteredModel{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetRegisteredModel(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetRegisteredModel", service.GetRegisteredModel, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/registered-models/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchRegisteredModels").End()
+		input := &protos.SearchRegisteredModels{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.SearchRegisteredModels", service.SearchRegisteredModels, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/registered-models/get-latest-versions", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getLatestVersions").End()
 		input := &protos.GetLatestVersions{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetLatestVersions(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetLatestVersions", service.GetLatestVersions, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/registered-models/get-latest-versions", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getLatestVersions").End()
 		input := &protos.GetLatestVersions{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetLatestVersions(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetLatestVersions", service.GetLatestVersions, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/model-versions/create", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createModelVersion").End()
+		input := &protos.CreateModelVersion{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.CreateModelVersion", service.CreateModelVersion, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Patch("/mlflow/model-versions/update", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "updateModelVersion").End()
 		input := &protos.UpdateModelVersion{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.UpdateModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.UpdateModelVersion", service.UpdateModelVersion, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/model-versions/transition-stage", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "transitionModelVersionStage").End()
 		input := &protos.TransitionModelVersionStage{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.TransitionModelVersionStage(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.TransitionModelVersionStage", service.TransitionModelVersionStage, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/model-versions/delete", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteModelVersion").End()
 		input := &protos.DeleteModelVersion{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.DeleteModelVersion", service.DeleteModelVersion, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/model-versions/get", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getModelVersion").End()
 		input := &protos.GetModelVersion{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetModelVersion(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetModelVersion", service.GetModelVersion, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/model-versions/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchModelVersions").End()
+		input := &protos.SearchModelVersions{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.SearchModelVersions", service.SearchModelVersions, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/model-versions/get-download-uri", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getModelVersionDownloadUri").End()
 		input := &protos.GetModelVersionDownloadUri{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetModelVersionDownloadUri(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetModelVersionDownloadUri", service.GetModelVersionDownloadUri, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/registered-models/set-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setRegisteredModelTag").End()
 		input := &protos.SetRegisteredModelTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetRegisteredModelTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.SetRegisteredModelTag", service.SetRegisteredModelTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/model-versions/set-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setModelVersionTag").End()
 		input := &protos.SetModelVersionTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetModelVersionTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.SetModelVersionTag", service.SetModelVersionTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/registered-models/delete-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteRegisteredModelTag").End()
 		input := &protos.DeleteRegisteredModelTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteRegisteredModelTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.DeleteRegisteredModelTag", service.DeleteRegisteredModelTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/model-versions/delete-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteModelVersionTag").End()
 		input := &protos.DeleteModelVersionTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteModelVersionTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.DeleteModelVersionTag", service.DeleteModelVersionTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setRegisteredModelAlias").End()
 		input := &protos.SetRegisteredModelAlias{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetRegisteredModelAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.SetRegisteredModelAlias", service.SetRegisteredModelAlias, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteRegisteredModelAlias").End()
 		input := &protos.DeleteRegisteredModelAlias{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteRegisteredModelAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.DeleteRegisteredModelAlias", service.DeleteRegisteredModelAlias, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/registered-models/alias", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getModelVersionByAlias").End()
 		input := &protos.GetModelVersionByAlias{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetModelVersionByAlias(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "ModelRegistryService.GetModelVersionByAlias", service.GetModelVersionByAlias, input)
 		if err != nil {
 			return err
 		}
@@ -7,313 +7,534 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracing"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 )
 
 func RegisterTrackingServiceRoutes(service service.TrackingService, parser *parser.HTTPRequestParser, app *fiber.App) {
 	app.Get("/mlflow/experiments/get-by-name", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getExperimentByName").End()
 		input := &protos.GetExperimentByName{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetExperimentByName(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetExperimentByName", service.GetExperimentByName, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/create", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createExperiment").End()
 		input := &protos.CreateExperiment{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.CreateExperiment(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.CreateExperiment", service.CreateExperiment, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchExperiments").End()
 		input := &protos.SearchExperiments{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SearchExperiments(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchExperiments", service.SearchExperiments, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/experiments/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchExperiments").End()
 		input := &protos.SearchExperiments{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SearchExperiments(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchExperiments", service.SearchExperiments, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/experiments/get", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getExperiment").End()
 		input := &protos.GetExperiment{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetExperiment(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetExperiment", service.GetExperiment, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/delete", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteExperiment").End()
 		input := &protos.DeleteExperiment{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteExperiment(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteExperiment", service.DeleteExperiment, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/restore", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "restoreExperiment").End()
 		input := &protos.RestoreExperiment{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.RestoreExperiment(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.RestoreExperiment", service.RestoreExperiment, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/update", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "updateExperiment").End()
 		input := &protos.UpdateExperiment{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.UpdateExperiment(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.UpdateExperiment", service.UpdateExperiment, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/create", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createRun").End()
 		input := &protos.CreateRun{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.CreateRun(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.CreateRun", service.CreateRun, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/update", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "updateRun").End()
 		input := &protos.UpdateRun{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.UpdateRun(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.UpdateRun", service.UpdateRun, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/delete", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteRun").End()
 		input := &protos.DeleteRun{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteRun(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteRun", service.DeleteRun, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/restore", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "restoreRun").End()
 		input := &protos.RestoreRun{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.RestoreRun(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.RestoreRun", service.RestoreRun, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/log-metric", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "logMetric").End()
 		input := &protos.LogMetric{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.LogMetric(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogMetric", service.LogMetric, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/log-parameter", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "logParam").End()
 		input := &protos.LogParam{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.LogParam(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogParam", service.LogParam, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/experiments/set-experiment-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setExperimentTag").End()
 		input := &protos.SetExperimentTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetExperimentTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SetExperimentTag", service.SetExperimentTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/set-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setTag").End()
 		input := &protos.SetTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SetTag", service.SetTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Patch("/mlflow/traces/:request_id/tags", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setTraceTag").End()
 		input := &protos.SetTraceTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SetTraceTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SetTraceTag", service.SetTraceTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Delete("/mlflow/traces/:request_id/tags", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteTraceTag").End()
 		input := &protos.DeleteTraceTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteTraceTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteTraceTag", service.DeleteTraceTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/delete-tag", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteTag").End()
 		input := &protos.DeleteTag{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteTag(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteTag", service.DeleteTag, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/runs/get", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getRun").End()
 		input := &protos.GetRun{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetRun(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetRun", service.GetRun, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchRuns").End()
 		input := &protos.SearchRuns{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.SearchRuns(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchRuns", service.SearchRuns, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/metrics/get-history", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getMetricHistory").End()
 		input := &protos.GetMetricHistory{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetMetricHistory(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetMetricHistory", service.GetMetricHistory, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/metrics/get-history-bulk-interval", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getMetricHistoryBulkInterval").End()
+		input := &protos.GetMetricHistoryBulkInterval{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetMetricHistoryBulkInterval", service.GetMetricHistoryBulkInterval, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/log-batch", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "logBatch").End()
 		input := &protos.LogBatch{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.LogBatch(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogBatch", service.LogBatch, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/runs/log-inputs", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "logInputs").End()
 		input := &protos.LogInputs{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.LogInputs(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogInputs", service.LogInputs, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/runs/outputs", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "logOutputs").End()
+		input := &protos.LogOutputs{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogOutputs", service.LogOutputs, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("mlflow/experiments/search-datasets", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchDatasets").End()
+		input := &protos.SearchDatasets{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchDatasets", service.SearchDatasets, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/traces", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "startTrace").End()
 		input := &protos.StartTrace{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.StartTrace(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.StartTrace", service.StartTrace, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Patch("/mlflow/traces/:request_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "endTrace").End()
 		input := &protos.EndTrace{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.EndTrace(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.EndTrace", service.EndTrace, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Get("/mlflow/traces/:request_id/info", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getTraceInfo").End()
 		input := &protos.GetTraceInfo{}
 		if err := parser.ParseQuery(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.GetTraceInfo(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetTraceInfo", service.GetTraceInfo, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/traces/:trace_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getTraceInfoV3").End()
+		input := &protos.GetTraceInfoV3{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetTraceInfoV3", service.GetTraceInfoV3, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/traces", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchTraces").End()
+		input := &protos.SearchTraces{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchTraces", service.SearchTraces, input)
 		if err != nil {
 			return err
 		}
 		return ctx.JSON(output)
 	})
 	app.Post("/mlflow/traces/delete-traces", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteTraces").End()
 		input := &protos.DeleteTraces{}
 		if err := parser.ParseBody(ctx, input); err != nil {
 			return err
 		}
-		output, err := service.DeleteTraces(utils.NewContextWithLoggerFromFiberContext(ctx), input)
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteTraces", service.DeleteTraces, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/logged-models", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createLoggedModel").End()
+		input := &protos.CreateLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.CreateLoggedModel", service.CreateLoggedModel, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Patch("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "finalizeLoggedModel").End()
+		input := &protos.FinalizeLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.FinalizeLoggedModel", service.FinalizeLoggedModel, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Get("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "getLoggedModel").End()
+		input := &protos.GetLoggedModel{}
+		if err := parser.ParseQuery(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.GetLoggedModel", service.GetLoggedModel, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/logged-models/:model_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteLoggedModel").End()
+		input := &protos.DeleteLoggedModel{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteLoggedModel", service.DeleteLoggedModel, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/logged-models/search", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "searchLoggedModels").End()
+		input := &protos.SearchLoggedModels{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SearchLoggedModels", service.SearchLoggedModels, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Patch("/mlflow/logged-models/:model_id/tags", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "setLoggedModelTags").End()
+		input := &protos.SetLoggedModelTags{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.SetLoggedModelTags", service.SetLoggedModelTags, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/logged-models/:model_id/tags/:tag_key", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteLoggedModelTag").End()
+		input := &protos.DeleteLoggedModelTag{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteLoggedModelTag", service.DeleteLoggedModelTag, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/logged-models/:model_id/params", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "LogLoggedModelParams").End()
+		input := &protos.LogLoggedModelParamsRequest{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.LogLoggedModelParams", service.LogLoggedModelParams, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Post("/mlflow/traces/:assessment_trace_id/assessments", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "createAssessment").End()
+		input := &protos.CreateAssessment{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.CreateAssessment", service.CreateAssessment, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Patch("/mlflow/traces/:trace_id/assessments/:assessment_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "updateAssessment").End()
+		input := &protos.UpdateAssessment{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.UpdateAssessment", service.UpdateAssessment, input)
+		if err != nil {
+			return err
+		}
+		return ctx.JSON(output)
+	})
+	app.Delete("/mlflow/traces/:trace_id/assessments/:assessment_id", func(ctx *fiber.Ctx) error {
+		defer tracing.StartRouteSpan(ctx, "deleteAssessment").End()
+		input := &protos.DeleteAssessment{}
+		if err := parser.ParseBody(ctx, input); err != nil {
+			return err
+		}
+		output, err := tracing.CallService(utils.NewContextWithLoggerFromFiberContext(ctx), "TrackingService.DeleteAssessment", service.DeleteAssessment, input)
 		if err != nil {
 			return err
 		}
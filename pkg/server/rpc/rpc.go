@@ -0,0 +1,152 @@
+// Package rpc serves the tracking and model registry services over gRPC,
+// with the service and method names of the MLflow protos.
+package rpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+	"github.com/mlflow/mlflow-go-backend/pkg/validation"
+)
+
+var getValidator = sync.OnceValues(func() (*validator.Validate, *contract.Error) {
+	validate, err := validation.NewValidator()
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INTERNAL_ERROR,
+			err.Error(),
+		)
+	}
+
+	return validate, nil
+})
+
+func newServiceDesc(serviceName string, handlerType any, metadata string) *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: serviceName,
+		HandlerType: handlerType,
+		Methods:     []grpc.MethodDesc{},
+		Streams:     []grpc.StreamDesc{},
+		Metadata:    metadata,
+	}
+}
+
+// addUnaryMethod adds a method of a service to its description, the input is validated
+// like the HTTP server does, after the interceptors ran.
+func addUnaryMethod[S any, T any, I interface {
+	*T
+	proto.Message
+}, O proto.Message](
+	desc *grpc.ServiceDesc, name string, method func(S, context.Context, I) (O, *contract.Error),
+) {
+	fullMethod := "/" + desc.ServiceName + "/" + name
+
+	handler := func(
+		srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+	) (any, error) {
+		input := I(new(T))
+		if err := dec(input); err != nil {
+			return nil, contract.NewError(protos.ErrorCode_BAD_REQUEST, err.Error())
+		}
+
+		invoke := func(ctx context.Context, req any) (any, error) {
+			input, _ := req.(I)
+
+			validate, contractErr := getValidator()
+			if contractErr != nil {
+				return nil, contractErr
+			}
+
+			if err := validate.Struct(input); err != nil {
+				return nil, validation.NewErrorFromValidationError(err)
+			}
+
+			service, _ := srv.(S)
+
+			output, contractErr := method(service, ctx, input)
+			if contractErr != nil {
+				return nil, contractErr
+			}
+
+			return output, nil
+		}
+
+		if interceptor == nil {
+			return invoke(ctx, input)
+		}
+
+		return interceptor(ctx, input, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}, invoke)
+	}
+
+	desc.Methods = append(desc.Methods, grpc.MethodDesc{MethodName: name, Handler: handler})
+}
+
+// HTTPEndpoint returns the first HTTP endpoint of a gRPC method, from the options of the protos.
+func HTTPEndpoint(fullMethod string) (string, string, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return "", "", false
+	}
+
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return "", "", false
+	}
+
+	rpcOptions, ok := proto.GetExtension(method.Options(), protos.E_Rpc).(*protos.DatabricksRpcOptions)
+	if !ok || len(rpcOptions.GetEndpoints()) == 0 {
+		return "", "", false
+	}
+
+	endpoint := rpcOptions.GetEndpoints()[0]
+
+	return endpoint.GetMethod(), endpoint.GetPath(), true
+}
+
+// The logger of the server is given to the services through the context, like the HTTP server does.
+func loggingInterceptor(ctx context.Context) grpc.UnaryServerInterceptor {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	return func(
+		requestCtx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(utils.NewContextWithLogger(requestCtx, logger), req)
+
+		grpcStatus, _ := status.FromError(err)
+		logger.Infof("%s - %s %s", grpcStatus.Code(), time.Since(start), info.FullMethod)
+
+		return resp, err //nolint:wrapcheck
+	}
+}
+
+// NewServer creates the gRPC server, the interceptors run in order after the logging one.
+// The server supports reflection, for clients like grpcurl.
+func NewServer(
+	ctx context.Context, interceptors []grpc.UnaryServerInterceptor, options ...grpc.ServerOption,
+) *grpc.Server {
+	server := grpc.NewServer(append(
+		options,
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{loggingInterceptor(ctx)}, interceptors...)...),
+	)...)
+
+	reflection.Register(server)
+
+	return server
+}
@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract/service"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type trackingService struct {
+	service.TrackingService
+}
+
+func (s trackingService) GetExperiment(
+	_ context.Context, input *protos.GetExperiment,
+) (*protos.GetExperiment_Response, *contract.Error) {
+	if input.GetExperimentId() != "0" {
+		return nil, contract.NewError(protos.ErrorCode_RESOURCE_DOES_NOT_EXIST, "experiment not found")
+	}
+
+	return &protos.GetExperiment_Response{
+		Experiment: &protos.Experiment{ExperimentId: input.ExperimentId},
+	}, nil
+}
+
+func newTestClient(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := NewServer(context.Background(), nil)
+	RegisterTrackingServiceServer(server, trackingService{})
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return conn
+}
+
+func TestUnaryMethod(t *testing.T) {
+	t.Parallel()
+
+	conn := newTestClient(t)
+	ctx := context.Background()
+
+	var output protos.GetExperiment_Response
+
+	err := conn.Invoke(
+		ctx, "/mlflow.MlflowService/getExperiment", &protos.GetExperiment{ExperimentId: utils.PtrTo("0")}, &output,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "0", output.GetExperiment().GetExperimentId())
+
+	err = conn.Invoke(
+		ctx, "/mlflow.MlflowService/getExperiment", &protos.GetExperiment{ExperimentId: utils.PtrTo("1")}, &output,
+	)
+	grpcStatus, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, grpcStatus.Code())
+	assert.Equal(t, "experiment not found", grpcStatus.Message())
+
+	require.Len(t, grpcStatus.Details(), 1)
+
+	errorInfo, ok := grpcStatus.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "RESOURCE_DOES_NOT_EXIST", errorInfo.GetReason())
+
+	// The inputs are validated like the HTTP server does.
+	err = conn.Invoke(ctx, "/mlflow.MlflowService/getExperiment", &protos.GetExperiment{}, &output)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHTTPEndpoint(t *testing.T) {
+	t.Parallel()
+
+	method, path, ok := HTTPEndpoint("/mlflow.MlflowService/getExperiment")
+	require.True(t, ok)
+	assert.Equal(t, "GET", method)
+	assert.Equal(t, "/mlflow/experiments/get", path)
+
+	_, _, ok = HTTPEndpoint("/mlflow.MlflowService/unknown")
+	assert.False(t, ok)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -21,24 +22,95 @@ import (
 	mr "github.com/mlflow/mlflow-go-backend/pkg/model_registry/service"
 	ts "github.com/mlflow/mlflow-go-backend/pkg/tracking/service"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/auth"
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/server/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/server/routes"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracing"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
+// services are shared by the HTTP server and the gRPC one.
+type services struct {
+	tracking      *ts.TrackingService
+	modelRegistry *mr.ModelRegistryService
+	artifacts     *as.ArtifactsService
+	// auth is nil when the authentication is disabled.
+	auth *auth.Store
+}
+
+// migrateDatabases creates or upgrades the schema of the SQL stores.
+func migrateDatabases(ctx context.Context, cfg *config.Config) error {
+	for _, storeURI := range slices.Compact([]string{cfg.TrackingStoreURI, cfg.ModelRegistryStoreURI}) {
+		if _, ok := utils.LocalPathFromURI(storeURI); ok {
+			continue
+		}
+
+		if err := sql.MigrateDatabase(ctx, storeURI); err != nil {
+			return fmt.Errorf("failed to migrate the database of %q: %w", storeURI, err)
+		}
+	}
+
+	return nil
+}
+
+func newServices(ctx context.Context, cfg *config.Config) (*services, error) {
+	if cfg.MigrateDatabase {
+		if err := migrateDatabases(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	trackingService, err := ts.NewTrackingService(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new tracking service: %w", err)
+	}
+
+	modelRegistryService, err := mr.NewModelRegistryService(ctx, cfg, trackingService.Store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new model registry service: %w", err)
+	}
+
+	artifactService, err := as.NewArtifactsService(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new artifacts service: %w", err)
+	}
+
+	var authStore *auth.Store
+
+	if cfg.Auth.Enabled {
+		authStore, err = auth.NewStore(ctx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new auth store: %w", err)
+		}
+	}
+
+	return &services{
+		tracking:      trackingService,
+		modelRegistry: modelRegistryService,
+		artifacts:     artifactService,
+		auth:          authStore,
+	}, nil
+}
+
 //nolint:funlen
-func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
-	//nolint:mnd
+func configureApp(ctx context.Context, cfg *config.Config, services *services) (*fiber.App, error) {
 	app := fiber.New(fiber.Config{
-		BodyLimit:      16 * 1024 * 1024,
-		ReadBufferSize: 16384,
-		ReadTimeout:    5 * time.Second,
-		WriteTimeout:   600 * time.Second,
-		IdleTimeout:    120 * time.Second,
-		ServerHeader:   "mlflow/" + cfg.Version,
+		BodyLimit:        int(cfg.HTTP.BodyLimit),
+		ReadBufferSize:   int(cfg.HTTP.ReadBufferSize),
+		WriteBufferSize:  int(cfg.HTTP.WriteBufferSize),
+		ReadTimeout:      cfg.HTTP.ReadTimeout.Duration,
+		WriteTimeout:     cfg.HTTP.WriteTimeout.Duration,
+		IdleTimeout:      cfg.HTTP.IdleTimeout.Duration,
+		DisableKeepalive: cfg.HTTP.DisableKeepalive,
+		Concurrency:      cfg.HTTP.Concurrency,
+		// Stream bodies over the BodyLimit instead of rejecting them, used for artifact uploads,
+		// the limitBody middleware rejects them on the other routes.
+		StreamRequestBody: true,
+		ServerHeader:      "mlflow/" + cfg.Version,
 		JSONEncoder: func(value interface{}) ([]byte, error) {
 			if protoMessage, ok := value.(proto.Message); ok {
 				return protojson.MarshalOptions{
@@ -57,6 +129,7 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		},
 		DisableStartupMessage: true,
 	})
+	app.Server().HeaderReceived = newRequestConfig(cfg.HTTP.Routes)
 
 	app.Use(compress.New())
 	app.Use(recover.New(recover.Config{EnableStackTrace: true}))
@@ -64,19 +137,42 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 		Format: "${status} - ${latency} ${method} ${path}\n",
 		Output: utils.GetLoggerFromContext(ctx).Writer(),
 	}))
+	app.Use(limitBody(cfg.HTTP))
 	app.Use(func(c *fiber.Ctx) error {
 		c.SetUserContext(ctx)
 
 		return c.Next()
 	})
+	app.Use(tracing.NewMiddleware())
+
+	if cfg.ExposePrometheus {
+		metrics, err := newMetrics(services)
+		if err != nil {
+			return nil, err
+		}
+
+		app.Use(metrics.middleware())
+		app.Get("/metrics", metrics.handler())
+	}
 
-	apiApp, err := newAPIApp(ctx, cfg)
+	if services.auth != nil {
+		app.Use(auth.NewMiddleware(services.auth, services.artifacts.GetMultipartUploadPath))
+	}
+
+	apiApp, modelVersionsApp, err := newAPIApps(cfg, services)
 	if err != nil {
 		return nil, err
 	}
 
-	app.Mount("/api/2.0", apiApp)
-	app.Mount("/ajax-api/2.0", apiApp)
+	if services.auth != nil {
+		auth.RegisterRoutes(services.auth, apiApp)
+	}
+
+	for _, prefix := range apiPrefixes {
+		app.Mount(prefix, apiApp)
+	}
+
+	app.Mount("/model-versions", modelVersionsApp)
 
 	if cfg.StaticFolder != "" {
 		app.Static("/static-files", cfg.StaticFolder)
@@ -93,7 +189,13 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 	})
 
 	if cfg.PythonAddress != "" {
-		app.Use(proxy.BalancerForward([]string{cfg.PythonAddress}))
+		forward := proxy.BalancerForward([]string{cfg.PythonAddress})
+
+		app.Use(func(c *fiber.Ctx) error {
+			c.Locals(originLocalsKey, originPython)
+
+			return forward(c)
+		})
 	}
 
 	return app, nil
@@ -102,11 +204,47 @@ func configureApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
 func launchServer(ctx context.Context, cfg *config.Config) error {
 	logger := utils.GetLoggerFromContext(ctx)
 
-	app, err := configureApp(ctx, cfg)
+	shutdownTracing, err := tracing.Setup(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to setup tracing: %w", err)
+	}
+
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Errorf("Failed to flush traces: %v", err)
+		}
+	}()
+
+	var reloader *certificateReloader
+
+	if cfg.TLS.CertFile != "" {
+		reloader, err = newCertificateReloader(cfg.TLS)
+		if err != nil {
+			return err
+		}
+	}
+
+	services, err := newServices(ctx, cfg)
 	if err != nil {
 		return err
 	}
 
+	app, err := configureApp(ctx, cfg, services)
+	if err != nil {
+		return err
+	}
+
+	// The files are watched once the setup succeeded, a failed launch would leave the watcher running.
+	if reloader != nil {
+		go reloader.watch(ctx)
+	}
+
+	if cfg.GRPCAddress != "" {
+		if err := launchGRPCServer(ctx, cfg, services, reloader); err != nil {
+			return err
+		}
+	}
+
 	go func() {
 		<-ctx.Done()
 
@@ -140,9 +278,16 @@ func launchServer(ctx context.Context, cfg *config.Config) error {
 		logger.Debugf("Python server is ready on http://%s", cfg.PythonAddress)
 	}
 
-	logger.Infof("Launching MLflow Go server on http://%s", cfg.Address)
+	if reloader == nil {
+		logger.Infof("Launching MLflow Go server on http://%s", cfg.Address)
+
+		err = app.Listen(cfg.Address)
+	} else {
+		logger.Infof("Launching MLflow Go server on https://%s", cfg.Address)
+
+		err = listenTLS(ctx, app, cfg.Address, reloader)
+	}
 
-	err = app.Listen(cfg.Address)
 	if err != nil {
 		return fmt.Errorf("failed to start MLflow Go server: %w", err)
 	}
@@ -150,27 +295,34 @@ func launchServer(ctx context.Context, cfg *config.Config) error {
 	return nil
 }
 
+// Convert the errors returned by the routes, Fiber errors are mapped to the closest error code.
+func newContractError(err error) *contract.Error {
+	var contractError *contract.Error
+	if errors.As(err, &contractError) {
+		return contractError
+	}
+
+	code := protos.ErrorCode_INTERNAL_ERROR
+
+	var f *fiber.Error
+	if errors.As(err, &f) {
+		switch f.Code {
+		case fiber.StatusBadRequest:
+			code = protos.ErrorCode_BAD_REQUEST
+		case fiber.StatusServiceUnavailable:
+			code = protos.ErrorCode_SERVICE_UNDER_MAINTENANCE
+		case fiber.StatusNotFound:
+			code = protos.ErrorCode_ENDPOINT_NOT_FOUND
+		}
+	}
+
+	return contract.NewError(code, err.Error())
+}
+
 func newFiberConfig() fiber.Config {
 	return fiber.Config{
 		ErrorHandler: func(context *fiber.Ctx, err error) error {
-			var contractError *contract.Error
-			if !errors.As(err, &contractError) {
-				code := protos.ErrorCode_INTERNAL_ERROR
-
-				var f *fiber.Error
-				if errors.As(err, &f) {
-					switch f.Code {
-					case fiber.StatusBadRequest:
-						code = protos.ErrorCode_BAD_REQUEST
-					case fiber.StatusServiceUnavailable:
-						code = protos.ErrorCode_SERVICE_UNDER_MAINTENANCE
-					case fiber.StatusNotFound:
-						code = protos.ErrorCode_ENDPOINT_NOT_FOUND
-					}
-				}
-
-				contractError = contract.NewError(code, err.Error())
-			}
+			contractError := newContractError(err)
 
 			var logFn func(format string, args ...any)
 
@@ -193,34 +345,31 @@ func newFiberConfig() fiber.Config {
 	}
 }
 
-func newAPIApp(ctx context.Context, cfg *config.Config) (*fiber.App, error) {
+// Create the app serving the REST API, and the app serving the model version artifacts to the UI.
+func newAPIApps(cfg *config.Config, services *services) (*fiber.App, *fiber.App, error) {
 	app := fiber.New(newFiberConfig())
 
 	parser, err := parser.NewHTTPRequestParser()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
-	}
-
-	trackingService, err := ts.NewTrackingService(ctx, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new tracking service: %w", err)
+		return nil, nil, fmt.Errorf("failed to create new HTTP request parser: %w", err)
 	}
 
-	routes.RegisterTrackingServiceRoutes(trackingService, parser, app)
-
-	modelRegistryService, err := mr.NewModelRegistryService(ctx, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new model registry service: %w", err)
+	if cfg.PythonAddress != "" {
+		app.Use(forwardNotImplemented(cfg.PythonAddress))
 	}
 
-	routes.RegisterModelRegistryServiceRoutes(modelRegistryService, parser, app)
+	routes.RegisterTrackingServiceRoutes(services.tracking, parser, app)
+	routes.RegisterModelRegistryServiceRoutes(services.modelRegistry, parser, app)
 
-	artifactService, err := as.NewArtifactsService(ctx, cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new artifacts service: %w", err)
+	// Without an artifacts destination the requests are left to the Python server.
+	if cfg.ArtifactsDestination != "" {
+		routes.RegisterArtifactsServiceRoutes(services.artifacts, parser, app)
+		routes.RegisterArtifactsServiceStreamingRoutes(services.artifacts, app)
+		routes.RegisterArtifactsServiceMultipartUploadRoutes(services.artifacts, parser, app)
 	}
 
-	routes.RegisterArtifactsServiceRoutes(artifactService, parser, app)
+	modelVersionsApp := fiber.New(newFiberConfig())
+	routes.RegisterModelVersionArtifactRoutes(services.modelRegistry, services.artifacts, modelVersionsApp)
 
-	return app, nil
+	return app, modelVersionsApp, nil
 }
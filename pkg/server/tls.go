@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var (
+	errClientCA = errors.New("no certificate found in client CA file")
+
+	clientAuthTypes = map[string]tls.ClientAuthType{
+		"none":     tls.NoClientCert,
+		"optional": tls.VerifyClientCertIfGiven,
+		"require":  tls.RequireAndVerifyClientCert,
+	}
+)
+
+// certificateReloader loads the certificate, key and client CA files of the TLS config,
+// and loads them again when their modification time changes.
+type certificateReloader struct {
+	cfg     config.TLSConfig
+	current atomic.Pointer[tls.Config]
+	// modTimes are the modification times of the files last loaded, only used by the goroutine reloading them.
+	modTimes []time.Time
+}
+
+func newCertificateReloader(cfg config.TLSConfig) (*certificateReloader, error) {
+	reloader := &certificateReloader{cfg: cfg}
+
+	if _, err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+func (r *certificateReloader) files() []string {
+	files := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCAFile != "" {
+		files = append(files, r.cfg.ClientCAFile)
+	}
+
+	return files
+}
+
+func (r *certificateReloader) load() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   clientAuthTypes[r.cfg.ClientAuth],
+	}
+
+	if r.cfg.ClientCAFile != "" {
+		bundle, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("%w %q", errClientCA, r.cfg.ClientCAFile)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// reload loads the files when one of them changed since they were last loaded, and reports whether it did.
+// A certificate and a key being replaced one after the other fail to load until both are.
+func (r *certificateReloader) reload() (bool, error) {
+	modTimes := make([]time.Time, 0, len(r.files()))
+
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, fmt.Errorf("failed to stat TLS file: %w", err)
+		}
+
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	if slices.EqualFunc(modTimes, r.modTimes, time.Time.Equal) {
+		return false, nil
+	}
+
+	tlsConfig, err := r.load()
+	if err != nil {
+		return false, err
+	}
+
+	r.current.Store(tlsConfig)
+	r.modTimes = modTimes
+
+	return true, nil
+}
+
+// watch reloads the files every reload interval until the context is done,
+// the previous certificate is kept when they fail to load.
+func (r *certificateReloader) watch(ctx context.Context) {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	ticker := time.NewTicker(r.cfg.ReloadInterval.Duration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.reload()
+			if err != nil {
+				logger.Errorf("Failed to reload TLS certificate, keeping the previous one: %v", err)
+			} else if reloaded {
+				logger.Infof("Reloaded TLS certificate %s", r.cfg.CertFile)
+			}
+		}
+	}
+}
+
+// tlsConfig returns the config of a listener, which uses the files last loaded for every handshake.
+func (r *certificateReloader) tlsConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			tlsConfig := r.current.Load().Clone()
+			tlsConfig.NextProtos = nextProtos
+
+			return tlsConfig, nil
+		},
+	}
+}
+
+// listenTLS serves the app over TLS, the client certificates verified are available
+// from the TLS connection state of the requests.
+func listenTLS(ctx context.Context, app *fiber.App, address string, reloader *certificateReloader) error {
+	listenConfig := &net.ListenConfig{}
+
+	listener, err := listenConfig.Listen(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	return app.Listener(tls.NewListener(listener, reloader.tlsConfig())) //nolint:wrapcheck
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/auth"
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCertificate(t *testing.T, name string, parent *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCertificate{certificate, key}
+}
+
+func (c *testCertificate) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.certificate.Raw})
+}
+
+func (c *testCertificate) write(t *testing.T, certFile, keyFile string, modTime time.Time) {
+	t.Helper()
+
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, c.certPEM(), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+
+	for _, file := range []string{certFile, keyFile} {
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+}
+
+func (c *testCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.certificate.Raw}, PrivateKey: c.key}
+}
+
+func TestTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := config.TLSConfig{
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+		ClientAuth:   "require",
+	}
+
+	authority := newTestCertificate(t, "ca", nil)
+	require.NoError(t, os.WriteFile(cfg.ClientCAFile, authority.certPEM(), 0o600))
+	newTestCertificate(t, "server", authority).write(t, cfg.CertFile, cfg.KeyFile, time.Now().Add(-time.Minute))
+
+	reloader, err := newCertificateReloader(cfg)
+	require.NoError(t, err)
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/subject", func(c *fiber.Ctx) error {
+		return c.SendString(auth.ClientCertificate(c).Subject.CommonName)
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = app.Listener(tls.NewListener(listener, reloader.tlsConfig()))
+	}()
+
+	t.Cleanup(func() {
+		require.NoError(t, app.Shutdown())
+	})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(authority.certificate)
+
+	client := newTestCertificate(t, "alice", authority)
+	get := func(certificates ...tls.Certificate) (string, string, error) {
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			RootCAs:      roots,
+			ServerName:   "localhost",
+			Certificates: certificates,
+		}}}
+		defer httpClient.CloseIdleConnections()
+
+		resp, err := httpClient.Get("https://" + listener.Addr().String() + "/subject") //nolint:noctx
+		if err != nil {
+			return "", "", err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.TLS.PeerCertificates[0].Subject.CommonName, string(body), nil
+	}
+
+	_, _, err = get()
+	require.Error(t, err, "the client certificate is required")
+
+	server, subject, err := get(client.tlsCertificate())
+	require.NoError(t, err)
+	assert.Equal(t, "server", server)
+	assert.Equal(t, "alice", subject)
+
+	// The files are loaded again once they changed.
+	reloaded, err := reloader.reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	newTestCertificate(t, "rotated", authority).write(t, cfg.CertFile, cfg.KeyFile, time.Now())
+
+	reloaded, err = reloader.reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+
+	server, _, err = get(client.tlsCertificate())
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", server)
+}
@@ -0,0 +1,488 @@
+package migrations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// The schema is described by the DDL operations of the alembic revisions, in the same terms as SQLAlchemy.
+// The operations are replayed on a model of the tables, as some dialects need the whole definition
+// of a table to change it, like SQLite which rebuilds a table to alter it.
+
+const (
+	sqliteDialect    = "sqlite"
+	postgresDialect  = "postgres"
+	mysqlDialect     = "mysql"
+	sqlserverDialect = "sqlserver"
+)
+
+type columnKind int
+
+const (
+	integerKind columnKind = iota
+	bigIntegerKind
+	stringKind
+	textKind
+	mediumTextKind
+	floatKind
+	doubleKind
+	booleanKind
+)
+
+type columnType struct {
+	kind   columnKind
+	length int
+}
+
+var (
+	integerType    = columnType{kind: integerKind}
+	bigIntegerType = columnType{kind: bigIntegerKind}
+	textType       = columnType{kind: textKind}
+	mediumTextType = columnType{kind: mediumTextKind}
+	floatType      = columnType{kind: floatKind}
+	doubleType     = columnType{kind: doubleKind}
+	booleanType    = columnType{kind: booleanKind}
+)
+
+func stringType(length int) columnType {
+	return columnType{kind: stringKind, length: length}
+}
+
+func (t columnType) sql(dialect string) string {
+	switch t.kind {
+	case integerKind:
+		return "INTEGER"
+	case bigIntegerKind:
+		return "BIGINT"
+	case stringKind:
+		return fmt.Sprintf("VARCHAR(%d)", t.length)
+	case textKind, mediumTextKind:
+		switch {
+		case dialect == mysqlDialect && t.kind == mediumTextKind:
+			return "MEDIUMTEXT"
+		case dialect == sqlserverDialect:
+			return "VARCHAR(max)"
+		default:
+			return "TEXT"
+		}
+	case floatKind, doubleKind:
+		// Only the FLOAT of MySQL is a single precision one, the others already are double precision.
+		if dialect == mysqlDialect && t.kind == doubleKind {
+			return "FLOAT(53)"
+		}
+
+		return "FLOAT"
+	case booleanKind:
+		switch dialect {
+		case mysqlDialect:
+			return "BOOL"
+		case sqlserverDialect:
+			return "BIT"
+		default:
+			return "BOOLEAN"
+		}
+	default:
+		panic(fmt.Sprintf("unknown column kind %d", t.kind))
+	}
+}
+
+type column struct {
+	name     string
+	typ      columnType
+	nullable bool
+	// serverDefault is the SQL literal of the default value of the column.
+	serverDefault string
+	autoIncrement bool
+}
+
+func (c column) sql(dialect string) string {
+	definition := quote(dialect, c.name) + " "
+
+	if c.autoIncrement && dialect == postgresDialect {
+		definition += "SERIAL"
+	} else {
+		definition += c.typ.sql(dialect)
+	}
+
+	switch {
+	case !c.nullable:
+		definition += " NOT NULL"
+	case dialect == sqlserverDialect:
+		// The default nullability of SQL Server depends on the settings of the session.
+		definition += " NULL"
+	}
+
+	if c.serverDefault != "" {
+		definition += " DEFAULT " + c.serverDefault
+	}
+
+	if c.autoIncrement {
+		switch dialect {
+		case mysqlDialect:
+			definition += " AUTO_INCREMENT"
+		case sqlserverDialect:
+			definition += " IDENTITY"
+		}
+	}
+
+	return definition
+}
+
+type primaryKey struct {
+	name    string
+	columns []string
+}
+
+func (p primaryKey) sql(dialect string) string {
+	return fmt.Sprintf("CONSTRAINT %s PRIMARY KEY (%s)", quote(dialect, p.name), quoteAll(dialect, p.columns))
+}
+
+// check is a CHECK constraint limiting a column to a list of values, the only kind used by MLflow.
+type check struct {
+	name   string
+	column string
+	values []string
+}
+
+func (c check) sql(dialect string) string {
+	values := make([]string, 0, len(c.values))
+	for _, value := range c.values {
+		values = append(values, "'"+value+"'")
+	}
+
+	return fmt.Sprintf(
+		"CONSTRAINT %s CHECK (%s IN (%s))",
+		quote(dialect, c.name), quote(dialect, c.column), strings.Join(values, ", "),
+	)
+}
+
+// foreignKey is a foreign key, the early revisions of MLflow leave their name to the database.
+type foreignKey struct {
+	name       string
+	columns    []string
+	table      string
+	references []string
+	onUpdate   string
+	onDelete   string
+}
+
+func (f foreignKey) sql(dialect string) string {
+	definition := ""
+	if f.name != "" {
+		definition = "CONSTRAINT " + quote(dialect, f.name) + " "
+	}
+
+	definition += fmt.Sprintf(
+		"FOREIGN KEY (%s) REFERENCES %s (%s)",
+		quoteAll(dialect, f.columns), quote(dialect, f.table), quoteAll(dialect, f.references),
+	)
+
+	if f.onDelete != "" {
+		definition += " ON DELETE " + f.onDelete
+	}
+
+	if f.onUpdate != "" {
+		definition += " ON UPDATE " + f.onUpdate
+	}
+
+	return definition
+}
+
+type index struct {
+	name    string
+	columns []string
+}
+
+func (i index) sql(dialect, table string) string {
+	return fmt.Sprintf(
+		"CREATE INDEX %s ON %s (%s)", quote(dialect, i.name), quote(dialect, table), quoteAll(dialect, i.columns),
+	)
+}
+
+type table struct {
+	name        string
+	columns     []column
+	primaryKey  primaryKey
+	uniques     [][]string
+	checks      []check
+	foreignKeys []foreignKey
+	indexes     []index
+}
+
+func (t *table) clone() *table {
+	clone := *t
+	clone.columns = slices.Clone(t.columns)
+	clone.uniques = slices.Clone(t.uniques)
+	clone.checks = slices.Clone(t.checks)
+	clone.foreignKeys = slices.Clone(t.foreignKeys)
+	clone.indexes = slices.Clone(t.indexes)
+
+	return &clone
+}
+
+func (t *table) column(name string) *column {
+	for i := range t.columns {
+		if t.columns[i].name == name {
+			return &t.columns[i]
+		}
+	}
+
+	panic(fmt.Sprintf("unknown column %s.%s", t.name, name))
+}
+
+func (t *table) columnNames() []string {
+	names := make([]string, 0, len(t.columns))
+	for _, column := range t.columns {
+		names = append(names, column.name)
+	}
+
+	return names
+}
+
+// create returns the statements creating the table under a name, which differs from its own during a rebuild.
+func (t *table) create(dialect, name string) []string {
+	definitions := make([]string, 0, len(t.columns)+1+len(t.uniques)+len(t.checks)+len(t.foreignKeys))
+
+	for _, column := range t.columns {
+		definitions = append(definitions, column.sql(dialect))
+	}
+
+	definitions = append(definitions, t.primaryKey.sql(dialect))
+
+	for _, columns := range t.uniques {
+		definitions = append(definitions, fmt.Sprintf("UNIQUE (%s)", quoteAll(dialect, columns)))
+	}
+
+	for _, check := range t.checks {
+		definitions = append(definitions, check.sql(dialect))
+	}
+
+	for _, foreignKey := range t.foreignKeys {
+		definitions = append(definitions, foreignKey.sql(dialect))
+	}
+
+	statements := []string{
+		fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", quote(dialect, name), strings.Join(definitions, ",\n\t")),
+	}
+
+	for _, index := range t.indexes {
+		statements = append(statements, index.sql(dialect, name))
+	}
+
+	return statements
+}
+
+// rebuild returns the statements recreating the table from its definition and copying its rows,
+// the way alembic alters a table on SQLite which only supports adding columns.
+func (t *table) rebuild(dialect string) []string {
+	temporary := "_alembic_tmp_" + t.name
+	name := quote(dialect, t.name)
+	columns := quoteAll(dialect, t.columnNames())
+
+	statements := t.create(dialect, temporary)[:1]
+	statements = append(statements,
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", quote(dialect, temporary), columns, columns, name),
+		"DROP TABLE "+name,
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", quote(dialect, temporary), name),
+	)
+
+	for _, index := range t.indexes {
+		statements = append(statements, index.sql(dialect, t.name))
+	}
+
+	return statements
+}
+
+type schema struct {
+	tables []*table
+}
+
+func (s *schema) table(name string) *table {
+	for _, table := range s.tables {
+		if table.name == name {
+			return table
+		}
+	}
+
+	panic("unknown table " + name)
+}
+
+func quote(dialect, name string) string {
+	switch dialect {
+	case mysqlDialect:
+		return "`" + name + "`"
+	case sqlserverDialect:
+		return "[" + name + "]"
+	default:
+		return `"` + name + `"`
+	}
+}
+
+func quoteAll(dialect string, names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, quote(dialect, name))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// operation applies a change of a revision to the model of the schema,
+// and returns the statements applying it to a database of the dialect.
+type operation func(s *schema, dialect string) []string
+
+func createTable(definition table) operation {
+	return func(s *schema, dialect string) []string {
+		table := definition.clone()
+		s.tables = append(s.tables, table)
+
+		return table.create(dialect, table.name)
+	}
+}
+
+func addColumn(tableName string, definition column) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		table.columns = append(table.columns, definition)
+
+		keyword := "ADD COLUMN"
+		if dialect == sqlserverDialect {
+			keyword = "ADD"
+		}
+
+		return []string{fmt.Sprintf("ALTER TABLE %s %s %s", quote(dialect, tableName), keyword, definition.sql(dialect))}
+	}
+}
+
+func alterColumn(tableName, columnName string, typ columnType, nullable bool) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		column := table.column(columnName)
+		previous := *column
+		column.typ, column.nullable = typ, nullable
+
+		if previous.sql(dialect) == column.sql(dialect) {
+			return nil
+		}
+
+		alter := "ALTER TABLE " + quote(dialect, tableName)
+
+		switch dialect {
+		case sqliteDialect:
+			return table.rebuild(dialect)
+		case mysqlDialect:
+			return []string{alter + " MODIFY " + column.sql(dialect)}
+		case sqlserverDialect:
+			// The default of a column is a constraint of its own, which ALTER COLUMN does not take.
+			definition := *column
+			definition.serverDefault = ""
+
+			return []string{alter + " ALTER COLUMN " + definition.sql(dialect)}
+		default:
+			var statements []string
+
+			if previous.typ.sql(dialect) != typ.sql(dialect) {
+				statements = append(statements, fmt.Sprintf(
+					"%s ALTER COLUMN %s TYPE %s", alter, quote(dialect, columnName), typ.sql(dialect),
+				))
+			}
+
+			if previous.nullable != nullable {
+				change := "SET NOT NULL"
+				if nullable {
+					change = "DROP NOT NULL"
+				}
+
+				statements = append(statements, fmt.Sprintf("%s ALTER COLUMN %s %s", alter, quote(dialect, columnName), change))
+			}
+
+			return statements
+		}
+	}
+}
+
+func replacePrimaryKey(tableName string, definition primaryKey) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		previous := table.primaryKey
+		table.primaryKey = definition
+
+		alter := "ALTER TABLE " + quote(dialect, tableName)
+
+		switch dialect {
+		case sqliteDialect:
+			return table.rebuild(dialect)
+		case mysqlDialect:
+			return []string{alter + " DROP PRIMARY KEY", alter + " ADD " + definition.sql(dialect)}
+		default:
+			return []string{
+				alter + " DROP CONSTRAINT " + quote(dialect, previous.name),
+				alter + " ADD " + definition.sql(dialect),
+			}
+		}
+	}
+}
+
+func replaceCheck(tableName string, definition check) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		index := slices.IndexFunc(table.checks, func(check check) bool {
+			return check.name == definition.name
+		})
+		table.checks[index] = definition
+
+		alter := "ALTER TABLE " + quote(dialect, tableName)
+
+		switch dialect {
+		case sqliteDialect:
+			return table.rebuild(dialect)
+		case mysqlDialect:
+			return []string{
+				alter + " DROP CHECK " + quote(dialect, definition.name),
+				alter + " ADD " + definition.sql(dialect),
+			}
+		default:
+			return []string{
+				alter + " DROP CONSTRAINT " + quote(dialect, definition.name),
+				alter + " ADD " + definition.sql(dialect),
+			}
+		}
+	}
+}
+
+func replaceForeignKey(tableName string, definition foreignKey) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		index := slices.IndexFunc(table.foreignKeys, func(foreignKey foreignKey) bool {
+			return foreignKey.name == definition.name
+		})
+		table.foreignKeys[index] = definition
+
+		alter := "ALTER TABLE " + quote(dialect, tableName)
+
+		switch dialect {
+		case sqliteDialect:
+			return table.rebuild(dialect)
+		case mysqlDialect:
+			return []string{
+				alter + " DROP FOREIGN KEY " + quote(dialect, definition.name),
+				alter + " ADD " + definition.sql(dialect),
+			}
+		default:
+			return []string{
+				alter + " DROP CONSTRAINT " + quote(dialect, definition.name),
+				alter + " ADD " + definition.sql(dialect),
+			}
+		}
+	}
+}
+
+func createIndex(tableName string, definition index) operation {
+	return func(s *schema, dialect string) []string {
+		table := s.table(tableName)
+		table.indexes = append(table.indexes, definition)
+
+		return []string{definition.sql(dialect, tableName)}
+	}
+}
@@ -0,0 +1,294 @@
+// Package migrations creates and upgrades the MLflow schema of the SQL stores.
+//
+// The revisions are the ones of the alembic migrations of MLflow, so that a database
+// can be shared with the Python stores and upgraded by `mlflow db upgrade`.
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var (
+	ErrMissingRevision  = errors.New("the database contains MLflow tables but no alembic revision")
+	ErrOutdatedRevision = errors.New("detected out-of-date database schema")
+	ErrUnknownRevision  = errors.New("detected unknown database schema")
+)
+
+type alembicVersionTable struct {
+	VersionNum string `gorm:"column:version_num;size:32;primaryKey"`
+}
+
+func (alembicVersionTable) TableName() string {
+	return "alembic_version"
+}
+
+func currentRevision(database *gorm.DB) (string, error) {
+	if !database.Migrator().HasTable(&alembicVersionTable{}) {
+		return "", nil
+	}
+
+	var versions []alembicVersionTable
+	if err := database.Find(&versions).Error; err != nil {
+		return "", fmt.Errorf("failed to read the alembic revision: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return "", nil
+	}
+
+	return versions[0].VersionNum, nil
+}
+
+func stampRevision(database *gorm.DB, revision string) error {
+	if err := database.Where("1 = 1").Delete(&alembicVersionTable{}).Error; err != nil {
+		return fmt.Errorf("failed to clear the alembic revision: %w", err)
+	}
+
+	if err := database.Create(&alembicVersionTable{VersionNum: revision}).Error; err != nil {
+		return fmt.Errorf("failed to record the alembic revision: %w", err)
+	}
+
+	return nil
+}
+
+// hasTables reports whether the database contains tables of the MLflow schema.
+func hasTables(database *gorm.DB) bool {
+	for _, table := range schemaAt(HeadRevision).tables {
+		if database.Migrator().HasTable(table.name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func execute(database *gorm.DB, statements []string) error {
+	for _, statement := range statements {
+		if err := database.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to execute %q: %w", statement, err)
+		}
+	}
+
+	return nil
+}
+
+// createSchema creates the tables at the head revision, as they result from the DDL of all the revisions.
+func createSchema(database *gorm.DB) error {
+	if hasTables(database) {
+		return ErrMissingRevision
+	}
+
+	dialect := database.Dialector.Name()
+	statements := []string{
+		fmt.Sprintf(
+			"CREATE TABLE %s (%s VARCHAR(32) NOT NULL, CONSTRAINT %s PRIMARY KEY (%s))",
+			quote(dialect, "alembic_version"), quote(dialect, "version_num"),
+			quote(dialect, "alembic_version_pkc"), quote(dialect, "version_num"),
+		),
+	}
+
+	for _, table := range schemaAt(HeadRevision).tables {
+		statements = append(statements, table.create(dialect, table.name)...)
+	}
+
+	if err := execute(database, statements); err != nil {
+		return err
+	}
+
+	return stampRevision(database, HeadRevision)
+}
+
+func outdatedRevisionError(current string) error {
+	return fmt.Errorf(
+		"%w (found version %s, but expected %s), take a backup of your database,"+
+			" then run 'mlflow db upgrade <database_uri>' to migrate your database to the latest schema",
+		ErrOutdatedRevision, current, HeadRevision,
+	)
+}
+
+func unknownRevisionError(current, reason string) error {
+	return fmt.Errorf(
+		"%w (found version %s, but expected %s), it was likely created by a newer version of MLflow"+
+			" which the Go backend does not support yet: %s",
+		ErrUnknownRevision, current, HeadRevision, reason,
+	)
+}
+
+// checkNewerSchema verifies that the schema of a database at a revision unknown to the Go backend,
+// likely a newer one, still fits the Go models: the tables and columns of HeadRevision are all there
+// and the columns added since then can be left out of the inserts.
+func checkNewerSchema(database *gorm.DB, current string) error {
+	for _, table := range schemaAt(HeadRevision).tables {
+		if !database.Migrator().HasTable(table.name) {
+			return unknownRevisionError(current, fmt.Sprintf("the table %s is missing", table.name))
+		}
+
+		columnTypes, err := database.Migrator().ColumnTypes(table.name)
+		if err != nil {
+			return fmt.Errorf("failed to read the columns of the table %s: %w", table.name, err)
+		}
+
+		for _, column := range table.columns {
+			if !slices.ContainsFunc(columnTypes, func(columnType gorm.ColumnType) bool {
+				return columnType.Name() == column.name
+			}) {
+				return unknownRevisionError(current, fmt.Sprintf("the column %s.%s is missing", table.name, column.name))
+			}
+		}
+
+		for _, columnType := range columnTypes {
+			if slices.ContainsFunc(table.columns, func(column column) bool {
+				return column.name == columnType.Name()
+			}) {
+				continue
+			}
+
+			nullable, _ := columnType.Nullable()
+			_, hasDefault := columnType.DefaultValue()
+
+			if !nullable && !hasDefault {
+				return unknownRevisionError(
+					current, fmt.Sprintf("the new column %s.%s requires a value", table.name, columnType.Name()),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pendingRevisions returns the revisions to apply on top of the current one.
+func pendingRevisions(current string) ([]revision, error) {
+	index := slices.IndexFunc(revisions, func(revision revision) bool {
+		return revision.id == current
+	})
+	if index == -1 {
+		return nil, unknownRevisionError(current, "the revision is not one of the Go migrations")
+	}
+
+	pending := revisions[index+1:]
+	for _, revision := range pending {
+		if revision.dataMigration {
+			return nil, outdatedRevisionError(current)
+		}
+	}
+
+	return pending, nil
+}
+
+// acceptNewerRevision reports whether a database at a revision unknown to the Go backend can be used as is,
+// its schema being a superset of the one of HeadRevision.
+func acceptNewerRevision(ctx context.Context, database *gorm.DB, current string) (bool, error) {
+	if slices.ContainsFunc(revisions, func(revision revision) bool {
+		return revision.id == current
+	}) {
+		return false, nil
+	}
+
+	if err := checkNewerSchema(database, current); err != nil {
+		return false, err
+	}
+
+	utils.GetLoggerFromContext(ctx).Warnf(
+		"The database schema is at revision %s, unknown to the Go backend which is written for %s,"+
+			" its tables still match the Go models",
+		current, HeadRevision,
+	)
+
+	return true, nil
+}
+
+// Check verifies that the schema of a database is at HeadRevision, without changing it.
+// A database without MLflow tables passes, its schema is yet to be created by Migrate or the Python store.
+// So does a database at a newer revision whose schema still contains the tables and columns of HeadRevision.
+func Check(ctx context.Context, database *gorm.DB) error {
+	database = database.WithContext(ctx)
+
+	current, err := currentRevision(database)
+	if err != nil {
+		return err
+	}
+
+	switch current {
+	case HeadRevision:
+		return nil
+	case "":
+		if hasTables(database) {
+			return ErrMissingRevision
+		}
+
+		return nil
+	}
+
+	if accepted, err := acceptNewerRevision(ctx, database, current); accepted || err != nil {
+		return err
+	}
+
+	if _, err := pendingRevisions(current); err != nil {
+		return err
+	}
+
+	return fmt.Errorf(
+		"%w (found version %s, but expected %s), take a backup of your database,"+
+			" then migrate it with 'mlflow db upgrade <database_uri>' or by starting the Go server with --migrate-database",
+		ErrOutdatedRevision, current, HeadRevision,
+	)
+}
+
+// Migrate creates the schema of an empty database and upgrades the schema of a database
+// created at a revision the Go backend knows how to upgrade from, by applying the DDL of the pending revisions.
+// A database at a newer revision accepted by Check is left as is.
+// It fails for the other revisions, the Go models would not match the tables.
+func Migrate(ctx context.Context, database *gorm.DB) error {
+	logger := utils.GetLoggerFromContext(ctx)
+
+	if err := database.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		current, err := currentRevision(transaction)
+		if err != nil {
+			return err
+		}
+
+		switch current {
+		case HeadRevision:
+			return nil
+		case "":
+			logger.Infof("Creating the database schema at revision %s", HeadRevision)
+
+			return createSchema(transaction)
+		}
+
+		if accepted, err := acceptNewerRevision(ctx, transaction, current); accepted || err != nil {
+			return err
+		}
+
+		pending, err := pendingRevisions(current)
+		if err != nil {
+			return err
+		}
+
+		model := schemaAt(current)
+
+		for _, revision := range pending {
+			logger.Infof("Upgrading the database schema to revision %s", revision.id)
+
+			for _, operation := range revision.operations {
+				if err := execute(transaction, operation(model, transaction.Dialector.Name())); err != nil {
+					return fmt.Errorf("failed to upgrade the database schema to revision %s: %w", revision.id, err)
+				}
+			}
+		}
+
+		return stampRevision(transaction, HeadRevision)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate the database schema: %w", err)
+	}
+
+	return nil
+}
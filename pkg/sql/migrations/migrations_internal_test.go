@@ -0,0 +1,218 @@
+package migrations
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	// The database does not need to survive a crash, not syncing it to disk keeps the tests fast.
+	database, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "mlflow.db")+"?_synchronous=OFF"), &gorm.Config{})
+	require.NoError(t, err)
+
+	return database
+}
+
+// createSchemaAt creates the tables of a revision, like a database created by an earlier version of MLflow.
+func createSchemaAt(t *testing.T, database *gorm.DB, revision string) {
+	t.Helper()
+
+	require.NoError(t, database.Transaction(func(transaction *gorm.DB) error {
+		for _, table := range schemaAt(revision).tables {
+			if err := execute(transaction, table.create(sqliteDialect, table.name)); err != nil {
+				return err
+			}
+		}
+
+		if err := transaction.Migrator().CreateTable(&alembicVersionTable{}); err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		return stampRevision(transaction, revision)
+	}))
+}
+
+// describeSchema returns the columns, keys and indexes of the tables of a database.
+func describeSchema(t *testing.T, database *gorm.DB) map[string][]map[string]any {
+	t.Helper()
+
+	description := map[string][]map[string]any{}
+
+	for _, table := range schemaAt(HeadRevision).tables {
+		for _, pragma := range []string{"table_info", "foreign_key_list", "index_list"} {
+			var rows []map[string]any
+			require.NoError(t, database.Raw("SELECT * FROM pragma_"+pragma+"(?)", table.name).Scan(&rows).Error)
+
+			description[table.name+" "+pragma] = rows
+		}
+	}
+
+	return description
+}
+
+func TestMigrateFreshDatabase(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	database := newTestDatabase(t)
+
+	require.NoError(t, Check(ctx, database), "the schema of an empty database is yet to be created")
+	require.NoError(t, Migrate(ctx, database))
+
+	revision, err := currentRevision(database)
+	require.NoError(t, err)
+	assert.Equal(t, HeadRevision, revision)
+
+	for _, table := range schemaAt(HeadRevision).tables {
+		assert.True(t, database.Migrator().HasTable(table.name))
+	}
+
+	for table, index := range map[string]string{
+		"metrics":    "index_metrics_run_uuid",
+		"trace_tags": "index_trace_tags_request_id",
+		"datasets":   "index_datasets_dataset_uuid",
+	} {
+		assert.True(t, database.Migrator().HasIndex(table, index), index)
+	}
+
+	// Migrating a database at the head revision is a no-op.
+	require.NoError(t, Migrate(ctx, database))
+	require.NoError(t, Check(ctx, database))
+}
+
+func TestMigrateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	expected := newTestDatabase(t)
+	require.NoError(t, Migrate(context.Background(), expected))
+
+	// The revisions after the last data migration can be upgraded from.
+	for _, revision := range revisions[5 : len(revisions)-1] {
+		t.Run(revision.id, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			database := newTestDatabase(t)
+			createSchemaAt(t, database, revision.id)
+
+			require.ErrorIs(t, Check(ctx, database), ErrOutdatedRevision)
+			require.NoError(t, Migrate(ctx, database))
+
+			current, err := currentRevision(database)
+			require.NoError(t, err)
+			assert.Equal(t, HeadRevision, current)
+			assert.Equal(t, describeSchema(t, expected), describeSchema(t, database))
+		})
+	}
+}
+
+func TestMigrateUnsupportedRevisions(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		name     string
+		revision string
+		expected error
+	}{
+		{
+			name:     "Older",
+			revision: "7ac759974ad8",
+			expected: ErrOutdatedRevision,
+		},
+		{
+			name:     "Missing",
+			expected: ErrMissingRevision,
+		},
+	}
+
+	for _, testData := range testData {
+		t.Run(testData.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			database := newTestDatabase(t)
+
+			require.NoError(t, Migrate(ctx, database))
+
+			if testData.revision == "" {
+				require.NoError(t, database.Migrator().DropTable(&alembicVersionTable{}))
+			} else {
+				require.NoError(t, stampRevision(database, testData.revision))
+			}
+
+			require.ErrorIs(t, Check(ctx, database), testData.expected)
+			require.ErrorIs(t, Migrate(ctx, database), testData.expected)
+		})
+	}
+}
+
+func TestNewerRevisions(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		name       string
+		statements []string
+		expected   error
+	}{
+		{
+			name: "Compatible",
+			statements: []string{
+				"CREATE TABLE webhooks (webhook_id VARCHAR(256) NOT NULL PRIMARY KEY)",
+				"ALTER TABLE runs ADD COLUMN description TEXT",
+				"ALTER TABLE experiments ADD COLUMN workspace VARCHAR(63) NOT NULL DEFAULT 'default'",
+			},
+		},
+		{
+			name:       "MissingColumn",
+			statements: []string{"ALTER TABLE runs RENAME COLUMN name TO run_name"},
+			expected:   ErrUnknownRevision,
+		},
+		{
+			name: "RequiredColumn",
+			statements: []string{
+				"DROP TABLE experiment_tags",
+				"CREATE TABLE experiment_tags (key VARCHAR(250), value VARCHAR(5000), experiment_id INTEGER, " +
+					"workspace VARCHAR(63) NOT NULL)",
+			},
+			expected: ErrUnknownRevision,
+		},
+		{
+			name:       "MissingTable",
+			statements: []string{"DROP TABLE trace_tags"},
+			expected:   ErrUnknownRevision,
+		},
+	}
+
+	for _, testData := range testData {
+		t.Run(testData.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			database := newTestDatabase(t)
+
+			require.NoError(t, Migrate(ctx, database))
+			require.NoError(t, execute(database, testData.statements))
+			require.NoError(t, stampRevision(database, "ffffffffffff"))
+
+			if testData.expected == nil {
+				require.NoError(t, Check(ctx, database))
+				require.NoError(t, Migrate(ctx, database))
+			} else {
+				require.ErrorIs(t, Check(ctx, database), testData.expected)
+				require.ErrorIs(t, Migrate(ctx, database), testData.expected)
+			}
+
+			current, err := currentRevision(database)
+			require.NoError(t, err)
+			assert.Equal(t, "ffffffffffff", current)
+		})
+	}
+}
@@ -0,0 +1,647 @@
+package migrations
+
+// HeadRevision is the alembic revision of the MLflow schema the Go models are written for.
+const HeadRevision = "770bee3c1dc5"
+
+// revision is a revision of the MLflow alembic migrations.
+type revision struct {
+	id         string
+	operations []operation
+	// dataMigration marks the revisions which also migrate rows, the Go backend cannot upgrade a database to them.
+	dataMigration bool
+}
+
+var (
+	lifecycleStages = []string{"active", "deleted"}
+	sourceTypes     = []string{"NOTEBOOK", "JOB", "LOCAL", "UNKNOWN", "PROJECT"}
+	runStatuses     = []string{"SCHEDULED", "FAILED", "FINISHED", "RUNNING", "KILLED"}
+)
+
+// initialTables are the tables created by MLflow before the first revision.
+var initialTables = []operation{
+	createTable(table{
+		name: "experiments",
+		columns: []column{
+			{name: "experiment_id", typ: integerType, autoIncrement: true},
+			{name: "name", typ: stringType(256)},
+			{name: "artifact_location", typ: stringType(256), nullable: true},
+			{name: "lifecycle_stage", typ: stringType(32), nullable: true},
+		},
+		primaryKey: primaryKey{name: "experiment_pk", columns: []string{"experiment_id"}},
+		uniques:    [][]string{{"name"}},
+		checks:     []check{{name: "experiments_lifecycle_stage", column: "lifecycle_stage", values: lifecycleStages}},
+	}),
+	createTable(table{
+		name: "runs",
+		columns: []column{
+			{name: "run_uuid", typ: stringType(32)},
+			{name: "name", typ: stringType(250), nullable: true},
+			{name: "source_type", typ: stringType(20), nullable: true},
+			{name: "source_name", typ: stringType(500), nullable: true},
+			{name: "entry_point_name", typ: stringType(50), nullable: true},
+			{name: "user_id", typ: stringType(256), nullable: true},
+			{name: "status", typ: stringType(20), nullable: true},
+			{name: "start_time", typ: bigIntegerType, nullable: true},
+			{name: "end_time", typ: bigIntegerType, nullable: true},
+			{name: "source_version", typ: stringType(50), nullable: true},
+			{name: "lifecycle_stage", typ: stringType(20), nullable: true},
+			{name: "artifact_uri", typ: stringType(200), nullable: true},
+			{name: "experiment_id", typ: integerType, nullable: true},
+		},
+		primaryKey: primaryKey{name: "run_pk", columns: []string{"run_uuid"}},
+		checks: []check{
+			{name: "source_type", column: "source_type", values: sourceTypes},
+			{name: "status", column: "status", values: runStatuses[:4]},
+			{name: "runs_lifecycle_stage", column: "lifecycle_stage", values: lifecycleStages},
+		},
+		foreignKeys: []foreignKey{
+			{columns: []string{"experiment_id"}, table: "experiments", references: []string{"experiment_id"}},
+		},
+	}),
+	createTable(table{
+		name: "tags",
+		columns: []column{
+			{name: "key", typ: stringType(250)},
+			{name: "value", typ: stringType(250), nullable: true},
+			{name: "run_uuid", typ: stringType(32)},
+		},
+		primaryKey: primaryKey{name: "tag_pk", columns: []string{"key", "run_uuid"}},
+		foreignKeys: []foreignKey{
+			{columns: []string{"run_uuid"}, table: "runs", references: []string{"run_uuid"}},
+		},
+	}),
+	createTable(table{
+		name: "metrics",
+		columns: []column{
+			{name: "key", typ: stringType(250)},
+			{name: "value", typ: floatType},
+			{name: "timestamp", typ: bigIntegerType},
+			{name: "run_uuid", typ: stringType(32)},
+		},
+		primaryKey: primaryKey{name: "metric_pk", columns: []string{"key", "timestamp", "run_uuid"}},
+		foreignKeys: []foreignKey{
+			{columns: []string{"run_uuid"}, table: "runs", references: []string{"run_uuid"}},
+		},
+	}),
+	createTable(table{
+		name: "params",
+		columns: []column{
+			{name: "key", typ: stringType(250)},
+			{name: "value", typ: stringType(250)},
+			{name: "run_uuid", typ: stringType(32)},
+		},
+		primaryKey: primaryKey{name: "param_pk", columns: []string{"key", "run_uuid"}},
+		foreignKeys: []foreignKey{
+			{columns: []string{"run_uuid"}, table: "runs", references: []string{"run_uuid"}},
+		},
+	}),
+}
+
+// revisions lists the MLflow revisions from the oldest to HeadRevision, with their DDL.
+//
+//nolint:maintidx
+var revisions = []revision{
+	{
+		id: "451aebb31d03",
+		operations: []operation{
+			addColumn("metrics", column{name: "step", typ: bigIntegerType, serverDefault: "'0'"}),
+			replacePrimaryKey("metrics", primaryKey{
+				name: "metric_pk", columns: []string{"key", "timestamp", "step", "run_uuid", "value"},
+			}),
+		},
+	},
+	{
+		// Copies the user_id column of the runs to their mlflow.user tag.
+		id:            "90e64c465722",
+		dataMigration: true,
+	},
+	{
+		id: "181f10493468",
+		operations: []operation{
+			alterColumn("metrics", "value", doubleType, false),
+			addColumn("metrics", column{name: "is_nan", typ: booleanType, serverDefault: "'0'"}),
+			replacePrimaryKey("metrics", primaryKey{
+				name: "metric_pk", columns: []string{"key", "timestamp", "step", "run_uuid", "value", "is_nan"},
+			}),
+		},
+	},
+	{
+		id: "df50e92ffc5e",
+		operations: []operation{
+			createTable(table{
+				name: "experiment_tags",
+				columns: []column{
+					{name: "key", typ: stringType(250)},
+					{name: "value", typ: stringType(5000), nullable: true},
+					{name: "experiment_id", typ: integerType},
+				},
+				primaryKey: primaryKey{name: "experiment_tag_pk", columns: []string{"key", "experiment_id"}},
+				foreignKeys: []foreignKey{
+					{columns: []string{"experiment_id"}, table: "experiments", references: []string{"experiment_id"}},
+				},
+			}),
+		},
+	},
+	{
+		id:         "7ac759974ad8",
+		operations: []operation{alterColumn("tags", "value", stringType(5000), true)},
+	},
+	{
+		// Also fills the latest metrics from the metrics.
+		id:            "89d4b8295536",
+		dataMigration: true,
+		operations: []operation{
+			createTable(table{
+				name: "latest_metrics",
+				columns: []column{
+					{name: "key", typ: stringType(250)},
+					{name: "value", typ: doubleType},
+					{name: "timestamp", typ: bigIntegerType, nullable: true},
+					{name: "step", typ: bigIntegerType},
+					{name: "is_nan", typ: booleanType},
+					{name: "run_uuid", typ: stringType(32)},
+				},
+				primaryKey: primaryKey{name: "latest_metric_pk", columns: []string{"key", "run_uuid"}},
+				foreignKeys: []foreignKey{
+					{columns: []string{"run_uuid"}, table: "runs", references: []string{"run_uuid"}},
+				},
+			}),
+		},
+	},
+	{
+		id: "2b4d017a5e9b",
+		operations: []operation{
+			createTable(table{
+				name: "registered_models",
+				columns: []column{
+					{name: "name", typ: stringType(256)},
+					{name: "creation_time", typ: bigIntegerType, nullable: true},
+					{name: "last_updated_time", typ: bigIntegerType, nullable: true},
+					{name: "description", typ: stringType(5000), nullable: true},
+				},
+				primaryKey: primaryKey{name: "registered_model_pk", columns: []string{"name"}},
+				uniques:    [][]string{{"name"}},
+			}),
+			createTable(table{
+				name: "model_versions",
+				columns: []column{
+					{name: "name", typ: stringType(256)},
+					{name: "version", typ: integerType},
+					{name: "creation_time", typ: bigIntegerType, nullable: true},
+					{name: "last_updated_time", typ: bigIntegerType, nullable: true},
+					{name: "description", typ: stringType(5000), nullable: true},
+					{name: "user_id", typ: stringType(256), nullable: true},
+					{name: "current_stage", typ: stringType(20), nullable: true},
+					{name: "source", typ: stringType(500), nullable: true},
+					{name: "run_id", typ: stringType(32)},
+					{name: "status", typ: stringType(20), nullable: true},
+					{name: "status_message", typ: stringType(500), nullable: true},
+				},
+				primaryKey: primaryKey{name: "model_version_pk", columns: []string{"name", "version"}},
+				foreignKeys: []foreignKey{
+					{
+						columns: []string{"name"}, table: "registered_models", references: []string{"name"},
+						onUpdate: "CASCADE",
+					},
+				},
+			}),
+		},
+	},
+	{
+		id: "cfd24bdc0731",
+		operations: []operation{
+			alterColumn("runs", "status", stringType(9), true),
+			replaceCheck("runs", check{name: "status", column: "status", values: runStatuses}),
+		},
+	},
+	{
+		// Drops a duplicate of the status constraint, which only some databases had.
+		id: "0a8213491aaa",
+	},
+	{
+		id: "728d730b5ebd",
+		operations: []operation{
+			createTable(table{
+				name: "registered_model_tags",
+				columns: []column{
+					{name: "key", typ: stringType(250)},
+					{name: "value", typ: stringType(5000), nullable: true},
+					{name: "name", typ: stringType(256)},
+				},
+				primaryKey: primaryKey{name: "registered_model_tag_pk", columns: []string{"key", "name"}},
+				foreignKeys: []foreignKey{
+					{
+						columns: []string{"name"}, table: "registered_models", references: []string{"name"},
+						onUpdate: "CASCADE",
+					},
+				},
+			}),
+		},
+	},
+	{
+		id: "27a6a02d2cf1",
+		operations: []operation{
+			createTable(table{
+				name: "model_version_tags",
+				columns: []column{
+					{name: "key", typ: stringType(250)},
+					{name: "value", typ: stringType(5000), nullable: true},
+					{name: "name", typ: stringType(256)},
+					{name: "version", typ: integerType},
+				},
+				primaryKey: primaryKey{name: "model_version_tag_pk", columns: []string{"key", "name", "version"}},
+				foreignKeys: []foreignKey{
+					{
+						columns: []string{"name", "version"}, table: "model_versions", references: []string{"name", "version"},
+						onUpdate: "CASCADE",
+					},
+				},
+			}),
+		},
+	},
+	{
+		id: "84291f40a231",
+		operations: []operation{
+			addColumn("model_versions", column{name: "run_link", typ: stringType(500), nullable: true}),
+		},
+	},
+	{
+		id:         "a8c4a736bde6",
+		operations: []operation{alterColumn("model_versions", "run_id", stringType(32), true)},
+	},
+	{
+		// Adds the boolean constraint of is_nan, which SQLAlchemy 1.4.0 did not create.
+		id: "39d1c3be5f05",
+	},
+	{
+		// Resets the default of is_nan on MySQL, which 39d1c3be5f05 dropped.
+		id: "c48cb773bb87",
+	},
+	{
+		id: "bd07f7e963c5",
+		operations: []operation{
+			createIndex("params", index{name: "index_params_run_uuid", columns: []string{"run_uuid"}}),
+			createIndex("metrics", index{name: "index_metrics_run_uuid", columns: []string{"run_uuid"}}),
+			createIndex("latest_metrics", index{name: "index_latest_metrics_run_uuid", columns: []string{"run_uuid"}}),
+			createIndex("tags", index{name: "index_tags_run_uuid", columns: []string{"run_uuid"}}),
+		},
+	},
+	{
+		id: "0c779009ac13",
+		operations: []operation{
+			addColumn("runs", column{name: "deleted_time", typ: bigIntegerType, nullable: true}),
+		},
+	},
+	{
+		id:         "cc1f77228345",
+		operations: []operation{alterColumn("params", "value", stringType(500), false)},
+	},
+	{
+		id: "97727af70f4d",
+		operations: []operation{
+			addColumn("experiments", column{name: "creation_time", typ: bigIntegerType, nullable: true}),
+			addColumn("experiments", column{name: "last_update_time", typ: bigIntegerType, nullable: true}),
+		},
+	},
+	{
+		id: "3500859a5d39",
+		operations: []operation{
+			createTable(table{
+				name: "registered_model_aliases",
+				columns: []column{
+					{name: "alias", typ: stringType(256)},
+					{name: "version", typ: integerType},
+					{name: "name", typ: stringType(256)},
+				},
+				primaryKey: primaryKey{name: "registered_model_alias_pk", columns: []string{"name", "alias"}},
+				foreignKeys: []foreignKey{
+					{
+						name: "registered_model_alias_name_fkey", columns: []string{"name"},
+						table: "registered_models", references: []string{"name"},
+						onUpdate: "CASCADE", onDelete: "CASCADE",
+					},
+				},
+			}),
+		},
+	},
+	{
+		id: "7f2a7d5fae7d",
+		operations: []operation{
+			createTable(table{
+				name: "datasets",
+				columns: []column{
+					{name: "dataset_uuid", typ: stringType(36)},
+					{name: "experiment_id", typ: integerType},
+					{name: "name", typ: stringType(500)},
+					{name: "digest", typ: stringType(36)},
+					{name: "dataset_source_type", typ: stringType(36)},
+					{name: "dataset_source", typ: textType},
+					{name: "dataset_schema", typ: textType, nullable: true},
+					{name: "dataset_profile", typ: textType, nullable: true},
+				},
+				primaryKey: primaryKey{name: "dataset_pk", columns: []string{"experiment_id", "name", "digest"}},
+				foreignKeys: []foreignKey{
+					{
+						name: "fk_datasets_experiment_id_experiments", columns: []string{"experiment_id"},
+						table: "experiments", references: []string{"experiment_id"},
+					},
+				},
+				indexes: []index{
+					{name: "index_datasets_dataset_uuid", columns: []string{"dataset_uuid"}},
+					{
+						name:    "index_datasets_experiment_id_dataset_source_type",
+						columns: []string{"experiment_id", "dataset_source_type"},
+					},
+				},
+			}),
+			createTable(table{
+				name: "inputs",
+				columns: []column{
+					{name: "input_uuid", typ: stringType(36)},
+					{name: "source_type", typ: stringType(36)},
+					{name: "source_id", typ: stringType(36)},
+					{name: "destination_type", typ: stringType(36)},
+					{name: "destination_id", typ: stringType(36)},
+				},
+				primaryKey: primaryKey{
+					name: "inputs_pk", columns: []string{"source_type", "source_id", "destination_type", "destination_id"},
+				},
+				indexes: []index{
+					{name: "index_inputs_input_uuid", columns: []string{"input_uuid"}},
+					{
+						name:    "index_inputs_destination_type_destination_id_source_type",
+						columns: []string{"destination_type", "destination_id", "source_type"},
+					},
+				},
+			}),
+			createTable(table{
+				name: "input_tags",
+				columns: []column{
+					{name: "input_uuid", typ: stringType(36)},
+					{name: "name", typ: stringType(255)},
+					{name: "value", typ: stringType(500)},
+				},
+				primaryKey: primaryKey{name: "input_tags_pk", columns: []string{"input_uuid", "name"}},
+			}),
+		},
+	},
+	{
+		id:         "2d6e25af4d3e",
+		operations: []operation{alterColumn("params", "value", stringType(8000), false)},
+	},
+	{
+		id: "acf3f17fdcc7",
+		operations: []operation{
+			addColumn("model_versions", column{name: "storage_location", typ: stringType(500), nullable: true}),
+		},
+	},
+	{
+		id: "867495a8f9d4",
+		operations: []operation{
+			createTable(table{
+				name: "trace_info",
+				columns: []column{
+					{name: "request_id", typ: stringType(50)},
+					{name: "experiment_id", typ: integerType},
+					{name: "timestamp_ms", typ: bigIntegerType},
+					{name: "execution_time_ms", typ: bigIntegerType, nullable: true},
+					{name: "status", typ: stringType(50)},
+				},
+				primaryKey: primaryKey{name: "trace_info_pk", columns: []string{"request_id"}},
+				foreignKeys: []foreignKey{
+					{
+						name: "fk_trace_info_experiment_id", columns: []string{"experiment_id"},
+						table: "experiments", references: []string{"experiment_id"},
+					},
+				},
+				indexes: []index{
+					{name: "index_trace_info_experiment_id_timestamp_ms", columns: []string{"experiment_id", "timestamp_ms"}},
+				},
+			}),
+			createTable(traceChildTable("trace_tags", "trace_tag_pk", "fk_trace_tags_request_id", "")),
+			createTable(traceChildTable(
+				"trace_request_metadata", "trace_request_metadata_pk", "fk_trace_request_metadata_request_id", "",
+			)),
+		},
+	},
+	{
+		id: "5b0e9adcef9c",
+		operations: []operation{
+			replaceForeignKey("trace_tags", traceChildTable(
+				"trace_tags", "trace_tag_pk", "fk_trace_tags_request_id", "CASCADE",
+			).foreignKeys[0]),
+			replaceForeignKey("trace_request_metadata", traceChildTable(
+				"trace_request_metadata", "trace_request_metadata_pk", "fk_trace_request_metadata_request_id", "CASCADE",
+			).foreignKeys[0]),
+		},
+	},
+	{
+		id:         "4465047574b1",
+		operations: []operation{alterColumn("datasets", "dataset_schema", mediumTextType, true)},
+	},
+	{
+		id:         "f5a4f2784254",
+		operations: []operation{alterColumn("tags", "value", stringType(8000), true)},
+	},
+	{
+		id: "0584bdc529eb",
+		operations: []operation{
+			replaceForeignKey("datasets", foreignKey{
+				name: "fk_datasets_experiment_id_experiments", columns: []string{"experiment_id"},
+				table: "experiments", references: []string{"experiment_id"}, onDelete: "CASCADE",
+			}),
+		},
+	},
+	{
+		id: "400f98739977",
+		operations: []operation{
+			createTable(table{
+				name: "logged_models",
+				columns: []column{
+					{name: "model_id", typ: stringType(36)},
+					{name: "experiment_id", typ: integerType},
+					{name: "name", typ: stringType(500)},
+					{name: "artifact_location", typ: stringType(1000)},
+					{name: "creation_timestamp_ms", typ: bigIntegerType},
+					{name: "last_updated_timestamp_ms", typ: bigIntegerType},
+					{name: "status", typ: integerType},
+					{name: "lifecycle_stage", typ: stringType(32), nullable: true},
+					{name: "model_type", typ: stringType(500), nullable: true},
+					{name: "source_run_id", typ: stringType(32), nullable: true},
+					{name: "status_message", typ: stringType(1000), nullable: true},
+				},
+				primaryKey: primaryKey{name: "logged_models_pk", columns: []string{"model_id"}},
+				checks: []check{
+					{name: "logged_models_lifecycle_stage_check", column: "lifecycle_stage", values: lifecycleStages},
+				},
+				foreignKeys: []foreignKey{
+					{
+						name: "fk_logged_models_experiment_id", columns: []string{"experiment_id"},
+						table: "experiments", references: []string{"experiment_id"}, onDelete: "CASCADE",
+					},
+				},
+			}),
+			createTable(table{
+				name: "logged_model_metrics",
+				columns: []column{
+					{name: "model_id", typ: stringType(36)},
+					{name: "metric_name", typ: stringType(500)},
+					{name: "metric_timestamp_ms", typ: bigIntegerType},
+					{name: "metric_step", typ: bigIntegerType},
+					{name: "metric_value", typ: doubleType, nullable: true},
+					{name: "experiment_id", typ: integerType},
+					{name: "run_id", typ: stringType(32)},
+					{name: "dataset_uuid", typ: stringType(36), nullable: true},
+					{name: "dataset_name", typ: stringType(500), nullable: true},
+					{name: "dataset_digest", typ: stringType(36), nullable: true},
+				},
+				primaryKey: primaryKey{
+					name:    "logged_model_metrics_pk",
+					columns: []string{"model_id", "metric_name", "metric_timestamp_ms", "metric_step", "run_id"},
+				},
+				foreignKeys: []foreignKey{
+					{
+						name: "fk_logged_model_metrics_model_id", columns: []string{"model_id"},
+						table: "logged_models", references: []string{"model_id"}, onDelete: "CASCADE",
+					},
+					{
+						name: "fk_logged_model_metrics_experiment_id", columns: []string{"experiment_id"},
+						table: "experiments", references: []string{"experiment_id"},
+					},
+					{
+						name: "fk_logged_model_metrics_run_id", columns: []string{"run_id"},
+						table: "runs", references: []string{"run_uuid"}, onDelete: "CASCADE",
+					},
+				},
+				indexes: []index{
+					{name: "index_logged_model_metrics_model_id", columns: []string{"model_id"}},
+				},
+			}),
+			createTable(loggedModelChildTable("logged_model_params", "param_key", "param_value")),
+			createTable(loggedModelChildTable("logged_model_tags", "tag_key", "tag_value")),
+		},
+	},
+	{
+		id: "6953534de441",
+		operations: []operation{
+			addColumn("inputs", column{name: "step", typ: bigIntegerType, serverDefault: "'0'"}),
+		},
+	},
+	{
+		id:         "bda7b8c39065",
+		operations: []operation{alterColumn("model_version_tags", "value", textType, true)},
+	},
+	{
+		id: "cbc13b556ace",
+		operations: []operation{
+			addColumn("trace_info", column{name: "client_request_id", typ: stringType(50), nullable: true}),
+			addColumn("trace_info", column{name: "request_preview", typ: stringType(1000), nullable: true}),
+			addColumn("trace_info", column{name: "response_preview", typ: stringType(1000), nullable: true}),
+		},
+	},
+	{
+		id: HeadRevision,
+		operations: []operation{
+			createTable(table{
+				name: "assessments",
+				columns: []column{
+					{name: "assessment_id", typ: stringType(50)},
+					{name: "trace_id", typ: stringType(50)},
+					{name: "name", typ: stringType(250)},
+					{name: "assessment_type", typ: stringType(20)},
+					{name: "value", typ: textType},
+					{name: "error", typ: textType, nullable: true},
+					{name: "created_timestamp", typ: bigIntegerType},
+					{name: "last_updated_timestamp", typ: bigIntegerType},
+					{name: "source_type", typ: stringType(50)},
+					{name: "source_id", typ: stringType(250), nullable: true},
+					{name: "run_id", typ: stringType(32), nullable: true},
+					{name: "span_id", typ: stringType(50), nullable: true},
+					{name: "rationale", typ: textType, nullable: true},
+					{name: "overrides", typ: stringType(50), nullable: true},
+					{name: "valid", typ: booleanType},
+					{name: "assessment_metadata", typ: textType, nullable: true},
+				},
+				primaryKey: primaryKey{name: "assessments_pk", columns: []string{"assessment_id"}},
+				foreignKeys: []foreignKey{
+					{
+						name: "fk_assessments_trace_id", columns: []string{"trace_id"},
+						table: "trace_info", references: []string{"request_id"}, onDelete: "CASCADE",
+					},
+				},
+				indexes: []index{
+					{name: "index_assessments_trace_id_created_timestamp", columns: []string{"trace_id", "created_timestamp"}},
+				},
+			}),
+		},
+	},
+}
+
+// traceChildTable returns the definition of the trace_tags and trace_request_metadata tables.
+func traceChildTable(name, primaryKeyName, foreignKeyName, onDelete string) table {
+	return table{
+		name: name,
+		columns: []column{
+			{name: "key", typ: stringType(250)},
+			{name: "value", typ: stringType(8000), nullable: true},
+			{name: "request_id", typ: stringType(50)},
+		},
+		primaryKey: primaryKey{name: primaryKeyName, columns: []string{"key", "request_id"}},
+		foreignKeys: []foreignKey{
+			{
+				name: foreignKeyName, columns: []string{"request_id"},
+				table: "trace_info", references: []string{"request_id"}, onDelete: onDelete,
+			},
+		},
+		indexes: []index{{name: "index_" + name + "_request_id", columns: []string{"request_id"}}},
+	}
+}
+
+// loggedModelChildTable returns the definition of the logged_model_params and logged_model_tags tables.
+func loggedModelChildTable(name, keyColumn, valueColumn string) table {
+	return table{
+		name: name,
+		columns: []column{
+			{name: "model_id", typ: stringType(36)},
+			{name: "experiment_id", typ: integerType},
+			{name: keyColumn, typ: stringType(255)},
+			{name: valueColumn, typ: textType},
+		},
+		primaryKey: primaryKey{name: name + "_pk", columns: []string{"model_id", keyColumn}},
+		foreignKeys: []foreignKey{
+			{
+				name: "fk_" + name + "_model_id", columns: []string{"model_id"},
+				table: "logged_models", references: []string{"model_id"}, onDelete: "CASCADE",
+			},
+			{
+				name: "fk_" + name + "_experiment_id", columns: []string{"experiment_id"},
+				table: "experiments", references: []string{"experiment_id"},
+			},
+		},
+	}
+}
+
+// schemaAt returns the model of the schema at a revision, the empty one being the initial tables.
+func schemaAt(revisionID string) *schema {
+	model := &schema{}
+
+	for _, operation := range initialTables {
+		operation(model, sqliteDialect)
+	}
+
+	if revisionID == "" {
+		return model
+	}
+
+	for _, revision := range revisions {
+		for _, operation := range revision.operations {
+			operation(model, sqliteDialect)
+		}
+
+		if revision.id == revisionID {
+			break
+		}
+	}
+
+	return model
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"runtime"
 	"strings"
+	"sync"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
@@ -14,16 +15,16 @@ import (
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql/migrations"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 var (
-	errSqliteMemory             = errors.New("go implementation does not support :memory: for sqlite")
 	errSqliteQueryParamsWindows = errors.New("query parameters are not supported on Windows")
 	errInUseConnections         = errors.New("there are still in use connections")
-	errNoSchemeDetected         = errors.New("no database schema was found," +
-		" we suspect you might be trying to use the file store which is not yet implemented." +
-		" Please pass a '--backend-store-uri' argument pointing to a database")
+	errNoSchemeDetected         = errors.New("no database scheme was found in the store URI," +
+		" please pass a '--backend-store-uri' argument pointing to a database or a local directory")
 )
 
 //nolint:ireturn
@@ -44,10 +45,6 @@ func getDialector(uri *url.URL) (gorm.Dialector, error) {
 		uri.Path = uri.Path[1:]
 		dsn := uri.String()
 
-		if uri.Path == ":memory:" {
-			return nil, errSqliteMemory
-		}
-
 		if runtime.GOOS == "windows" {
 			if uri.RawQuery != "" {
 				return nil, errSqliteQueryParamsWindows
@@ -80,9 +77,72 @@ func initSqlite(database *gorm.DB) error {
 	return nil
 }
 
-func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
+// memoryDatabase is the database of the sqlite:///:memory: store URIs.
+// SQLite drops an in-memory database with its last connection,
+// so all the stores of the process share a single connection that is kept open until the last one is closed.
+var memoryDatabase struct {
+	sync.Mutex
+	db    *gorm.DB
+	users int
+}
+
+func openDatabase(ctx context.Context, dialector gorm.Dialector) (*gorm.DB, error) {
 	logger := utils.GetLoggerFromContext(ctx)
 
+	database, err := gorm.Open(dialector, &gorm.Config{
+		TranslateError: true,
+		Logger:         NewLoggerAdaptor(logger, LoggerAdaptorConfig{IgnoreRecordNotFoundError: true}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := database.Use(tracingPlugin{}); err != nil {
+		return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+
+	if dialector.Name() == "sqlite" {
+		if err := initSqlite(database); err != nil {
+			return nil, err
+		}
+	}
+
+	return database, nil
+}
+
+func openMemoryDatabase(ctx context.Context) (*gorm.DB, error) {
+	memoryDatabase.Lock()
+	defer memoryDatabase.Unlock()
+
+	if memoryDatabase.db == nil {
+		database, err := openDatabase(ctx, sqlite.Open(":memory:"))
+		if err != nil {
+			return nil, err
+		}
+
+		sqlDB, err := database.DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get database instance: %w", err)
+		}
+
+		// Never close the connection, it holds the whole database.
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+		sqlDB.SetConnMaxIdleTime(0)
+
+		if err := migrations.Migrate(ctx, database); err != nil {
+			return nil, errors.Join(err, sqlDB.Close())
+		}
+
+		memoryDatabase.db = database
+	}
+
+	memoryDatabase.users++
+
+	return memoryDatabase.db, nil
+}
+
+func openStoreDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
 	uri, err := url.Parse(storeURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse store URL %q: %w", storeURL, err)
@@ -93,24 +153,72 @@ func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
 		return nil, err
 	}
 
-	database, err := gorm.Open(dialector, &gorm.Config{
-		TranslateError: true,
-		Logger:         NewLoggerAdaptor(logger, LoggerAdaptorConfig{IgnoreRecordNotFoundError: true}),
-	})
+	database, err := openDatabase(ctx, dialector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database %q: %w", uri.String(), err)
 	}
 
-	if dialector.Name() == "sqlite" {
-		if err := initSqlite(database); err != nil {
-			return nil, err
-		}
+	return database, nil
+}
+
+// NewDatabase opens the database of a store, after checking that its schema is the one of the Go models.
+// The schema is not changed, other than the one of an in-memory database which is created on its first use.
+func NewDatabase(ctx context.Context, storeURL string) (*gorm.DB, error) {
+	if config.IsInMemoryStoreURI(storeURL) {
+		return openMemoryDatabase(ctx)
+	}
+
+	database, err := openStoreDatabase(ctx, storeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrations.Check(ctx, database); err != nil {
+		return nil, errors.Join(err, CloseDatabase(database))
 	}
 
 	return database, nil
 }
 
+// MigrateDatabase creates or upgrades the schema of the database of a store.
+func MigrateDatabase(ctx context.Context, storeURL string) error {
+	if config.IsInMemoryStoreURI(storeURL) {
+		return nil
+	}
+
+	database, err := openStoreDatabase(ctx, storeURL)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(migrations.Migrate(ctx, database), CloseDatabase(database))
+}
+
+// releaseMemoryDatabase reports whether the database can be closed,
+// which is the case of the in-memory database only once its last user released it.
+func releaseMemoryDatabase(gormDatabase *gorm.DB) bool {
+	memoryDatabase.Lock()
+	defer memoryDatabase.Unlock()
+
+	if gormDatabase != memoryDatabase.db {
+		return true
+	}
+
+	memoryDatabase.users--
+	if memoryDatabase.users > 0 {
+		return false
+	}
+
+	memoryDatabase.db = nil
+
+	return true
+}
+
 func CloseDatabase(gormDatabase *gorm.DB) error {
+	if !releaseMemoryDatabase(gormDatabase) {
+		return nil
+	}
+
 	database, err := gormDatabase.DB()
 	if err != nil {
 		return fmt.Errorf("error while getting database connection: %w", err)
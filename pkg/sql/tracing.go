@@ -0,0 +1,87 @@
+package sql
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	tracerName = "github.com/mlflow/mlflow-go-backend/pkg/sql"
+	// parentContextKey holds the context of a statement before its span was started.
+	parentContextKey = "mlflow:tracing:parent"
+)
+
+// tracingPlugin records a span for every statement, in the trace of the context of the statement.
+type tracingPlugin struct{}
+
+func (tracingPlugin) Name() string {
+	return "mlflow:tracing"
+}
+
+func startSpan(operation string) func(*gorm.DB) {
+	return func(database *gorm.DB) {
+		ctx := database.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		database.InstanceSet(parentContextKey, ctx)
+
+		database.Statement.Context, _ = otel.Tracer(tracerName).Start(
+			ctx,
+			"sql."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemKey.String(database.Dialector.Name())),
+		)
+	}
+}
+
+func endSpan(database *gorm.DB) {
+	span := trace.SpanFromContext(database.Statement.Context)
+
+	if parent, ok := database.InstanceGet(parentContextKey); ok {
+		database.Statement.Context, _ = parent.(context.Context)
+	}
+
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(
+		semconv.DBQueryText(database.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", database.Statement.RowsAffected),
+	)
+
+	if database.Error != nil && !errors.Is(database.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(database.Error)
+		span.SetStatus(codes.Error, database.Error.Error())
+	}
+
+	span.End()
+}
+
+func (tracingPlugin) Initialize(database *gorm.DB) error {
+	callback := database.Callback()
+
+	return errors.Join(
+		callback.Create().Before("gorm:create").Register("mlflow:tracing:before_create", startSpan("create")),
+		callback.Create().After("gorm:create").Register("mlflow:tracing:after_create", endSpan),
+		callback.Query().Before("gorm:query").Register("mlflow:tracing:before_query", startSpan("query")),
+		callback.Query().After("gorm:query").Register("mlflow:tracing:after_query", endSpan),
+		callback.Update().Before("gorm:update").Register("mlflow:tracing:before_update", startSpan("update")),
+		callback.Update().After("gorm:update").Register("mlflow:tracing:after_update", endSpan),
+		callback.Delete().Before("gorm:delete").Register("mlflow:tracing:before_delete", startSpan("delete")),
+		callback.Delete().After("gorm:delete").Register("mlflow:tracing:after_delete", endSpan),
+		callback.Row().Before("gorm:row").Register("mlflow:tracing:before_row", startSpan("row")),
+		callback.Row().After("gorm:row").Register("mlflow:tracing:after_row", endSpan),
+		callback.Raw().Before("gorm:raw").Register("mlflow:tracing:before_raw", startSpan("raw")),
+		callback.Raw().After("gorm:raw").Register("mlflow:tracing:after_raw", endSpan),
+	)
+}
@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+)
+
+// headerCarrier reads the trace context from the request headers,
+// and writes it to them for the requests forwarded to the Python server.
+type headerCarrier struct {
+	c *fiber.Ctx
+}
+
+func (h headerCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h headerCarrier) Set(key, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+func (h headerCarrier) Keys() []string {
+	headers := h.c.GetReqHeaders()
+	keys := make([]string, 0, len(headers))
+
+	for key := range headers {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// statusCode returns the status code of a request, the errors of the routes are only sent after the middlewares ran.
+func statusCode(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+
+	var contractErr *contract.Error
+	if errors.As(err, &contractErr) {
+		return contractErr.StatusCode()
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+
+	return fiber.StatusInternalServerError
+}
+
+// NewMiddleware records the span of every request, continuing the trace of its traceparent header.
+// The span becomes the parent of the one of the Python server for the forwarded requests.
+func NewMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		carrier := headerCarrier{c}
+		propagator := otel.GetTextMapPropagator()
+		method := utils.CopyString(c.Method())
+
+		ctx, span := tracer().Start(
+			propagator.Extract(c.UserContext(), carrier),
+			method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.URLPath(utils.CopyString(c.Path())),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		propagator.Inject(ctx, carrier)
+
+		err := c.Next()
+
+		route := c.Route().Path
+		status := statusCode(c, err)
+
+		span.SetName(method + " " + route)
+		span.SetAttributes(semconv.HTTPRoute(route), semconv.HTTPResponseStatusCode(status))
+
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, utils.StatusMessage(status))
+		}
+
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	}
+}
+
+// StartRouteSpan starts the span of a route handler, which becomes the parent of the spans of the request.
+//
+//nolint:ireturn
+func StartRouteSpan(c *fiber.Ctx, name string) trace.Span {
+	ctx, span := tracer().Start(c.UserContext(), name)
+	c.SetUserContext(ctx)
+
+	return span
+}
+
+// CallService calls a service method in its own span, which records the error of the method.
+func CallService[I, O any](
+	ctx context.Context, name string, method func(context.Context, I) (O, *contract.Error), input I,
+) (O, *contract.Error) {
+	ctx, span := tracer().Start(ctx, name)
+	defer span.End()
+
+	output, err := method(ctx, input)
+	if err != nil {
+		span.SetAttributes(attribute.String("mlflow.error_code", err.Code.String()))
+		span.SetStatus(codes.Error, err.Message)
+	}
+
+	return output, err
+}
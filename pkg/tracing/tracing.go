@@ -0,0 +1,98 @@
+// Package tracing records the OpenTelemetry spans of the requests, of their route handlers
+// and of the service methods they call. The spans of the SQL statements are recorded by pkg/sql.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+)
+
+const tracerName = "github.com/mlflow/mlflow-go-backend/pkg/tracing"
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+var errUnknownExporter = errors.New("unknown tracing exporter")
+
+//nolint:ireturn
+func newExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, func() error, error) {
+	switch cfg.Exporter {
+	case "otlp":
+		options := make([]otlptracehttp.Option, 0, 1)
+		if cfg.Endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, options...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+
+		return exporter, func() error { return nil }, nil
+	case "file":
+		file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644) //nolint:mnd
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open trace file %q: %w", cfg.File, err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, nil, errors.Join(fmt.Errorf("failed to create file exporter: %w", err), file.Close())
+		}
+
+		return exporter, file.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("%w %q, expected otlp or file", errUnknownExporter, cfg.Exporter)
+	}
+}
+
+// Setup installs the W3C trace context propagator, so incoming traces continue through the server
+// and the Python one, and the tracer provider of the configured exporter.
+// The returned function flushes the recorded spans and stops the provider.
+func Setup(ctx context.Context, cfg *config.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	if cfg.Tracing.Exporter == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeExporter, err := newExporter(ctx, cfg.Tracing)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(cfg.Tracing.ServiceName),
+			semconv.ServiceVersion(cfg.Version),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		if err := provider.Shutdown(ctx); err != nil {
+			return errors.Join(fmt.Errorf("failed to shutdown tracer provider: %w", err), closeExporter())
+		}
+
+		return closeExporter()
+	}, nil
+}
@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+type exportedSpan struct {
+	Name        string
+	SpanContext struct {
+		TraceID string
+		SpanID  string
+	}
+	Parent struct {
+		SpanID string
+	}
+}
+
+func readSpans(t *testing.T, path string) map[string]exportedSpan {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	spans := make(map[string]exportedSpan)
+	decoder := json.NewDecoder(file)
+
+	for {
+		var span exportedSpan
+
+		err := decoder.Decode(&span)
+		if errors.Is(err, io.EOF) {
+			return spans
+		}
+
+		require.NoError(t, err)
+
+		spans[span.Name] = span
+	}
+}
+
+// The tracer provider and the propagator are global, the test cannot run in parallel with other ones.
+//
+//nolint:paralleltest
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+
+	database, err := sql.NewDatabase(ctx, "sqlite:///"+filepath.ToSlash(filepath.Join(t.TempDir(), "mlflow.db")))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, sql.CloseDatabase(database))
+	})
+
+	shutdown, err := Setup(ctx, &config.Config{
+		Tracing: config.TracingConfig{Exporter: "file", File: traceFile, ServiceName: "mlflow"},
+	})
+	require.NoError(t, err)
+
+	service := func(ctx context.Context, input string) (string, *contract.Error) {
+		var result string
+		if err := database.WithContext(ctx).Raw("SELECT ?", input).Scan(&result).Error; err != nil {
+			return "", contract.NewError(0, err.Error())
+		}
+
+		return result, nil
+	}
+
+	var forwardedTraceParent string
+
+	app := fiber.New()
+	app.Use(NewMiddleware())
+	app.Get("/test", func(c *fiber.Ctx) error {
+		defer StartRouteSpan(c, "test").End()
+
+		forwardedTraceParent = c.Get("traceparent")
+
+		output, err := CallService(utils.NewContextWithLoggerFromFiberContext(c), "TestService.Test", service, "output")
+		if err != nil {
+			return err
+		}
+
+		return c.SendString(output)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	require.NoError(t, shutdown(ctx))
+
+	spans := readSpans(t, traceFile)
+
+	request := spans["GET /test"]
+	route := spans["test"]
+	serviceSpan := spans["TestService.Test"]
+	query := spans["sql.row"]
+
+	for _, span := range []exportedSpan{request, route, serviceSpan, query} {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID)
+	}
+
+	assert.Equal(t, "00f067aa0ba902b7", request.Parent.SpanID)
+	assert.Equal(t, request.SpanContext.SpanID, route.Parent.SpanID)
+	assert.Equal(t, route.SpanContext.SpanID, serviceSpan.Parent.SpanID)
+	assert.Equal(t, serviceSpan.SpanContext.SpanID, query.Parent.SpanID)
+
+	// The requests forwarded to the Python server are children of the span of the request.
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-"+request.SpanContext.SpanID+"-01", forwardedTraceParent)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+var mutableAssessmentFields = []string{"assessment_name", "expectation", "feedback", "rationale", "metadata"}
+
+func assessmentToProto(assessment *entities.Assessment) (*protos.Assessment, *contract.Error) {
+	proto, err := assessment.ToProto()
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to convert assessment '%s'", assessment.AssessmentID),
+			err,
+		)
+	}
+
+	return proto, nil
+}
+
+func validateAssessmentValue(assessment *protos.Assessment) *contract.Error {
+	if assessment.GetExpectation().GetSerializedValue() != nil {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Expectations with a serialized value are not supported, the value must be JSON serializable.",
+		)
+	}
+
+	return nil
+}
+
+func (ts TrackingService) CreateAssessment(
+	ctx context.Context, input *protos.CreateAssessment,
+) (*protos.CreateAssessment_Response, *contract.Error) {
+	assessment := input.GetAssessment()
+
+	if assessment.AssessmentName == nil || assessment.Source == nil || assessment.Value == nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"Assessment must have an assessment_name, a source and either a feedback or an expectation.",
+		)
+	}
+
+	if err := validateAssessmentValue(assessment); err != nil {
+		return nil, err
+	}
+
+	created, err := ts.Store.CreateAssessment(ctx, entities.NewAssessmentFromProto(assessment))
+	if err != nil {
+		return nil, err
+	}
+
+	proto, err := assessmentToProto(created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateAssessment_Response{Assessment: proto}, nil
+}
+
+func (ts TrackingService) UpdateAssessment(
+	ctx context.Context, input *protos.UpdateAssessment,
+) (*protos.UpdateAssessment_Response, *contract.Error) {
+	assessment := input.GetAssessment()
+
+	if err := validateAssessmentValue(assessment); err != nil {
+		return nil, err
+	}
+
+	if len(input.GetUpdateMask().GetPaths()) == 0 {
+		return nil, contract.NewError(protos.ErrorCode_INVALID_PARAMETER_VALUE, "The update mask is empty.")
+	}
+
+	update := entities.AssessmentUpdate{}
+
+	for _, path := range input.GetUpdateMask().GetPaths() {
+		switch path {
+		case "assessment_name":
+			update.Name = assessment.AssessmentName
+		case "expectation", "feedback":
+			if assessment.Value == nil {
+				return nil, contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("The %s to update is missing.", path),
+				)
+			}
+
+			assessmentType, value, assessmentError := entities.NewAssessmentValueFromProto(assessment)
+			update.Type, update.Value, update.Error = &assessmentType, value, assessmentError
+		case "rationale":
+			update.Rationale = assessment.Rationale
+		case "metadata":
+			update.Metadata = assessment.GetMetadata()
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid update mask path '%s', valid paths are %v.", path, mutableAssessmentFields),
+			)
+		}
+	}
+
+	updated, err := ts.Store.UpdateAssessment(ctx, assessment.GetTraceId(), assessment.GetAssessmentId(), &update)
+	if err != nil {
+		return nil, err
+	}
+
+	proto, err := assessmentToProto(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.UpdateAssessment_Response{Assessment: proto}, nil
+}
+
+func (ts TrackingService) DeleteAssessment(
+	ctx context.Context, input *protos.DeleteAssessment,
+) (*protos.DeleteAssessment_Response, *contract.Error) {
+	if err := ts.Store.DeleteAssessment(ctx, input.GetTraceId(), input.GetAssessmentId()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteAssessment_Response{}, nil
+}
@@ -31,3 +31,37 @@ func (ts TrackingService) LogInputs(
 
 	return &protos.LogInputs_Response{}, nil
 }
+
+func (ts TrackingService) LogOutputs(
+	ctx context.Context, input *protos.LogOutputs,
+) (*protos.LogOutputs_Response, *contract.Error) {
+	modelOutputs := make([]*entities.ModelOutput, 0, len(input.GetModels()))
+	for _, m := range input.GetModels() {
+		modelOutputs = append(modelOutputs, entities.NewModelOutputFromProto(m))
+	}
+
+	if err := ts.Store.LogOutputs(ctx, input.GetRunId(), modelOutputs); err != nil {
+		return nil, err
+	}
+
+	return &protos.LogOutputs_Response{}, nil
+}
+
+func (ts TrackingService) SearchDatasets(
+	ctx context.Context, input *protos.SearchDatasets,
+) (*protos.SearchDatasets_Response, *contract.Error) {
+	summaries, err := ts.Store.SearchDatasets(ctx, input.GetExperimentIds())
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchDatasets_Response{
+		DatasetSummaries: make([]*protos.DatasetSummary, 0, len(summaries)),
+	}
+
+	for _, summary := range summaries {
+		response.DatasetSummaries = append(response.DatasetSummaries, summary.ToProto())
+	}
+
+	return &response, nil
+}
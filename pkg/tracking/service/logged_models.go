@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const loggedModelNameInvalidCharacters = `/:.%"'`
+
+func validateLoggedModelName(input *protos.CreateLoggedModel) *contract.Error {
+	if input.Name == nil {
+		return nil
+	}
+
+	if name := input.GetName(); name == "" || strings.ContainsAny(name, loggedModelNameInvalidCharacters) {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Invalid model name ('%s') provided. Model name must be a non-empty string "+
+					"and cannot contain the following characters: %s",
+				name,
+				loggedModelNameInvalidCharacters,
+			),
+		)
+	}
+
+	return nil
+}
+
+func (ts TrackingService) CreateLoggedModel(
+	ctx context.Context, input *protos.CreateLoggedModel,
+) (*protos.CreateLoggedModel_Response, *contract.Error) {
+	if err := validateLoggedModelName(input); err != nil {
+		return nil, err
+	}
+
+	loggedModel, err := ts.Store.CreateLoggedModel(
+		ctx,
+		input.GetExperimentId(),
+		input.GetName(),
+		input.GetModelType(),
+		input.GetSourceRunId(),
+		entities.NewLoggedModelParametersFromProto(input.GetParams()),
+		entities.NewLoggedModelTagsFromProto(input.GetTags()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.CreateLoggedModel_Response{
+		Model: loggedModel.ToProto(),
+	}, nil
+}
+
+func (ts TrackingService) FinalizeLoggedModel(
+	ctx context.Context, input *protos.FinalizeLoggedModel,
+) (*protos.FinalizeLoggedModel_Response, *contract.Error) {
+	loggedModel, err := ts.Store.FinalizeLoggedModel(ctx, input.GetModelId(), input.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.FinalizeLoggedModel_Response{
+		Model: loggedModel.ToProto(),
+	}, nil
+}
+
+func (ts TrackingService) GetLoggedModel(
+	ctx context.Context, input *protos.GetLoggedModel,
+) (*protos.GetLoggedModel_Response, *contract.Error) {
+	loggedModel, err := ts.Store.GetLoggedModel(ctx, input.GetModelId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.GetLoggedModel_Response{
+		Model: loggedModel.ToProto(),
+	}, nil
+}
+
+func (ts TrackingService) DeleteLoggedModel(
+	ctx context.Context, input *protos.DeleteLoggedModel,
+) (*protos.DeleteLoggedModel_Response, *contract.Error) {
+	if err := ts.Store.DeleteLoggedModel(ctx, input.GetModelId()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteLoggedModel_Response{}, nil
+}
+
+func (ts TrackingService) SearchLoggedModels(
+	ctx context.Context, input *protos.SearchLoggedModels,
+) (*protos.SearchLoggedModels_Response, *contract.Error) {
+	datasets, orderBy := entities.NewLoggedModelSearchFromProto(input)
+
+	loggedModels, nextPageToken, err := ts.Store.SearchLoggedModels(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		datasets,
+		int64(input.GetMaxResults()),
+		orderBy,
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchLoggedModels_Response{
+		Models: make([]*protos.LoggedModel, len(loggedModels)),
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, loggedModel := range loggedModels {
+		response.Models[i] = loggedModel.ToProto()
+	}
+
+	return &response, nil
+}
+
+func (ts TrackingService) SetLoggedModelTags(
+	ctx context.Context, input *protos.SetLoggedModelTags,
+) (*protos.SetLoggedModelTags_Response, *contract.Error) {
+	if err := ts.Store.SetLoggedModelTags(
+		ctx, input.GetModelId(), entities.NewLoggedModelTagsFromProto(input.GetTags()),
+	); err != nil {
+		return nil, err
+	}
+
+	loggedModel, err := ts.Store.GetLoggedModel(ctx, input.GetModelId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.SetLoggedModelTags_Response{
+		Model: loggedModel.ToProto(),
+	}, nil
+}
+
+func (ts TrackingService) DeleteLoggedModelTag(
+	ctx context.Context, input *protos.DeleteLoggedModelTag,
+) (*protos.DeleteLoggedModelTag_Response, *contract.Error) {
+	if err := ts.Store.DeleteLoggedModelTag(ctx, input.GetModelId(), input.GetTagKey()); err != nil {
+		return nil, err
+	}
+
+	return &protos.DeleteLoggedModelTag_Response{}, nil
+}
+
+func (ts TrackingService) LogLoggedModelParams(
+	ctx context.Context, input *protos.LogLoggedModelParamsRequest,
+) (*protos.LogLoggedModelParamsRequest_Response, *contract.Error) {
+	if err := ts.Store.LogLoggedModelParams(
+		ctx, input.GetModelId(), entities.NewLoggedModelParametersFromProto(input.GetParams()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &protos.LogLoggedModelParamsRequest_Response{}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 func (ts TrackingService) LogMetric(
@@ -64,3 +65,56 @@ func (ts TrackingService) GetMetricHistory(
 
 	return &response, nil
 }
+
+// MaxResultsPerRun is the default and maximum number of sampled steps per run.
+const MaxResultsPerRun = 2500
+
+func (ts TrackingService) GetMetricHistoryBulkInterval(
+	ctx context.Context, input *protos.GetMetricHistoryBulkInterval,
+) (*protos.GetMetricHistoryBulkInterval_Response, *contract.Error) {
+	var startStep, endStep *int64
+
+	switch {
+	case input.StartStep != nil && input.EndStep != nil:
+		if input.GetStartStep() > input.GetEndStep() {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"end_step must be greater than start_step. Found start_step=%d and end_step=%d.",
+					input.GetStartStep(),
+					input.GetEndStep(),
+				),
+			)
+		}
+
+		startStep = utils.PtrTo(int64(input.GetStartStep()))
+		endStep = utils.PtrTo(int64(input.GetEndStep()))
+	case input.StartStep != nil || input.EndStep != nil:
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"If either start step or end step are specified, both must be specified.",
+		)
+	}
+
+	maxResults := MaxResultsPerRun
+	if input.MaxResults != nil {
+		maxResults = int(input.GetMaxResults())
+	}
+
+	metrics, err := ts.Store.GetMetricHistoryBulkInterval(
+		ctx, input.GetRunIds(), input.GetMetricKey(), startStep, endStep, maxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.GetMetricHistoryBulkInterval_Response{
+		Metrics: make([]*protos.MetricWithRunId, len(metrics)),
+	}
+
+	for i, metric := range metrics {
+		response.Metrics[i] = metric.ToProto()
+	}
+
+	return &response, nil
+}
@@ -34,11 +34,17 @@ const (
 	Like
 	ILike
 	And
+	Or
+	Is
+	Null
 )
 
 //nolint:gochecknoglobals
 var reservedLu = map[string]TokenKind{
 	"AND":   And,
+	"OR":    Or,
+	"IS":    Is,
+	"NULL":  Null,
 	"NOT":   Not,
 	"IN":    In,
 	"LIKE":  Like,
@@ -87,6 +93,12 @@ func TokenKindString(kind TokenKind) string {
 		return "greater_equals"
 	case And:
 		return "and"
+	case Or:
+		return "or"
+	case Is:
+		return "is"
+	case Null:
+		return "null"
 	case Dot:
 		return "dot"
 	case Comma:
@@ -61,6 +61,14 @@ func TestQueries(t *testing.T) {
 			input:    "metrics.measure_a != -12.0",
 			expected: "identifier(metrics) dot identifier(measure_a) not_equals number(-12.0) eof",
 		},
+		{
+			input:    "(metrics.a > 1 OR tags.b = 'x') and params.c = 'y'",
+			expected: "open_paren identifier(metrics) dot identifier(a) greater number(1) or identifier(tags) dot identifier(b) equals string('x') close_paren and identifier(params) dot identifier(c) equals string('y') eof",
+		},
+		{
+			input:    "tags.holdout IS NULL AND metrics.auc is not null",
+			expected: "identifier(tags) dot identifier(holdout) is null and identifier(metrics) dot identifier(auc) is not null eof",
+		},
 	}
 
 	for _, sample := range samples {
@@ -55,6 +55,17 @@ func (n StringListExpr) String() string {
 	return strings.Join(items, ", ")
 }
 
+// NULL, the right side of IS NULL and IS NOT NULL.
+type NullExpr struct{}
+
+func (n NullExpr) value() interface{} {
+	return nil
+}
+
+func (n NullExpr) String() string {
+	return "NULL"
+}
+
 //-----------------------
 // Identifier Expressions
 // ----------------------
@@ -90,6 +101,8 @@ const (
 	ILike
 	In //nolint:varnamelen
 	NotIn
+	IsNull
+	IsNotNull
 )
 
 //nolint:cyclop
@@ -115,6 +128,10 @@ func (op OperatorKind) String() string {
 		return "IN"
 	case NotIn:
 		return "NOT IN"
+	case IsNull:
+		return "IS NULL"
+	case IsNotNull:
+		return "IS NOT NULL"
 	default:
 		return "UNKNOWN"
 	}
@@ -131,7 +148,73 @@ func (expr *CompareExpr) String() string {
 	return fmt.Sprintf("%s %s %s", expr.Left, expr.Operator, expr.Right)
 }
 
+func (expr *CompareExpr) expr() {}
+
 // AND.
 type AndExpr struct {
 	Exprs []*CompareExpr
 }
+
+// --------------------
+// Boolean Expressions
+// --------------------
+
+// Expr is a node of a filter, either a comparison or a boolean combination of filters.
+type Expr interface {
+	expr()
+	fmt.Stringer
+}
+
+type BooleanOperatorKind int
+
+const (
+	And BooleanOperatorKind = iota
+	Or
+)
+
+func (op BooleanOperatorKind) String() string {
+	if op == Or {
+		return "OR"
+	}
+
+	return "AND"
+}
+
+// a AND b AND c, or a OR b OR c.
+type BooleanExpr struct {
+	Operator BooleanOperatorKind
+	Exprs    []Expr
+}
+
+func (expr *BooleanExpr) expr() {}
+
+func (expr *BooleanExpr) String() string {
+	items := make([]string, 0, len(expr.Exprs))
+	for _, e := range expr.Exprs {
+		items = append(items, e.String())
+	}
+
+	return "(" + strings.Join(items, " "+expr.Operator.String()+" ") + ")"
+}
+
+// Combines the expressions with the operator, nested expressions combined with the same
+// operator are merged as the operators are associative.
+//
+//nolint:ireturn
+func newBooleanExpr(operator BooleanOperatorKind, exprs []Expr) Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+
+	merged := make([]Expr, 0, len(exprs))
+
+	for _, e := range exprs {
+		if nested, ok := e.(*BooleanExpr); ok && nested.Operator == operator {
+			merged = append(merged, nested.Exprs...)
+		} else {
+			merged = append(merged, e)
+		}
+	}
+
+	return &BooleanExpr{Operator: operator, Exprs: merged}
+}
@@ -20,7 +20,7 @@ func newParser(tokens []lexer.Token) *parser {
 }
 
 func (p *parser) currentTokenKind() lexer.TokenKind {
-	return p.tokens[p.pos].Kind
+	return p.currentToken().Kind
 }
 
 func (p *parser) hasTokens() bool {
@@ -28,16 +28,23 @@ func (p *parser) hasTokens() bool {
 }
 
 func (p *parser) printCurrentToken() string {
-	return p.tokens[p.pos].Debug()
+	return p.currentToken().Debug()
 }
 
+// currentToken returns an EOF token once the tokens are consumed.
 func (p *parser) currentToken() lexer.Token {
+	if p.pos >= len(p.tokens) {
+		return lexer.Token{Kind: lexer.EOF, Value: "EOF"}
+	}
+
 	return p.tokens[p.pos]
 }
 
 func (p *parser) advance() lexer.Token {
 	tk := p.currentToken()
-	p.pos++
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
 
 	return tk
 }
@@ -50,13 +57,19 @@ func NewParserError(format string, a ...any) *Error {
 	return &Error{message: fmt.Sprintf(format, a...)}
 }
 
+var errEndOfFilter = NewParserError("unexpected end of filter")
+
 func (e *Error) Error() string {
 	return e.message
 }
 
 func (p *parser) parseIdentifier() (Identifier, error) {
 	emptyIdentifier := Identifier{Identifier: "", Key: ""}
-	if p.hasTokens() && p.currentTokenKind() != lexer.Identifier {
+	if !p.hasTokens() {
+		return emptyIdentifier, errEndOfFilter
+	}
+
+	if p.currentTokenKind() != lexer.Identifier {
 		return emptyIdentifier, NewParserError(
 			"expected identifier, got %s",
 			p.printCurrentToken(),
@@ -90,8 +103,14 @@ func (p *parser) parseIdentifier() (Identifier, error) {
 }
 
 func (p *parser) parseOperator() (OperatorKind, error) {
+	if !p.hasTokens() {
+		return -1, errEndOfFilter
+	}
+
+	token := p.advance()
+
 	//nolint:exhaustive
-	switch p.advance().Kind {
+	switch token.Kind {
 	case lexer.Equals:
 		return Equals, nil
 	case lexer.NotEquals:
@@ -109,12 +128,16 @@ func (p *parser) parseOperator() (OperatorKind, error) {
 	case lexer.ILike:
 		return ILike, nil
 	default:
-		return -1, NewParserError("expected operator, got %s", p.printCurrentToken())
+		return -1, NewParserError("expected operator, got %s", token.Debug())
 	}
 }
 
 //nolint:ireturn
 func (p *parser) parseValue() (Value, error) {
+	if !p.hasTokens() {
+		return nil, errEndOfFilter
+	}
+
 	//nolint:exhaustive
 	switch p.currentTokenKind() {
 	case lexer.Number:
@@ -179,6 +202,28 @@ func (p *parser) parseInSetExpr(ident Identifier) (*CompareExpr, error) {
 	return &CompareExpr{Left: ident, Operator: In, Right: StringListExpr{Values: set}}, nil
 }
 
+// Parses the NULL of IS NULL and IS NOT NULL, the IS has already been consumed.
+func (p *parser) parseIsNullExpr(ident Identifier) (*CompareExpr, error) {
+	operator := IsNull
+
+	if p.currentTokenKind() == lexer.Not {
+		p.advance() // Consume the NOT
+
+		operator = IsNotNull
+	}
+
+	if p.currentTokenKind() != lexer.Null {
+		return nil, NewParserError(
+			"expected NULL after IS, got %s",
+			p.printCurrentToken(),
+		)
+	}
+
+	p.advance() // Consume the NULL
+
+	return &CompareExpr{Left: ident, Operator: operator, Right: NullExpr{}}, nil
+}
+
 func (p *parser) parseExpression() (*CompareExpr, error) {
 	ident, err := p.parseIdentifier()
 	if err != nil {
@@ -191,6 +236,10 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 		p.advance() // Consume the IN
 
 		return p.parseInSetExpr(ident)
+	case lexer.Is:
+		p.advance() // Consume the IS
+
+		return p.parseIsNullExpr(ident)
 	case lexer.Not:
 		p.advance() // Consume the NOT
 
@@ -226,26 +275,88 @@ func (p *parser) parseExpression() (*CompareExpr, error) {
 	}
 }
 
-func (p *parser) parse() (*AndExpr, error) {
-	exprs := make([]*CompareExpr, 0)
+// primary := '(' or ')' | comparison.
+//
+//nolint:ireturn
+func (p *parser) parsePrimary() (Expr, error) {
+	if p.currentTokenKind() != lexer.OpenParen {
+		return p.parseExpression()
+	}
+
+	p.advance() // Consume the OPEN_PAREN
 
-	leftExpr, err := p.parseExpression()
+	expr, err := p.parseOr()
 	if err != nil {
-		return nil, fmt.Errorf("error while parsing initial expression: %w", err)
+		return nil, err
 	}
 
-	exprs = append(exprs, leftExpr)
+	if p.currentTokenKind() != lexer.CloseParen {
+		return nil, NewParserError(
+			"expected ')', got %s",
+			p.printCurrentToken(),
+		)
+	}
+
+	p.advance() // Consume the CLOSE_PAREN
+
+	return expr, nil
+}
+
+// and := primary (AND primary)*.
+//
+//nolint:ireturn
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{left}
 
-	// While there are tokens and the next token is AND
 	for p.currentTokenKind() == lexer.And {
 		p.advance() // Consume the AND
 
-		rightExpr, err := p.parseExpression()
+		right, err := p.parsePrimary()
 		if err != nil {
 			return nil, err
 		}
 
-		exprs = append(exprs, rightExpr)
+		exprs = append(exprs, right)
+	}
+
+	return newBooleanExpr(And, exprs), nil
+}
+
+// or := and (OR and)*, AND binds tighter than OR.
+//
+//nolint:ireturn
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{left}
+
+	for p.currentTokenKind() == lexer.Or {
+		p.advance() // Consume the OR
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		exprs = append(exprs, right)
+	}
+
+	return newBooleanExpr(Or, exprs), nil
+}
+
+//nolint:ireturn
+func (p *parser) parse() (Expr, error) {
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing expression: %w", err)
 	}
 
 	if p.hasTokens() {
@@ -255,11 +366,42 @@ func (p *parser) parse() (*AndExpr, error) {
 		)
 	}
 
-	return &AndExpr{Exprs: exprs}, nil
+	return expr, nil
 }
 
-func Parse(tokens []lexer.Token) (*AndExpr, error) {
+// ParseBoolean parses a filter made of comparisons combined with AND, OR and parentheses.
+//
+//nolint:ireturn
+func ParseBoolean(tokens []lexer.Token) (Expr, error) {
 	parser := newParser(tokens)
 
 	return parser.parse()
 }
+
+// Parse parses a filter made of comparisons combined with AND only.
+func Parse(tokens []lexer.Token) (*AndExpr, error) {
+	expr, err := ParseBoolean(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr := expr.(type) {
+	case *CompareExpr:
+		return &AndExpr{Exprs: []*CompareExpr{expr}}, nil
+	case *BooleanExpr:
+		exprs := make([]*CompareExpr, 0, len(expr.Exprs))
+
+		for _, e := range expr.Exprs {
+			compareExpr, ok := e.(*CompareExpr)
+			if expr.Operator != And || !ok {
+				return nil, NewParserError("the OR operator is not supported in this filter")
+			}
+
+			exprs = append(exprs, compareExpr)
+		}
+
+		return &AndExpr{Exprs: exprs}, nil
+	default:
+		return nil, NewParserError("unexpected expression %s", expr)
+	}
+}
@@ -131,6 +131,23 @@ func TestQueries(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "tags.holdout IS NULL AND params.lr is not null",
+			expected: &parser.AndExpr{
+				Exprs: []*parser.CompareExpr{
+					{
+						Left:     parser.Identifier{"tags", "holdout"},
+						Operator: parser.IsNull,
+						Right:    parser.NullExpr{},
+					},
+					{
+						Left:     parser.Identifier{"params", "lr"},
+						Operator: parser.IsNotNull,
+						Right:    parser.NullExpr{},
+					},
+				},
+			},
+		},
 	}
 
 	for _, sample := range samples {
@@ -156,11 +173,107 @@ func TestQueries(t *testing.T) {
 	}
 }
 
+type BooleanSample struct {
+	input    string
+	expected parser.Expr
+}
+
+//nolint:funlen
+func TestBooleanQueries(t *testing.T) {
+	t.Parallel()
+
+	accuracy := &parser.CompareExpr{
+		Left:     parser.Identifier{"metrics", "accuracy"},
+		Operator: parser.Greater,
+		Right:    parser.NumberExpr{Value: 0.72},
+	}
+	loss := &parser.CompareExpr{
+		Left:     parser.Identifier{"metrics", "loss"},
+		Operator: parser.LessEquals,
+		Right:    parser.NumberExpr{Value: 0.15},
+	}
+	task := &parser.CompareExpr{
+		Left:     parser.Identifier{"tags", "task"},
+		Operator: parser.Equals,
+		Right:    parser.StringExpr{Value: "classif"},
+	}
+
+	samples := []BooleanSample{
+		{
+			input:    "(metrics.accuracy > 0.72)",
+			expected: accuracy,
+		},
+		{
+			input: "metrics.accuracy > 0.72 OR metrics.loss <= 0.15",
+			expected: &parser.BooleanExpr{
+				Operator: parser.Or,
+				Exprs:    []parser.Expr{accuracy, loss},
+			},
+		},
+		{
+			input: "metrics.accuracy > 0.72 OR metrics.loss <= 0.15 AND tags.task = 'classif'",
+			expected: &parser.BooleanExpr{
+				Operator: parser.Or,
+				Exprs: []parser.Expr{
+					accuracy,
+					&parser.BooleanExpr{Operator: parser.And, Exprs: []parser.Expr{loss, task}},
+				},
+			},
+		},
+		{
+			input: "(metrics.accuracy > 0.72 OR metrics.loss <= 0.15) AND tags.task = 'classif'",
+			expected: &parser.BooleanExpr{
+				Operator: parser.And,
+				Exprs: []parser.Expr{
+					&parser.BooleanExpr{Operator: parser.Or, Exprs: []parser.Expr{accuracy, loss}},
+					task,
+				},
+			},
+		},
+		{
+			input: "metrics.accuracy > 0.72 or (metrics.loss <= 0.15 or (tags.task = 'classif'))",
+			expected: &parser.BooleanExpr{
+				Operator: parser.Or,
+				Exprs:    []parser.Expr{accuracy, loss, task},
+			},
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := lexer.Tokenize(&currentSample.input)
+			if err != nil {
+				t.Errorf("unexpected lex error: %v", err)
+			}
+
+			ast, err := parser.ParseBoolean(tokens)
+			if err != nil {
+				t.Errorf("error parsing: %s", err)
+			}
+
+			if !reflect.DeepEqual(ast, currentSample.expected) {
+				t.Errorf("expected %s, got %s", currentSample.expected, ast)
+			}
+		})
+	}
+}
+
 func TestInvalidSyntax(t *testing.T) {
 	t.Parallel()
 
 	samples := []string{
 		"attribute.status IS 'RUNNING'",
+		"metrics.accuracy > 0.72 AND",
+		"(metrics.accuracy > 0.72",
+		"metrics.accuracy > 0.72)",
+		"()",
+		"metrics.accuracy > 0.72 OR metrics.loss <= 0.15",
+		"tags.holdout IS NOT",
+		"tags.holdout NOT NULL",
 	}
 
 	for _, sample := range samples {
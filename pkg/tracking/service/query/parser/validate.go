@@ -38,6 +38,7 @@ const (
 	Tag
 	Attribute
 	Dataset
+	RequestMetadata
 )
 
 func (v ValidIdentifier) String() string {
@@ -52,6 +53,8 @@ func (v ValidIdentifier) String() string {
 		return "attribute"
 	case Dataset:
 		return "dataset"
+	case RequestMetadata:
+		return "request_metadata"
 	default:
 		return "unknown"
 	}
@@ -68,6 +71,30 @@ func (v ValidCompareExpr) String() string {
 	return fmt.Sprintf("%s.%s %s %v", v.Identifier, v.Key, v.Operator, v.Value)
 }
 
+func (v *ValidCompareExpr) validExpr() {}
+
+// ValidExpr is a node of a validated filter, either a comparison or a boolean combination of filters.
+type ValidExpr interface {
+	validExpr()
+	fmt.Stringer
+}
+
+type ValidBooleanExpr struct {
+	Operator BooleanOperatorKind
+	Exprs    []ValidExpr
+}
+
+func (v *ValidBooleanExpr) validExpr() {}
+
+func (v *ValidBooleanExpr) String() string {
+	items := make([]string, 0, len(v.Exprs))
+	for _, e := range v.Exprs {
+		items = append(items, e.String())
+	}
+
+	return "(" + strings.Join(items, " "+v.Operator.String()+" ") + ")"
+}
+
 type ValidationError struct {
 	message string
 }
@@ -315,6 +342,24 @@ func ValidateExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
 		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
 	}
 
+	if expression.Operator == IsNull || expression.Operator == IsNotNull {
+		//nolint:exhaustive
+		switch validIdentifier {
+		case Metric, Parameter, Tag:
+			return &ValidCompareExpr{
+				Identifier: validIdentifier,
+				Key:        validKey,
+				Operator:   expression.Operator,
+			}, nil
+		default:
+			return nil, NewValidationError(
+				"Error on parsing filter expression: %s is only supported for metrics, params and tags. Found %s",
+				expression.Operator,
+				validIdentifier,
+			)
+		}
+	}
+
 	value, err := validateValue(validIdentifier, validKey, expression.Right)
 	if err != nil {
 		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+)
+
+/*
+
+The experiment dialect shares the grammar of the run filters,
+but searches the experiments table and its tags.
+
+Port of SearchExperimentsUtils in search_utils.py.
+
+For attributes, the allowed keys are: name, creation_time and last_update_time.
+The times are compared to numbers, the name to strings.
+
+Tags are compared to strings, or checked for presence with IS NULL and IS NOT NULL.
+
+*/
+
+const (
+	ExperimentCreationTime   = "creation_time"
+	ExperimentLastUpdateTime = "last_update_time"
+)
+
+var searchableExperimentAttributes = []string{
+	"name",
+	ExperimentCreationTime,
+	ExperimentLastUpdateTime,
+}
+
+func isNumericExperimentKey(identifier ValidIdentifier, key string) bool {
+	return identifier == Attribute && (key == ExperimentCreationTime || key == ExperimentLastUpdateTime)
+}
+
+func validateExperimentOperator(identifier ValidIdentifier, key string, operator OperatorKind) error {
+	var allowed []OperatorKind
+
+	switch {
+	case isNumericExperimentKey(identifier, key):
+		allowed = []OperatorKind{Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals}
+	case identifier == Tag:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike, IsNull, IsNotNull}
+	default:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike}
+	}
+
+	if !slices.Contains(allowed, operator) {
+		return NewValidationError("invalid comparator '%s' for %s", operator, identifier)
+	}
+
+	return nil
+}
+
+func validateExperimentValue(identifier ValidIdentifier, key string, value Value) (interface{}, error) {
+	if isNumericExperimentKey(identifier, key) {
+		if _, ok := value.(NumberExpr); !ok {
+			return nil, NewValidationError(
+				"expected numeric value type for %s %s. Found %s",
+				identifier,
+				key,
+				value,
+			)
+		}
+
+		return value.value(), nil
+	}
+
+	switch value.(type) {
+	case StringExpr, NullExpr:
+		return value.value(), nil
+	default:
+		return nil, NewValidationError(
+			"expected a quoted string value for %s. Found %s",
+			identifier, value,
+		)
+	}
+}
+
+// Validate an expression according to the experiment search domain.
+// The returned key is the column of the experiments table for attributes.
+func ValidateExperimentExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseValidIdentifier(expression.Left.Identifier)
+	if err != nil || (validIdentifier != Attribute && validIdentifier != Tag) {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError("invalid identifier %q", expression.Left.Identifier),
+		)
+	}
+
+	key := expression.Left.Key
+	if validIdentifier == Attribute && !slices.Contains(searchableExperimentAttributes, key) {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError(
+				"Invalid attribute key '%s' specified. Valid keys are '%v'",
+				key,
+				searchableExperimentAttributes,
+			),
+		)
+	}
+
+	if err := validateExperimentOperator(validIdentifier, key, expression.Operator); err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	value, err := validateExperimentValue(validIdentifier, key, expression.Right)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+)
+
+/*
+
+The logged model dialect shares the grammar of the run filters,
+but searches the logged_models table, its metrics, params and tags.
+
+Port of SearchLoggedModelsUtils in search_utils.py.
+
+For attributes, the allowed keys are: name, model_id, model_type, status, source_run_id,
+creation_timestamp and last_updated_timestamp. The timestamps are compared to numbers,
+the other attributes to strings. The status is only compared for (in)equality.
+
+Metrics are compared to numbers, params and tags to strings.
+
+*/
+
+const (
+	LoggedModelCreationTimestamp    = "creation_timestamp_ms"
+	LoggedModelLastUpdatedTimestamp = "last_updated_timestamp_ms"
+)
+
+var searchableLoggedModelAttributes = []string{
+	"name",
+	"model_id",
+	"model_type",
+	"status",
+	"source_run_id",
+	"creation_timestamp",
+	"last_updated_timestamp",
+}
+
+// Returns the logged_models column that is searched for an attribute key.
+func parseLoggedModelAttributeKey(key string) (string, error) {
+	switch key {
+	case "name", "model_id", "model_type", "status", "source_run_id":
+		return key, nil
+	case "creation_timestamp", "creation_time", LoggedModelCreationTimestamp:
+		return LoggedModelCreationTimestamp, nil
+	case "last_updated_timestamp", "last_updated_time", LoggedModelLastUpdatedTimestamp:
+		return LoggedModelLastUpdatedTimestamp, nil
+	default:
+		return "", NewValidationError(
+			"Invalid attribute key '%s' specified. Valid keys are '%v'",
+			key,
+			searchableLoggedModelAttributes,
+		)
+	}
+}
+
+func isNumericLoggedModelKey(identifier ValidIdentifier, key string) bool {
+	return identifier == Metric ||
+		(identifier == Attribute && (key == LoggedModelCreationTimestamp || key == LoggedModelLastUpdatedTimestamp))
+}
+
+func validateLoggedModelOperator(identifier ValidIdentifier, key string, operator OperatorKind) error {
+	var allowed []OperatorKind
+
+	switch {
+	case isNumericLoggedModelKey(identifier, key):
+		allowed = []OperatorKind{Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals}
+	// The status is stored as a number, it can't be matched against a pattern.
+	case identifier == Attribute && key == "status":
+		allowed = []OperatorKind{Equals, NotEquals, In, NotIn}
+	case identifier == Attribute:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike, In, NotIn}
+	default:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike}
+	}
+
+	if !slices.Contains(allowed, operator) {
+		return NewValidationError("invalid comparator '%s' for %s", operator, identifier)
+	}
+
+	return nil
+}
+
+func validateLoggedModelValue(identifier ValidIdentifier, key string, value Value) (interface{}, error) {
+	if isNumericLoggedModelKey(identifier, key) {
+		if _, ok := value.(NumberExpr); !ok {
+			return nil, NewValidationError(
+				"expected numeric value type for %s %s. Found %s",
+				identifier,
+				key,
+				value,
+			)
+		}
+
+		return value.value(), nil
+	}
+
+	switch value.(type) {
+	case StringExpr, StringListExpr:
+		return value.value(), nil
+	default:
+		return nil, NewValidationError(
+			"expected a quoted string value for %s. Found %s",
+			identifier, value,
+		)
+	}
+}
+
+// Validate an expression according to the logged model search domain.
+// The returned key is the column of the logged_models table for attributes.
+func ValidateLoggedModelExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseValidIdentifier(expression.Left.Identifier)
+	if err != nil || validIdentifier == Dataset {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError("invalid identifier %q", expression.Left.Identifier),
+		)
+	}
+
+	key := expression.Left.Key
+	if validIdentifier == Attribute {
+		key, err = parseLoggedModelAttributeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+	}
+
+	if err := validateLoggedModelOperator(validIdentifier, key, expression.Operator); err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	value, err := validateLoggedModelValue(validIdentifier, key, expression.Right)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
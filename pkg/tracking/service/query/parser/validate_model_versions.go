@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+/*
+
+The model version dialect shares the grammar of the run filters,
+but only knows about attributes and tags of the model_versions table.
+
+Port of SearchModelVersionUtils in search_utils.py.
+
+For attributes, the allowed keys are: name, version_number, run_id and source_path.
+version_number is compared to numbers, the other attributes to strings.
+Only run_id supports comparison with a list of strings.
+
+Tags are compared to strings.
+
+*/
+
+const (
+	ModelVersionNumber     = "version_number"
+	ModelVersionSourcePath = "source_path"
+)
+
+var searchableModelVersionAttributes = []string{
+	"name",
+	ModelVersionNumber,
+	RunID,
+	ModelVersionSourcePath,
+}
+
+func parseModelVersionIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "", attributeIdentifier, "attr", "attributes":
+		return Attribute, nil
+	default:
+		return -1, NewValidationError("invalid identifier %q", identifier)
+	}
+}
+
+// Returns the model_versions column that is searched for an attribute key.
+func parseModelVersionAttributeKey(key string) (string, error) {
+	switch key {
+	case "name", RunID:
+		return key, nil
+	case ModelVersionNumber:
+		return "version", nil
+	case ModelVersionSourcePath:
+		return "source", nil
+	default:
+		return "", NewValidationError(
+			"Invalid attribute key '%s' specified. Valid keys are '%v'",
+			key,
+			searchableModelVersionAttributes,
+		)
+	}
+}
+
+func validateModelVersionOperator(identifier ValidIdentifier, column string, operator OperatorKind) error {
+	var allowed []OperatorKind
+
+	switch {
+	case identifier == Attribute && column == "version":
+		allowed = []OperatorKind{Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals}
+	case identifier == Attribute && column == RunID:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike, In}
+	default:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike}
+	}
+
+	if !slices.Contains(allowed, operator) {
+		return NewValidationError("invalid comparator '%s' for %s", operator, identifier)
+	}
+
+	return nil
+}
+
+func validateModelVersionValue(identifier ValidIdentifier, column string, value Value) (interface{}, error) {
+	if identifier == Attribute && column == "version" {
+		number, ok := value.(NumberExpr)
+		if !ok || number.Value != math.Trunc(number.Value) {
+			return nil, NewValidationError(
+				"expected an integer value for attribute %s. Found %s",
+				ModelVersionNumber,
+				value,
+			)
+		}
+
+		return int64(number.Value), nil
+	}
+
+	switch value.(type) {
+	case StringExpr, StringListExpr:
+		return value.value(), nil
+	default:
+		return nil, NewValidationError(
+			"expected a quoted string value for %s. Found %s",
+			identifier, value,
+		)
+	}
+}
+
+// Validate an expression according to the model version search domain.
+// The returned key is the column of the model_versions table for attributes.
+func ValidateModelVersionExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseModelVersionIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	key := expression.Left.Key
+	if validIdentifier == Attribute {
+		key, err = parseModelVersionAttributeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+	}
+
+	if err := validateModelVersionOperator(validIdentifier, key, expression.Operator); err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	value, err := validateModelVersionValue(validIdentifier, key, expression.Right)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+)
+
+/*
+
+The registered model dialect shares the grammar of the run filters,
+but only knows about the name of the registered_models table and its tags.
+
+Port of SearchModelUtils in search_utils.py.
+
+The name and the tags are compared to strings.
+
+*/
+
+var searchableRegisteredModelAttributes = []string{"name"}
+
+func parseRegisteredModelIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "", attributeIdentifier, "attr", "attributes":
+		return Attribute, nil
+	default:
+		return -1, NewValidationError(
+			"invalid entity type '%s'. Valid values are ['tag', 'tags', 'attribute']", identifier,
+		)
+	}
+}
+
+// Validate an expression according to the registered model search domain.
+// The returned key is the column of the registered_models table for attributes.
+func ValidateRegisteredModelExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseRegisteredModelIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	key := expression.Left.Key
+	if validIdentifier == Attribute && !slices.Contains(searchableRegisteredModelAttributes, key) {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError(
+				"Invalid attribute key '%s' specified. Valid keys are '%v'", key, searchableRegisteredModelAttributes,
+			),
+		)
+	}
+
+	if !slices.Contains([]OperatorKind{Equals, NotEquals, Like, ILike}, expression.Operator) {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError("invalid comparator '%s' for %s", expression.Operator, validIdentifier),
+		)
+	}
+
+	value, ok := expression.Right.(StringExpr)
+	if !ok {
+		return nil, fmt.Errorf(
+			"Error on parsing filter expression: %w",
+			NewValidationError("expected a quoted string value for %s. Found %s", validIdentifier, expression.Right),
+		)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value.Value,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+/*
+
+The trace dialect shares the grammar of the run filters,
+but searches the trace_info table, its tags and its request metadata.
+
+Port of SearchTraceUtils in search_utils.py.
+
+For attributes, the allowed keys are: request_id, timestamp_ms, execution_time_ms and status.
+timestamp_ms and execution_time_ms are compared to numbers, the other attributes to strings.
+name and run_id are shortcuts for the trace name tag and the source run metadata.
+
+Tags and request metadata are compared to strings.
+
+*/
+
+const (
+	TraceTimestampMS     = "timestamp_ms"
+	TraceExecutionTimeMS = "execution_time_ms"
+	TraceNameTagKey      = "mlflow.traceName"
+	TraceSourceRunKey    = "mlflow.sourceRun"
+)
+
+var searchableTraceAttributes = []string{
+	"request_id",
+	TraceTimestampMS,
+	TraceExecutionTimeMS,
+	"status",
+	"name",
+	RunID,
+}
+
+func parseTraceIdentifier(identifier string) (ValidIdentifier, error) {
+	switch identifier {
+	case tagIdentifier, "tags":
+		return Tag, nil
+	case "request_metadata", "metadata":
+		return RequestMetadata, nil
+	case "", attributeIdentifier, "attr", "attributes", "trace":
+		return Attribute, nil
+	default:
+		return -1, NewValidationError("invalid identifier %q", identifier)
+	}
+}
+
+// Returns the identifier and key that are searched for a trace attribute,
+// name and run_id are stored as tag and request metadata.
+func parseTraceAttributeKey(key string) (ValidIdentifier, string, error) {
+	switch key {
+	case "request_id", "status":
+		return Attribute, key, nil
+	case "timestamp", TraceTimestampMS:
+		return Attribute, TraceTimestampMS, nil
+	case "execution_time", TraceExecutionTimeMS:
+		return Attribute, TraceExecutionTimeMS, nil
+	case "name":
+		return Tag, TraceNameTagKey, nil
+	case RunID:
+		return RequestMetadata, TraceSourceRunKey, nil
+	default:
+		return -1, "", NewValidationError(
+			"Invalid attribute key '%s' specified. Valid keys are '%v'",
+			key,
+			searchableTraceAttributes,
+		)
+	}
+}
+
+func isNumericTraceAttribute(identifier ValidIdentifier, key string) bool {
+	return identifier == Attribute && (key == TraceTimestampMS || key == TraceExecutionTimeMS)
+}
+
+func validateTraceOperator(identifier ValidIdentifier, key string, operator OperatorKind) error {
+	var allowed []OperatorKind
+
+	switch {
+	case isNumericTraceAttribute(identifier, key):
+		allowed = []OperatorKind{Equals, NotEquals, Less, LessEquals, Greater, GreaterEquals}
+	case identifier == Attribute && key == "request_id":
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike, In, NotIn}
+	default:
+		allowed = []OperatorKind{Equals, NotEquals, Like, ILike}
+	}
+
+	if !slices.Contains(allowed, operator) {
+		return NewValidationError("invalid comparator '%s' for %s", operator, identifier)
+	}
+
+	return nil
+}
+
+func validateTraceValue(identifier ValidIdentifier, key string, value Value) (interface{}, error) {
+	if isNumericTraceAttribute(identifier, key) {
+		number, ok := value.(NumberExpr)
+		if !ok || number.Value != math.Trunc(number.Value) {
+			return nil, NewValidationError(
+				"expected an integer value for attribute %s. Found %s",
+				key,
+				value,
+			)
+		}
+
+		return int64(number.Value), nil
+	}
+
+	switch value.(type) {
+	case StringExpr, StringListExpr:
+		return value.value(), nil
+	default:
+		return nil, NewValidationError(
+			"expected a quoted string value for %s. Found %s",
+			identifier, value,
+		)
+	}
+}
+
+// Validate an expression according to the trace search domain.
+// The returned key is the column of the trace_info table for attributes.
+func ValidateTraceExpression(expression *CompareExpr) (*ValidCompareExpr, error) {
+	validIdentifier, err := parseTraceIdentifier(expression.Left.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	key := expression.Left.Key
+	if validIdentifier == Attribute {
+		validIdentifier, key, err = parseTraceAttributeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+		}
+	}
+
+	if err := validateTraceOperator(validIdentifier, key, expression.Operator); err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	value, err := validateTraceValue(validIdentifier, key, expression.Right)
+	if err != nil {
+		return nil, fmt.Errorf("Error on parsing filter expression: %w", err)
+	}
+
+	return &ValidCompareExpr{
+		Identifier: validIdentifier,
+		Key:        key,
+		Operator:   expression.Operator,
+		Value:      value,
+	}, nil
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 )
 
-func ParseFilter(input string) ([]*parser.ValidCompareExpr, error) {
+type validator func(expression *parser.CompareExpr) (*parser.ValidCompareExpr, error)
+
+func parseFilter(input string, validate validator) ([]*parser.ValidCompareExpr, error) {
 	if input == "" {
 		return make([]*parser.ValidCompareExpr, 0), nil
 	}
@@ -25,7 +27,7 @@ func ParseFilter(input string) ([]*parser.ValidCompareExpr, error) {
 	validExpressions := make([]*parser.ValidCompareExpr, 0, len(ast.Exprs))
 
 	for _, expr := range ast.Exprs {
-		ve, err := parser.ValidateExpression(expr)
+		ve, err := validate(expr)
 		if err != nil {
 			return nil, fmt.Errorf("error while validating %s: %w", input, err)
 		}
@@ -35,3 +37,86 @@ func ParseFilter(input string) ([]*parser.ValidCompareExpr, error) {
 
 	return validExpressions, nil
 }
+
+//nolint:ireturn
+func validateBooleanExpr(expr parser.Expr, validate validator) (parser.ValidExpr, error) {
+	switch expr := expr.(type) {
+	case *parser.CompareExpr:
+		return validate(expr)
+	case *parser.BooleanExpr:
+		validExpressions := make([]parser.ValidExpr, 0, len(expr.Exprs))
+
+		for _, e := range expr.Exprs {
+			ve, err := validateBooleanExpr(e, validate)
+			if err != nil {
+				return nil, err
+			}
+
+			validExpressions = append(validExpressions, ve)
+		}
+
+		return &parser.ValidBooleanExpr{Operator: expr.Operator, Exprs: validExpressions}, nil
+	default:
+		return nil, parser.NewParserError("unexpected expression %s", expr)
+	}
+}
+
+//nolint:ireturn
+func parseBooleanFilter(input string, validate validator) (parser.ValidExpr, error) {
+	if input == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	tokens, err := lexer.Tokenize(&input)
+	if err != nil {
+		return nil, fmt.Errorf("error while lexing %s: %w", input, err)
+	}
+
+	ast, err := parser.ParseBoolean(tokens)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", input, err)
+	}
+
+	validExpression, err := validateBooleanExpr(ast, validate)
+	if err != nil {
+		return nil, fmt.Errorf("error while validating %s: %w", input, err)
+	}
+
+	return validExpression, nil
+}
+
+// ParseFilter parses a filter of the run search dialect, where comparisons can be combined
+// with AND, OR and parentheses. An empty filter returns nil.
+//
+//nolint:ireturn
+func ParseFilter(input string) (parser.ValidExpr, error) {
+	return parseBooleanFilter(input, parser.ValidateExpression)
+}
+
+// ParseExperimentFilter parses a filter of the experiment search dialect, which shares
+// the grammar of the run search dialect. An empty filter returns nil.
+//
+//nolint:ireturn
+func ParseExperimentFilter(input string) (parser.ValidExpr, error) {
+	return parseBooleanFilter(input, parser.ValidateExperimentExpression)
+}
+
+// ParseModelVersionFilter parses a filter of the model version search dialect.
+func ParseModelVersionFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseFilter(input, parser.ValidateModelVersionExpression)
+}
+
+// ParseRegisteredModelFilter parses a filter of the registered model search dialect.
+func ParseRegisteredModelFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseFilter(input, parser.ValidateRegisteredModelExpression)
+}
+
+// ParseTraceFilter parses a filter of the trace search dialect.
+func ParseTraceFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseFilter(input, parser.ValidateTraceExpression)
+}
+
+// ParseLoggedModelFilter parses a filter of the logged model search dialect.
+func ParseLoggedModelFilter(input string) ([]*parser.ValidCompareExpr, error) {
+	return parseFilter(input, parser.ValidateLoggedModelExpression)
+}
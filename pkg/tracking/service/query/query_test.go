@@ -21,6 +21,11 @@ func TestValidQueries(t *testing.T) {
 		"params.solver LIKE \"l%\"",
 		"datasets.digest IN ('77a19fc0')",
 		"attributes.run_id IN ('meh')",
+		"metrics.foobar = 40 OR run_name = \"bouncy-boar-498\"",
+		"(params.solver = 'lbfgs' OR params.solver = 'saga') AND metrics.accuracy > 0.9",
+		"tags.a = 'x' OR (datasets.name = 'd' AND (datasets.context = 'train' OR metrics.loss < 1))",
+		"tags.holdout IS NULL",
+		"params.lr IS NOT NULL AND metrics.auc is null",
 	}
 
 	for _, sample := range samples {
@@ -88,6 +93,38 @@ func TestInvalidQueries(t *testing.T) {
 			input:         "datasets.context = 60",
 			expectedError: "expected datasets.context to be either a string or list of strings",
 		},
+		{
+			input:         "metrics.accuracy > 0.9 OR yow.foobar = 40",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "(metrics.accuracy > 0.9 OR params.solver = 'saga'",
+			expectedError: "expected ')'",
+		},
+		{
+			input:         "attributes.status IS NULL",
+			expectedError: "IS NULL is only supported for metrics, params and tags",
+		},
+		{
+			input:         "datasets.context IS NOT NULL",
+			expectedError: "IS NOT NULL is only supported for metrics, params and tags",
+		},
+		{
+			input:         "tags.holdout IS 'x'",
+			expectedError: "expected NULL after IS",
+		},
+		{
+			input:         "metrics.a",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "metrics.a >",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "metrics.a > 1 AND",
+			expectedError: "unexpected end of filter",
+		},
 	}
 
 	for _, sample := range samples {
@@ -110,3 +147,396 @@ func TestInvalidQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidModelVersionQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"name = 'my-model'",
+		"run_id IN ('a1b2', 'c3d4')",
+		"source_path LIKE 's3://bucket/%'",
+		"version_number >= 3 AND tags.`validation.status` = 'passed'",
+		"attributes.name ILIKE '%Model%'",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseModelVersionFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidModelVersionQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "metrics.acc > 0.9",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "run_name = 'foo'",
+			expectedError: "Invalid attribute key 'run_name' specified",
+		},
+		{
+			input:         "name IN ('foo')",
+			expectedError: "invalid comparator 'IN' for attribute",
+		},
+		{
+			input:         "version_number = '3'",
+			expectedError: "expected an integer value for attribute version_number",
+		},
+		{
+			input:         "tags.stage > 'prod'",
+			expectedError: "invalid comparator '>' for tag",
+		},
+		{
+			input:         "name",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "tags.stage =",
+			expectedError: "unexpected end of filter",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseModelVersionFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidRegisteredModelQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"name = 'my-model'",
+		"name = 'a AND b'",
+		"attributes.name ILIKE '%Model%' AND tags.`mlflow.prompt.is_prompt` != 'true'",
+		"tags.stage LIKE 'prod%'",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseRegisteredModelFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidRegisteredModelQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "metrics.acc > 0.9",
+			expectedError: "invalid entity type 'metrics'",
+		},
+		{
+			input:         "run_id = 'foo'",
+			expectedError: "Invalid attribute key 'run_id' specified",
+		},
+		{
+			input:         "name IN ('foo')",
+			expectedError: "invalid comparator 'IN' for attribute",
+		},
+		{
+			input:         "name = 3",
+			expectedError: "expected a quoted string value for attribute",
+		},
+		{
+			input:         "tags.stage",
+			expectedError: "unexpected end of filter",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseRegisteredModelFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidTraceQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"timestamp_ms > 1700000000000",
+		"attributes.execution_time_ms <= 500 AND status = 'OK'",
+		"tags.environment = 'production'",
+		"request_metadata.`mlflow.sourceRun` = 'a1b2'",
+		"name ILIKE '%chain%' AND run_id = 'a1b2'",
+		"trace.request_id IN ('tr-1', 'tr-2')",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseTraceFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidTraceQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "params.model = 'gpt'",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "experiment_id = '1'",
+			expectedError: "Invalid attribute key 'experiment_id' specified",
+		},
+		{
+			input:         "timestamp_ms = '1700000000000'",
+			expectedError: "expected an integer value for attribute timestamp_ms",
+		},
+		{
+			input:         "status > 'OK'",
+			expectedError: "invalid comparator '>' for attribute",
+		},
+		{
+			input:         "request_metadata.key IN ('a')",
+			expectedError: "invalid comparator 'IN' for request_metadata",
+		},
+		{
+			input:         "tags.key IS NULL",
+			expectedError: "invalid comparator 'IS NULL' for tag",
+		},
+		{
+			input:         "tags.key",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "status =",
+			expectedError: "unexpected end of filter",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseTraceFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidLoggedModelQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"metrics.accuracy > 0.9",
+		"params.learning_rate = '0.01' AND tags.stage = 'prod'",
+		"name ILIKE '%agent%' AND status = 'READY'",
+		"attributes.model_id IN ('m-1', 'm-2')",
+		"status NOT IN ('FAILED', 'PENDING')",
+		"creation_timestamp >= 1700000000000",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseLoggedModelFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidLoggedModelQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "datasets.name = 'train'",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "artifact_uri = 'file:///tmp'",
+			expectedError: "Invalid attribute key 'artifact_uri' specified",
+		},
+		{
+			input:         "metrics.accuracy = 'high'",
+			expectedError: "expected numeric value type for metric accuracy",
+		},
+		{
+			input:         "params.lr IN ('0.1')",
+			expectedError: "invalid comparator 'IN' for param",
+		},
+		{
+			input:         "metrics.a",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "status LIKE 'READY%'",
+			expectedError: "invalid comparator 'LIKE' for attribute",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseLoggedModelFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
+
+func TestValidExperimentQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []string{
+		"name = 'my-experiment'",
+		"attributes.name ILIKE '%exp%'",
+		"creation_time > 1700000000000 AND last_update_time <= 1800000000000",
+		"tags.team = 'nlp' OR tags.`mlflow.note.content` LIKE '%baseline%'",
+		"(name LIKE 'a%' OR name LIKE 'b%') AND tags.archived IS NULL",
+		"tags.owner IS NOT NULL",
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseExperimentFilter(currentSample)
+			if err != nil {
+				t.Errorf("unexpected parse error: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvalidExperimentQueries(t *testing.T) {
+	t.Parallel()
+
+	samples := []invalidSample{
+		{
+			input:         "metrics.accuracy > 0.9",
+			expectedError: "invalid identifier",
+		},
+		{
+			input:         "attributes.artifact_location = 'x'",
+			expectedError: "Invalid attribute key 'artifact_location' specified",
+		},
+		{
+			input:         "creation_time > '1700000000000'",
+			expectedError: "expected numeric value type for attribute creation_time",
+		},
+		{
+			input:         "name IN ('a', 'b')",
+			expectedError: "invalid comparator 'IN' for attribute",
+		},
+		{
+			input:         "name IS NULL",
+			expectedError: "invalid comparator 'IS NULL' for attribute",
+		},
+		{
+			input:         "tags.team = 1",
+			expectedError: "expected a quoted string value for tag",
+		},
+		{
+			input:         "metrics.a",
+			expectedError: "unexpected end of filter",
+		},
+		{
+			input:         "name",
+			expectedError: "unexpected end of filter",
+		},
+	}
+
+	for _, sample := range samples {
+		currentSample := sample
+		t.Run(currentSample.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := query.ParseExperimentFilter(currentSample.input)
+			if err == nil {
+				t.Fatalf("expected parse error but got nil")
+			}
+
+			if !strings.Contains(err.Error(), currentSample.expectedError) {
+				t.Errorf(
+					"expected error to contain %q, got %q",
+					currentSample.expectedError,
+					err.Error(),
+				)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store"
-	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
 )
 
 type TrackingService struct {
@@ -15,9 +14,9 @@ type TrackingService struct {
 }
 
 func NewTrackingService(ctx context.Context, config *config.Config) (*TrackingService, error) {
-	store, err := sql.NewTrackingSQLStore(ctx, config)
+	store, err := store.NewTrackingStore(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+		return nil, fmt.Errorf("failed to create tracking store: %w", err)
 	}
 
 	return &TrackingService{
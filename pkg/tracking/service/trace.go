@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
@@ -16,6 +17,11 @@ func (ts TrackingService) SetTraceTag(
 	if err := ts.Store.SetTraceTag(
 		ctx, input.GetRequestId(), input.GetKey(), input.GetValue(),
 	); err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return nil, contractError
+		}
+
 		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to create trace_tag", err)
 	}
 
@@ -27,7 +33,7 @@ func (ts TrackingService) DeleteTraceTag(
 ) (*protos.DeleteTraceTag_Response, *contract.Error) {
 	tag, err := ts.Store.GetTraceTag(ctx, input.GetRequestId(), input.GetKey())
 	if err != nil {
-		return nil, contract.NewError(protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error getting trace tag: %v", err))
+		return nil, err
 	}
 
 	if tag == nil {
@@ -59,6 +65,12 @@ func (ts TrackingService) StartTrace(
 		entities.TagsFromStartTraceProtoInput(input.GetTags()),
 	)
 	if err != nil {
+		// The file store answers NOT_IMPLEMENTED, the request is then served by the Python server.
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return nil, contractError
+		}
+
 		return nil, contract.NewError(protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error starting trace: %v", err))
 	}
 
@@ -79,6 +91,11 @@ func (ts TrackingService) EndTrace(
 		entities.TagsFromStartTraceProtoInput(input.GetTags()),
 	)
 	if err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return nil, contractError
+		}
+
 		return nil, contract.NewError(protos.ErrorCode_INTERNAL_ERROR, fmt.Sprintf("error ending trace: %v", err))
 	}
 
@@ -100,6 +117,35 @@ func (ts TrackingService) GetTraceInfo(
 	}, nil
 }
 
+func (ts TrackingService) SearchTraces(
+	ctx context.Context, input *protos.SearchTraces,
+) (*protos.SearchTraces_Response, *contract.Error) {
+	traces, nextPageToken, err := ts.Store.SearchTraces(
+		ctx,
+		input.GetExperimentIds(),
+		input.GetFilter(),
+		int64(input.GetMaxResults()),
+		input.GetOrderBy(),
+		input.GetPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := protos.SearchTraces_Response{
+		Traces: make([]*protos.TraceInfo, len(traces)),
+	}
+	if nextPageToken != "" {
+		response.NextPageToken = &nextPageToken
+	}
+
+	for i, trace := range traces {
+		response.Traces[i] = trace.ToProto()
+	}
+
+	return &response, nil
+}
+
 func (ts TrackingService) DeleteTraces(
 	ctx context.Context, input *protos.DeleteTraces,
 ) (*protos.DeleteTraces_Response, *contract.Error) {
@@ -146,3 +192,25 @@ func (ts TrackingService) DeleteTraces(
 		TracesDeleted: utils.PtrTo(result),
 	}, nil
 }
+
+func (ts TrackingService) GetTraceInfoV3(
+	ctx context.Context, input *protos.GetTraceInfoV3,
+) (*protos.GetTraceInfoV3_Response, *contract.Error) {
+	traceInfo, err := ts.Store.GetTraceInfo(ctx, input.GetTraceId())
+	if err != nil {
+		return nil, err
+	}
+
+	traceInfoV3, convertErr := traceInfo.ToProtoV3()
+	if convertErr != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to convert trace info '%s'", input.GetTraceId()),
+			convertErr,
+		)
+	}
+
+	return &protos.GetTraceInfoV3_Response{
+		Trace: &protos.Trace{TraceInfo: traceInfoV3},
+	}, nil
+}
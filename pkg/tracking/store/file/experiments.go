@@ -0,0 +1,373 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const (
+	defaultExperimentID   = "0"
+	defaultExperimentName = "Default"
+)
+
+func experimentDoesNotExist(id string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("No Experiment with id=%s exists", id),
+	)
+}
+
+func checkExperimentIsActive(experiment *entities.Experiment) *contract.Error {
+	if experiment.LifecycleStage != lifecycleStageActive {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The experiment %q must be in the 'active' state.\n"+
+					"Current state is %q.",
+				experiment.ExperimentID,
+				experiment.LifecycleStage,
+			),
+		)
+	}
+
+	return nil
+}
+
+// experimentDirs returns the directories of the active experiments followed by the deleted ones.
+func (s TrackingFileStore) experimentDirs() ([]string, *contract.Error) {
+	dirs := make([]string, 0)
+
+	for _, parent := range []string{s.root, filepath.Join(s.root, trashFolderName)} {
+		names, err := filestore.ListDirectories(parent)
+		if err != nil {
+			return nil, newInternalError("failed to list experiments", err)
+		}
+
+		for _, name := range names {
+			if name != trashFolderName && filestore.Exists(filepath.Join(parent, name, filestore.MetaFileName)) {
+				dirs = append(dirs, filepath.Join(parent, name))
+			}
+		}
+	}
+
+	return dirs, nil
+}
+
+// experimentDir returns the directory of an experiment, either in the root or in the trash folder.
+func (s TrackingFileStore) experimentDir(id string) (string, *contract.Error) {
+	if !filestore.IsValidName(id) {
+		return "", experimentDoesNotExist(id)
+	}
+
+	for _, dir := range []string{filepath.Join(s.root, id), filepath.Join(s.root, trashFolderName, id)} {
+		if filestore.Exists(filepath.Join(dir, filestore.MetaFileName)) {
+			return dir, nil
+		}
+	}
+
+	return "", experimentDoesNotExist(id)
+}
+
+func (s TrackingFileStore) readExperimentMeta(dir string) (*experimentMeta, *contract.Error) {
+	var meta experimentMeta
+	if err := filestore.ReadYAML(filepath.Join(dir, filestore.MetaFileName), &meta); err != nil {
+		return nil, newInternalError("failed to read experiment", err)
+	}
+
+	// Experiments written by old MLflow versions have no lifecycle stage.
+	if meta.LifecycleStage == "" {
+		meta.LifecycleStage = lifecycleStageActive
+	}
+
+	return &meta, nil
+}
+
+func (s TrackingFileStore) readExperiment(dir string) (*entities.Experiment, *contract.Error) {
+	meta, err := s.readExperimentMeta(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	values, readErr := filestore.ReadKeyValues(filepath.Join(dir, tagsFolder))
+	if readErr != nil {
+		return nil, newInternalError("failed to read experiment tags", readErr)
+	}
+
+	tags := make([]*entities.ExperimentTag, 0, len(values))
+	for _, key := range filestore.SortedKeys(values) {
+		tags = append(tags, &entities.ExperimentTag{Key: key, Value: values[key]})
+	}
+
+	return &entities.Experiment{
+		Name:             meta.Name,
+		ExperimentID:     meta.ExperimentID,
+		ArtifactLocation: meta.ArtifactLocation,
+		LifecycleStage:   meta.LifecycleStage,
+		LastUpdateTime:   meta.LastUpdateTime,
+		CreationTime:     meta.CreationTime,
+		Tags:             tags,
+	}, nil
+}
+
+func (s TrackingFileStore) readExperiments() ([]*entities.Experiment, *contract.Error) {
+	dirs, err := s.experimentDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	experiments := make([]*entities.Experiment, 0, len(dirs))
+
+	for _, dir := range dirs {
+		experiment, err := s.readExperiment(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		experiments = append(experiments, experiment)
+	}
+
+	return experiments, nil
+}
+
+func (s TrackingFileStore) GetExperiment(_ context.Context, id string) (*entities.Experiment, *contract.Error) {
+	dir, err := s.experimentDir(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readExperiment(dir)
+}
+
+//nolint:perfsprint
+func (s TrackingFileStore) GetExperimentByName(
+	_ context.Context, name string,
+) (*entities.Experiment, *contract.Error) {
+	experiments, err := s.readExperiments()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, experiment := range experiments {
+		if experiment.Name == name {
+			return experiment, nil
+		}
+	}
+
+	return nil, contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Could not find experiment with name %s", name),
+	)
+}
+
+// nextExperimentID returns the successor of the highest numeric experiment ID.
+func nextExperimentID(experiments []*entities.Experiment) string {
+	next := int64(0)
+
+	for _, experiment := range experiments {
+		if id, err := strconv.ParseInt(experiment.ExperimentID, 10, 64); err == nil && id >= next {
+			next = id + 1
+		}
+	}
+
+	return strconv.FormatInt(next, 10)
+}
+
+func (s TrackingFileStore) createExperiment(
+	id, name, artifactLocation string, tags []*entities.ExperimentTag,
+) *contract.Error {
+	if artifactLocation == "" {
+		location, err := utils.AppendToURIPath(s.artifactRoot(), id)
+		if err != nil {
+			return newInternalError("failed to join artifact location", err)
+		}
+
+		artifactLocation = location
+	}
+
+	now := time.Now().UnixMilli()
+	dir := filepath.Join(s.root, id)
+
+	if err := filestore.WriteYAML(filepath.Join(dir, filestore.MetaFileName), experimentMeta{
+		ArtifactLocation: artifactLocation,
+		CreationTime:     now,
+		ExperimentID:     id,
+		LastUpdateTime:   now,
+		LifecycleStage:   lifecycleStageActive,
+		Name:             name,
+	}); err != nil {
+		return newInternalError("failed to create experiment", err)
+	}
+
+	for _, tag := range tags {
+		path := filestore.KeyPath(filepath.Join(dir, tagsFolder), tag.Key)
+		if err := filestore.WriteFile(path, []byte(tag.Value)); err != nil {
+			return newInternalError("failed to create experiment tag", err)
+		}
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) ensureDefaultExperiment(_ context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dirs, err := s.experimentDirs()
+	if err != nil {
+		return err
+	}
+
+	if len(dirs) > 0 {
+		return nil
+	}
+
+	if err := s.createExperiment(defaultExperimentID, defaultExperimentName, "", nil); err != nil {
+		return fmt.Errorf("failed to create default experiment: %w", err)
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) CreateExperiment(
+	_ context.Context,
+	name string,
+	artifactLocation string,
+	tags []*entities.ExperimentTag,
+) (string, *contract.Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experiments, err := s.readExperiments()
+	if err != nil {
+		return "", err
+	}
+
+	for _, experiment := range experiments {
+		if experiment.Name == name {
+			return "", contract.NewError(
+				protos.ErrorCode_RESOURCE_ALREADY_EXISTS,
+				fmt.Sprintf("Experiment(name=%s) already exists.", name),
+			)
+		}
+	}
+
+	id := nextExperimentID(experiments)
+	if err := s.createExperiment(id, name, artifactLocation, tags); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (s TrackingFileStore) updateExperimentMeta(id string, update func(meta *experimentMeta)) *contract.Error {
+	dir, err := s.experimentDir(id)
+	if err != nil {
+		return err
+	}
+
+	meta, err := s.readExperimentMeta(dir)
+	if err != nil {
+		return err
+	}
+
+	update(meta)
+	meta.LastUpdateTime = time.Now().UnixMilli()
+
+	if err := filestore.WriteYAML(filepath.Join(dir, filestore.MetaFileName), meta); err != nil {
+		return newInternalError("failed to update experiment", err)
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) RenameExperiment(_ context.Context, experimentID, name string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.updateExperimentMeta(experimentID, func(meta *experimentMeta) {
+		meta.Name = name
+	})
+}
+
+// moveExperiment sets the lifecycle stage of an experiment and moves it to the matching folder,
+// deleted experiments live in the trash folder.
+func (s TrackingFileStore) moveExperiment(id, fromStage, toStage string) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, err := s.experimentDir(id)
+	if err != nil {
+		return err
+	}
+
+	meta, err := s.readExperimentMeta(dir)
+	if err != nil {
+		return err
+	}
+
+	if meta.LifecycleStage != fromStage {
+		return experimentDoesNotExist(id)
+	}
+
+	if err := s.updateExperimentMeta(id, func(meta *experimentMeta) {
+		meta.LifecycleStage = toStage
+	}); err != nil {
+		return err
+	}
+
+	target := filepath.Join(s.root, id)
+	if toStage == lifecycleStageDeleted {
+		target = filepath.Join(s.root, trashFolderName, id)
+	}
+
+	if err := os.Rename(dir, target); err != nil {
+		return newInternalError(fmt.Sprintf("failed to move experiment %s", id), err)
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) DeleteExperiment(_ context.Context, id string) *contract.Error {
+	return s.moveExperiment(id, lifecycleStageActive, lifecycleStageDeleted)
+}
+
+func (s TrackingFileStore) RestoreExperiment(_ context.Context, id string) *contract.Error {
+	return s.moveExperiment(id, lifecycleStageDeleted, lifecycleStageActive)
+}
+
+func (s TrackingFileStore) SetExperimentTag(
+	ctx context.Context, experimentID, key, value string,
+) *contract.Error {
+	experiment, err := s.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, err := s.experimentDir(experimentID)
+	if err != nil {
+		return err
+	}
+
+	if err := filestore.WriteFile(filestore.KeyPath(filepath.Join(dir, tagsFolder), key), []byte(value)); err != nil {
+		return newInternalError("failed to set experiment tag", err)
+	}
+
+	return nil
+}
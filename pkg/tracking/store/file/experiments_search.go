@@ -0,0 +1,183 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+func experimentTag(experiment *entities.Experiment, key string) *string {
+	for _, tag := range experiment.Tags {
+		if tag.Key == key {
+			return &tag.Value
+		}
+	}
+
+	return nil
+}
+
+func experimentMatches(experiment *entities.Experiment, condition *parser.ValidCompareExpr) bool {
+	if condition.Identifier == parser.Tag {
+		value := experimentTag(experiment, condition.Key)
+
+		//nolint:exhaustive
+		switch condition.Operator {
+		case parser.IsNull:
+			return value == nil
+		case parser.IsNotNull:
+			return value != nil
+		default:
+			return filestore.CompareStrings(value, condition.Operator, condition.Value)
+		}
+	}
+
+	switch condition.Key {
+	case parser.ExperimentCreationTime:
+		return filestore.CompareNumbers(
+			utils.PtrTo(float64(experiment.CreationTime)), condition.Operator, condition.Value,
+		)
+	case parser.ExperimentLastUpdateTime:
+		return filestore.CompareNumbers(
+			utils.PtrTo(float64(experiment.LastUpdateTime)), condition.Operator, condition.Value,
+		)
+	default:
+		return filestore.CompareStrings(&experiment.Name, condition.Operator, condition.Value)
+	}
+}
+
+func experimentSortValue(experiment *entities.Experiment, column string) filestore.SortValue {
+	switch column {
+	case "name":
+		return filestore.SortValue{Text: experiment.Name}
+	case "experiment_id":
+		id, err := strconv.ParseFloat(experiment.ExperimentID, 64)
+		if err != nil {
+			return filestore.SortValue{Text: experiment.ExperimentID}
+		}
+
+		return filestore.SortValue{Number: id}
+	case parser.ExperimentCreationTime:
+		return filestore.SortValue{Number: float64(experiment.CreationTime)}
+	default:
+		return filestore.SortValue{Number: float64(experiment.LastUpdateTime)}
+	}
+}
+
+// Port of the ordering of the SQL store, see applyExperimentsOrderBy.
+func experimentSortClauses(
+	experiments []*entities.Experiment, orderBy []string,
+) ([]filestore.SortClause, *contract.Error) {
+	clauses := make([]filestore.SortClause, 0, len(orderBy)+2)
+	columns := make([]string, 0, len(orderBy)+2)
+
+	for _, o := range orderBy {
+		parts := experimentOrder.FindStringSubmatch(o)
+		if len(parts) == 0 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", o),
+			)
+		}
+
+		column := parts[1]
+		switch column {
+		case "name", "experiment_id", "creation_time", "last_update_time":
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"invalid attribute '%s'. Valid values are ['name', 'experiment_id', 'creation_time', 'last_update_time']",
+					column,
+				),
+			)
+		}
+
+		columns = append(columns, column)
+		clauses = append(clauses, filestore.SortClause{
+			Descending: strings.ToUpper(parts[2]) == "DESC",
+			Value: func(index int) filestore.SortValue {
+				return experimentSortValue(experiments[index], column)
+			},
+		})
+	}
+
+	if len(orderBy) == 0 {
+		clauses = append(clauses, filestore.SortClause{
+			Descending: true,
+			Value: func(index int) filestore.SortValue {
+				return experimentSortValue(experiments[index], parser.ExperimentCreationTime)
+			},
+		})
+	}
+
+	if !slices.Contains(columns, "experiment_id") {
+		clauses = append(clauses, filestore.SortClause{
+			Value: func(index int) filestore.SortValue {
+				return experimentSortValue(experiments[index], "experiment_id")
+			},
+		})
+	}
+
+	return clauses, nil
+}
+
+func (s TrackingFileStore) SearchExperiments(
+	_ context.Context,
+	experimentViewType protos.ViewType,
+	maxResults int64,
+	filter string,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.Experiment, string, *contract.Error) {
+	filterExpression, parseErr := query.ParseExperimentFilter(filter)
+	if parseErr != nil {
+		return nil, "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", parseErr),
+		)
+	}
+
+	all, err := s.readExperiments()
+	if err != nil {
+		return nil, "", err
+	}
+
+	lifecycleStages := lifecycleStagesOf(experimentViewType)
+	experiments := make([]*entities.Experiment, 0, len(all))
+
+	for _, experiment := range all {
+		if !slices.Contains(lifecycleStages, experiment.LifecycleStage) {
+			continue
+		}
+
+		match := func(condition *parser.ValidCompareExpr) bool {
+			return experimentMatches(experiment, condition)
+		}
+
+		if filterExpression != nil && !filestore.MatchesFilter(filterExpression, match) {
+			continue
+		}
+
+		experiments = append(experiments, experiment)
+	}
+
+	clauses, err := experimentSortClauses(experiments, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return filestore.Paginate(filestore.SortByClauses(experiments, clauses), int(maxResults), pageToken)
+}
@@ -0,0 +1,235 @@
+package file
+
+import (
+	"cmp"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// The vertex types of the Python FileStore inputs.
+const (
+	inputVertexTypeRun     = 1
+	inputVertexTypeDataset = 2
+)
+
+const maxDatasetSummariesResults = 1000
+
+// datasetMeta is the content of <experiment_id>/datasets/<dataset_id>/meta.yaml.
+type datasetMeta struct {
+	Name       string `yaml:"name"`
+	Digest     string `yaml:"digest"`
+	SourceType string `yaml:"source_type"`
+	Source     string `yaml:"source"`
+	Schema     string `yaml:"schema,omitempty"`
+	Profile    string `yaml:"profile,omitempty"`
+}
+
+// inputMeta is the content of <experiment_id>/<run_id>/inputs/<input_id>/meta.yaml.
+type inputMeta struct {
+	SourceType      int               `yaml:"source_type"`
+	SourceID        string            `yaml:"source_id"`
+	DestinationType int               `yaml:"destination_type"`
+	DestinationID   string            `yaml:"destination_id"`
+	Tags            map[string]string `yaml:"tags"`
+}
+
+// md5ID derives the IDs of datasets and inputs from their content, like the Python FileStore.
+func md5ID(values ...string) string {
+	hash := md5.New() //nolint:gosec
+	for _, value := range values {
+		hash.Write([]byte(value))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// readDatasetInputs returns the dataset inputs of a run, the datasets are stored in the experiment directory.
+func readDatasetInputs(runDir string) ([]*entities.DatasetInput, *contract.Error) {
+	inputIDs, err := filestore.ListDirectories(filepath.Join(runDir, inputsFolder))
+	if err != nil {
+		return nil, newInternalError("failed to list run inputs", err)
+	}
+
+	slices.Sort(inputIDs)
+
+	datasetInputs := make([]*entities.DatasetInput, 0, len(inputIDs))
+
+	for _, inputID := range inputIDs {
+		var input inputMeta
+		inputPath := filepath.Join(runDir, inputsFolder, inputID, filestore.MetaFileName)
+		if err := filestore.ReadYAML(inputPath, &input); err != nil {
+			return nil, newInternalError("failed to read run input", err)
+		}
+
+		if input.SourceType != inputVertexTypeDataset || !filestore.IsValidName(input.SourceID) {
+			continue
+		}
+
+		var dataset datasetMeta
+		if err := filestore.ReadYAML(
+			filepath.Join(filepath.Dir(runDir), datasetsFolder, input.SourceID, filestore.MetaFileName), &dataset,
+		); err != nil {
+			return nil, newInternalError("failed to read dataset", err)
+		}
+
+		tags := make([]*entities.InputTag, 0, len(input.Tags))
+		for _, key := range filestore.SortedKeys(input.Tags) {
+			tags = append(tags, &entities.InputTag{Key: key, Value: input.Tags[key]})
+		}
+
+		datasetInputs = append(datasetInputs, &entities.DatasetInput{
+			Tags: tags,
+			Dataset: &entities.Dataset{
+				Name:       dataset.Name,
+				Digest:     dataset.Digest,
+				SourceType: dataset.SourceType,
+				Source:     dataset.Source,
+				Schema:     dataset.Schema,
+				Profile:    dataset.Profile,
+			},
+		})
+	}
+
+	return datasetInputs, nil
+}
+
+func (s TrackingFileStore) LogInputs(
+	_ context.Context, runID string, modelInputs []*entities.ModelInput, datasets []*entities.DatasetInput,
+) *contract.Error {
+	if len(modelInputs) > 0 {
+		return notSupported("logging model inputs")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, _, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	experimentDir := filepath.Dir(dir)
+
+	for _, datasetInput := range datasets {
+		dataset := datasetInput.Dataset
+		datasetID := md5ID(dataset.Name, dataset.Digest)
+
+		// A dataset is only written once, later inputs of the same dataset reference it.
+		datasetPath := filepath.Join(experimentDir, datasetsFolder, datasetID, filestore.MetaFileName)
+		if !filestore.Exists(datasetPath) {
+			if err := filestore.WriteYAML(datasetPath, datasetMeta{
+				Name:       dataset.Name,
+				Digest:     dataset.Digest,
+				SourceType: dataset.SourceType,
+				Source:     dataset.Source,
+				Schema:     dataset.Schema,
+				Profile:    dataset.Profile,
+			}); err != nil {
+				return newInternalError("failed to log dataset", err)
+			}
+		}
+
+		inputPath := filepath.Join(dir, inputsFolder, md5ID(datasetID, runID), filestore.MetaFileName)
+		if filestore.Exists(inputPath) {
+			continue
+		}
+
+		tags := make(map[string]string, len(datasetInput.Tags))
+		for _, tag := range datasetInput.Tags {
+			tags[tag.Key] = tag.Value
+		}
+
+		if err := filestore.WriteYAML(inputPath, inputMeta{
+			SourceType:      inputVertexTypeDataset,
+			SourceID:        datasetID,
+			DestinationType: inputVertexTypeRun,
+			DestinationID:   runID,
+			Tags:            tags,
+		}); err != nil {
+			return newInternalError("failed to log dataset input", err)
+		}
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) LogOutputs(context.Context, string, []*entities.ModelOutput) *contract.Error {
+	return notSupported("logging model outputs")
+}
+
+//nolint:cyclop
+func (s TrackingFileStore) SearchDatasets(
+	_ context.Context, experimentIDs []string,
+) ([]*entities.DatasetSummary, *contract.Error) {
+	summaries := make([]*entities.DatasetSummary, 0)
+	seen := make(map[entities.DatasetSummary]struct{})
+
+	for _, experimentID := range experimentIDs {
+		id, parseErr := strconv.ParseInt(experimentID, 10, 32)
+		if parseErr != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("failed to convert experiment id %q to int", experimentID),
+				parseErr,
+			)
+		}
+
+		experimentDir, err := s.experimentDir(experimentID)
+		if err != nil {
+			continue
+		}
+
+		dirs, err := runDirs(experimentDir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dir := range dirs {
+			datasetInputs, err := readDatasetInputs(dir)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, datasetInput := range datasetInputs {
+				summary := entities.DatasetSummary{
+					ExperimentID: int32(id),
+					Name:         datasetInput.Dataset.Name,
+					Digest:       datasetInput.Dataset.Digest,
+				}
+
+				for _, tag := range datasetInput.Tags {
+					if tag.Key == utils.TagDatasetContext {
+						summary.Context = tag.Value
+					}
+				}
+
+				if _, ok := seen[summary]; !ok {
+					seen[summary] = struct{}{}
+					summaries = append(summaries, &summary)
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(summaries, func(a, b *entities.DatasetSummary) int {
+		return cmp.Or(
+			cmp.Compare(a.ExperimentID, b.ExperimentID),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Digest, b.Digest),
+			cmp.Compare(a.Context, b.Context),
+		)
+	})
+
+	return summaries[:min(len(summaries), maxDatasetSummariesResults)], nil
+}
@@ -0,0 +1,75 @@
+package file
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+const (
+	trashFolderName = ".trash"
+	metricsFolder   = "metrics"
+	paramsFolder    = "params"
+	tagsFolder      = "tags"
+	inputsFolder    = "inputs"
+	datasetsFolder  = "datasets"
+)
+
+const (
+	lifecycleStageActive  = "active"
+	lifecycleStageDeleted = "deleted"
+)
+
+// experimentMeta is the content of <experiment_id>/meta.yaml.
+type experimentMeta struct {
+	ArtifactLocation string `yaml:"artifact_location"`
+	CreationTime     int64  `yaml:"creation_time"`
+	ExperimentID     string `yaml:"experiment_id"`
+	LastUpdateTime   int64  `yaml:"last_update_time"`
+	LifecycleStage   string `yaml:"lifecycle_stage"`
+	Name             string `yaml:"name"`
+}
+
+// sourceTypeLocal is the value of SourceType.LOCAL, which the Python FileStore writes for every run.
+const sourceTypeLocal = 4
+
+// runMeta is the content of <experiment_id>/<run_id>/meta.yaml.
+// The status is stored as the number of the RunStatus enum.
+type runMeta struct {
+	ArtifactURI    string   `yaml:"artifact_uri"`
+	EndTime        *int64   `yaml:"end_time"`
+	EntryPointName string   `yaml:"entry_point_name"`
+	ExperimentID   string   `yaml:"experiment_id"`
+	LifecycleStage string   `yaml:"lifecycle_stage"`
+	RunID          string   `yaml:"run_id"`
+	RunName        string   `yaml:"run_name"`
+	RunUUID        string   `yaml:"run_uuid"`
+	SourceName     string   `yaml:"source_name"`
+	SourceType     int      `yaml:"source_type"`
+	SourceVersion  string   `yaml:"source_version"`
+	StartTime      int64    `yaml:"start_time"`
+	Status         int32    `yaml:"status"`
+	Tags           []string `yaml:"tags"`
+	UserID         string   `yaml:"user_id"`
+	DeletedTime    *int64   `yaml:"deleted_time,omitempty"`
+}
+
+// formatMetricValue writes a float the way Python prints it, so that both stores can read the metric files.
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "nan"
+	case math.IsInf(value, 1):
+		return "inf"
+	case math.IsInf(value, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+func newInternalError(message string, err error) *contract.Error {
+	return contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, message, err)
+}
@@ -0,0 +1,290 @@
+package file
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
+)
+
+const (
+	metricLineParts       = 3
+	metricLineLegacyParts = 2
+)
+
+var errInvalidMetricLine = errors.New("invalid metric line")
+
+// parseMetricLine parses a "<timestamp> <value> <step>" line of a metric file,
+// the step is missing in the files of old MLflow versions.
+func parseMetricLine(key, line string) (*entities.Metric, error) {
+	parts := strings.Fields(line)
+	if len(parts) != metricLineParts && len(parts) != metricLineLegacyParts {
+		return nil, fmt.Errorf("%w %q of metric %q", errInvalidMetricLine, line, key)
+	}
+
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp of metric %q: %w", key, err)
+	}
+
+	value, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value of metric %q: %w", key, err)
+	}
+
+	step := int64(0)
+	if len(parts) == metricLineParts {
+		if step, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid step of metric %q: %w", key, err)
+		}
+	}
+
+	return &entities.Metric{
+		Key:       key,
+		Value:     value,
+		Timestamp: timestamp,
+		Step:      step,
+		IsNaN:     math.IsNaN(value),
+	}, nil
+}
+
+func parseMetricHistory(key, content string) ([]*entities.Metric, error) {
+	metrics := make([]*entities.Metric, 0)
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		metric, err := parseMetricLine(key, line)
+		if err != nil {
+			return nil, err
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+func readMetricHistory(dir, key string) ([]*entities.Metric, *contract.Error) {
+	content, err := os.ReadFile(filestore.KeyPath(filepath.Join(dir, metricsFolder), key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return make([]*entities.Metric, 0), nil
+		}
+
+		return nil, newInternalError(fmt.Sprintf("error getting metric history: %v", err), err)
+	}
+
+	metrics, err := parseMetricHistory(key, string(content))
+	if err != nil {
+		return nil, newInternalError(fmt.Sprintf("error getting metric history: %v", err), err)
+	}
+
+	return metrics, nil
+}
+
+// isNewerMetric reports whether a is logged after b, NaN values are the smallest ones.
+func isNewerMetric(a, b *entities.Metric) bool {
+	if a.Step != b.Step {
+		return a.Step > b.Step
+	}
+
+	if a.Timestamp != b.Timestamp {
+		return a.Timestamp > b.Timestamp
+	}
+
+	return !a.IsNaN && (b.IsNaN || a.Value > b.Value)
+}
+
+// readLatestMetrics returns the latest value of each metric of a run.
+func readLatestMetrics(dir string) ([]*entities.Metric, *contract.Error) {
+	values, err := filestore.ReadKeyValues(filepath.Join(dir, metricsFolder))
+	if err != nil {
+		return nil, newInternalError("failed to read run metrics", err)
+	}
+
+	metrics := make([]*entities.Metric, 0, len(values))
+
+	for _, key := range filestore.SortedKeys(values) {
+		history, err := parseMetricHistory(key, values[key])
+		if err != nil {
+			return nil, newInternalError("failed to read run metrics", err)
+		}
+
+		var latest *entities.Metric
+
+		for _, metric := range history {
+			if latest == nil || isNewerMetric(metric, latest) {
+				latest = metric
+			}
+		}
+
+		if latest != nil {
+			metrics = append(metrics, latest)
+		}
+	}
+
+	return metrics, nil
+}
+
+// logMetrics appends the metrics to the metric files of a run.
+func logMetrics(dir string, metrics []*entities.Metric) *contract.Error {
+	for _, metric := range metrics {
+		path := filestore.KeyPath(filepath.Join(dir, metricsFolder), metric.Key)
+
+		if err := os.MkdirAll(filepath.Dir(path), filestore.DirPermissions); err != nil {
+			return newInternalError(fmt.Sprintf("failed to log metric %q", metric.Key), err)
+		}
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filestore.FilePermissions)
+		if err != nil {
+			return newInternalError(fmt.Sprintf("failed to log metric %q", metric.Key), err)
+		}
+
+		value := metric.Value
+		if metric.IsNaN {
+			value = math.NaN()
+		}
+
+		_, err = fmt.Fprintf(file, "%d %s %d\n", metric.Timestamp, formatMetricValue(value), metric.Step)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return newInternalError(fmt.Sprintf("failed to log metric %q", metric.Key), err)
+		}
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) LogMetric(_ context.Context, runID string, metric *entities.Metric) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, _, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	return logMetrics(dir, []*entities.Metric{metric})
+}
+
+func (s TrackingFileStore) GetMetricHistory(
+	_ context.Context, runID, metricKey string,
+) ([]*entities.Metric, *contract.Error) {
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	return readMetricHistory(dir, metricKey)
+}
+
+// Port of the step sampling of the SQL store: the first and last step of every run are kept,
+// without a step range all steps from 0 to the last logged step are considered.
+//
+//nolint:cyclop,funlen
+func (s TrackingFileStore) GetMetricHistoryBulkInterval(
+	_ context.Context, runIDs []string, metricKey string, startStep, endStep *int64, maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	histories := make(map[string][]*entities.Metric, len(runIDs))
+	allSteps := make([]int64, 0)
+
+	for _, runID := range runIDs {
+		if _, ok := histories[runID]; ok {
+			continue
+		}
+
+		dir, err := s.runDir(runID)
+		if err != nil {
+			// Unknown runs have no metrics, like in the SQL store.
+			continue
+		}
+
+		history, err := readMetricHistory(dir, metricKey)
+		if err != nil {
+			return nil, err
+		}
+
+		histories[runID] = history
+		for _, metric := range history {
+			allSteps = append(allSteps, metric.Step)
+		}
+	}
+
+	slices.Sort(allSteps)
+	allSteps = slices.Compact(allSteps)
+
+	start, end := int64(0), int64(0)
+	if len(allSteps) > 0 {
+		end = allSteps[len(allSteps)-1]
+	}
+
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	}
+
+	sampledSteps := sql.SampleSteps(start, end, maxResults, allSteps)
+
+	for _, history := range histories {
+		if len(history) == 0 {
+			continue
+		}
+
+		minStep, maxStep := history[0].Step, history[0].Step
+		for _, metric := range history {
+			minStep, maxStep = min(minStep, metric.Step), max(maxStep, metric.Step)
+		}
+
+		for _, step := range []int64{minStep, maxStep} {
+			if start <= step && step <= end {
+				sampledSteps[step] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]*entities.MetricWithRunID, 0)
+
+	for _, runID := range runIDs {
+		history := make([]*entities.Metric, 0, len(histories[runID]))
+
+		for _, metric := range histories[runID] {
+			if _, ok := sampledSteps[metric.Step]; ok {
+				history = append(history, metric)
+			}
+		}
+
+		slices.SortStableFunc(history, func(a, b *entities.Metric) int {
+			return cmp.Or(
+				cmp.Compare(a.Step, b.Step),
+				cmp.Compare(a.Timestamp, b.Timestamp),
+				cmp.Compare(a.Value, b.Value),
+			)
+		})
+
+		for _, metric := range history[:min(len(history), sql.MaxResultsGetMetricHistory)] {
+			result = append(result, &entities.MetricWithRunID{Metric: *metric, RunID: runID})
+		}
+
+		// A duplicated run ID is only returned once.
+		delete(histories, runID)
+	}
+
+	return result, nil
+}
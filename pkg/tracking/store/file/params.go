@@ -0,0 +1,82 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func changedParamError(runID, key, oldValue, newValue string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf(
+			"Changing param values is not allowed. "+
+				"Params with key=%q was already logged "+
+				"with value=%q for run ID=%q. "+
+				"Attempted logging new value %q",
+			key,
+			oldValue,
+			runID,
+			newValue,
+		),
+	)
+}
+
+// logParams writes the params of a run, the params are all checked before the first one is written,
+// as a logged param can't change its value.
+func logParams(dir, runID string, params []*entities.Param) *contract.Error {
+	values := make(map[string]string, len(params))
+
+	for _, param := range params {
+		value := ""
+		if param.Value != nil {
+			value = *param.Value
+		}
+
+		if oldValue, ok := values[param.Key]; ok && oldValue != value {
+			return changedParamError(runID, param.Key, oldValue, value)
+		}
+
+		oldValue, err := os.ReadFile(filestore.KeyPath(filepath.Join(dir, paramsFolder), param.Key))
+
+		switch {
+		case err == nil && string(oldValue) != value:
+			return changedParamError(runID, param.Key, string(oldValue), value)
+		case err != nil && !errors.Is(err, fs.ErrNotExist):
+			return newInternalError(fmt.Sprintf("failed to read param %q of run %q", param.Key, runID), err)
+		}
+
+		values[param.Key] = value
+	}
+
+	for _, key := range filestore.SortedKeys(values) {
+		path := filestore.KeyPath(filepath.Join(dir, paramsFolder), key)
+		if err := filestore.WriteFile(path, []byte(values[key])); err != nil {
+			return newInternalError(fmt.Sprintf("error creating params for run_uuid %q", runID), err)
+		}
+	}
+
+	return nil
+}
+
+func (s TrackingFileStore) LogParam(
+	_ context.Context, runID string, param *entities.Param,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, _, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	return logParams(dir, runID, []*entities.Param{param})
+}
@@ -0,0 +1,389 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const artifactFolderName = "artifacts"
+
+func runDoesNotExist(runID string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Run with id=%s not found", runID),
+	)
+}
+
+func checkRunIsActive(meta *runMeta) *contract.Error {
+	if meta.LifecycleStage != lifecycleStageActive {
+		return contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"The run %s must be in the 'active' state.\n"+
+					"Current state is %v.",
+				meta.RunID,
+				meta.LifecycleStage,
+			),
+		)
+	}
+
+	return nil
+}
+
+// runDir returns the directory of a run, the run can be in any experiment including the deleted ones.
+func (s TrackingFileStore) runDir(runID string) (string, *contract.Error) {
+	if !filestore.IsValidName(runID) {
+		return "", runDoesNotExist(runID)
+	}
+
+	experimentDirs, err := s.experimentDirs()
+	if err != nil {
+		return "", err
+	}
+
+	for _, experimentDir := range experimentDirs {
+		if dir := filepath.Join(experimentDir, runID); filestore.Exists(filepath.Join(dir, filestore.MetaFileName)) {
+			return dir, nil
+		}
+	}
+
+	return "", runDoesNotExist(runID)
+}
+
+// runDirs returns the directories of the runs of an experiment.
+func runDirs(experimentDir string) ([]string, *contract.Error) {
+	names, err := filestore.ListDirectories(experimentDir)
+	if err != nil {
+		return nil, newInternalError("failed to list runs", err)
+	}
+
+	dirs := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if dir := filepath.Join(experimentDir, name); filestore.Exists(filepath.Join(dir, filestore.MetaFileName)) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs, nil
+}
+
+func readRunMeta(dir string) (*runMeta, *contract.Error) {
+	var meta runMeta
+	if err := filestore.ReadYAML(filepath.Join(dir, filestore.MetaFileName), &meta); err != nil {
+		return nil, newInternalError("failed to read run", err)
+	}
+
+	if meta.RunID == "" {
+		meta.RunID = meta.RunUUID
+	}
+
+	if meta.LifecycleStage == "" {
+		meta.LifecycleStage = lifecycleStageActive
+	}
+
+	return &meta, nil
+}
+
+func writeRunMeta(dir string, meta *runMeta) *contract.Error {
+	if err := filestore.WriteYAML(filepath.Join(dir, filestore.MetaFileName), meta); err != nil {
+		return newInternalError(fmt.Sprintf("failed to write run %s", meta.RunID), err)
+	}
+
+	return nil
+}
+
+// activeRunDir returns the directory and the metadata of a run that must be active.
+func (s TrackingFileStore) activeRunDir(runID string) (string, *runMeta, *contract.Error) {
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	meta, err := readRunMeta(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := checkRunIsActive(meta); err != nil {
+		return "", nil, err
+	}
+
+	return dir, meta, nil
+}
+
+func (s TrackingFileStore) readRun(dir string) (*entities.Run, *contract.Error) {
+	meta, err := readRunMeta(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	experimentID, parseErr := strconv.ParseInt(meta.ExperimentID, 10, 32)
+	if parseErr != nil {
+		return nil, newInternalError(
+			fmt.Sprintf("unsupported experiment id %q of run %s", meta.ExperimentID, meta.RunID),
+			parseErr,
+		)
+	}
+
+	metrics, err := readLatestMetrics(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	values, readErr := filestore.ReadKeyValues(filepath.Join(dir, paramsFolder))
+	if readErr != nil {
+		return nil, newInternalError("failed to read run params", readErr)
+	}
+
+	params := make([]*entities.Param, 0, len(values))
+	for _, key := range filestore.SortedKeys(values) {
+		params = append(params, &entities.Param{Key: key, Value: utils.PtrTo(values[key])})
+	}
+
+	values, readErr = filestore.ReadKeyValues(filepath.Join(dir, tagsFolder))
+	if readErr != nil {
+		return nil, newInternalError("failed to read run tags", readErr)
+	}
+
+	tags := make([]*entities.RunTag, 0, len(values))
+	for _, key := range filestore.SortedKeys(values) {
+		tags = append(tags, &entities.RunTag{Key: key, Value: values[key]})
+	}
+
+	datasetInputs, err := readDatasetInputs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Run{
+		Info: &entities.RunInfo{
+			RunID:          meta.RunID,
+			RunUUID:        meta.RunID,
+			RunName:        meta.RunName,
+			ExperimentID:   int32(experimentID),
+			UserID:         meta.UserID,
+			Status:         protos.RunStatus(meta.Status).String(),
+			StartTime:      meta.StartTime,
+			EndTime:        meta.EndTime,
+			ArtifactURI:    meta.ArtifactURI,
+			LifecycleStage: meta.LifecycleStage,
+		},
+		Data: &entities.RunData{
+			Tags:    tags,
+			Params:  params,
+			Metrics: metrics,
+		},
+		Inputs: &entities.RunInputs{
+			ModelInputs:   make([]*entities.ModelInput, 0),
+			DatasetInputs: datasetInputs,
+		},
+		Outputs: &entities.RunOutputs{
+			ModelOutputs: make([]*entities.ModelOutput, 0),
+		},
+	}, nil
+}
+
+func (s TrackingFileStore) GetRun(_ context.Context, runID string) (*entities.Run, *contract.Error) {
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readRun(dir)
+}
+
+func getRunNameFromTags(tags []*entities.RunTag) string {
+	for _, tag := range tags {
+		if tag.Key == utils.TagRunName {
+			return tag.Value
+		}
+	}
+
+	return ""
+}
+
+// Port of the run name resolution of the SQL store.
+func ensureRunName(runName string, tags []*entities.RunTag) (string, []*entities.RunTag, *contract.Error) {
+	runNameFromTags := getRunNameFromTags(tags)
+
+	switch {
+	case runName != "" && runNameFromTags != "" && runName != runNameFromTags:
+		return "", nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf(
+				"Both 'run_name' argument and 'mlflow.runName' tag are specified, but with "+
+					"different values (run_name='%s', mlflow.runName='%s').",
+				runName,
+				runNameFromTags,
+			),
+		)
+	case runName == "" && runNameFromTags == "":
+		randomName, err := utils.GenerateRandomName()
+		if err != nil {
+			return "", nil, newInternalError("failed to generate random run name", err)
+		}
+
+		runName = randomName
+	case runName == "":
+		runName = runNameFromTags
+	}
+
+	if runNameFromTags == "" {
+		tags = append(tags, &entities.RunTag{Key: utils.TagRunName, Value: runName})
+	}
+
+	return runName, tags, nil
+}
+
+func (s TrackingFileStore) CreateRun(
+	ctx context.Context,
+	experimentID, userID string,
+	startTime int64,
+	tags []*entities.RunTag,
+	runName string,
+) (*entities.Run, *contract.Error) {
+	experiment, err := s.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return nil, err
+	}
+
+	runID := utils.NewUUID()
+
+	artifactURI, appendErr := utils.AppendToURIPath(experiment.ArtifactLocation, runID, artifactFolderName)
+	if appendErr != nil {
+		return nil, newInternalError("failed to append run ID to experiment artifact location", appendErr)
+	}
+
+	runName, tags, err = ensureRunName(runName, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	experimentDir, err := s.experimentDir(experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(experimentDir, runID)
+	meta := &runMeta{
+		ArtifactURI:    artifactURI,
+		ExperimentID:   experiment.ExperimentID,
+		LifecycleStage: lifecycleStageActive,
+		RunID:          runID,
+		RunName:        runName,
+		RunUUID:        runID,
+		SourceType:     sourceTypeLocal,
+		StartTime:      startTime,
+		Status:         int32(protos.RunStatus_RUNNING),
+		Tags:           make([]string, 0),
+		UserID:         userID,
+	}
+
+	if err := setTags(dir, meta, tags); err != nil {
+		return nil, err
+	}
+
+	return s.readRun(dir)
+}
+
+func (s TrackingFileStore) UpdateRun(
+	_ context.Context,
+	runID string,
+	runStatus string,
+	endTime *int64,
+	runName string,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := readRunMeta(dir)
+	if err != nil {
+		return err
+	}
+
+	if status, ok := protos.RunStatus_value[runStatus]; ok {
+		meta.Status = status
+	}
+
+	if endTime != nil {
+		meta.EndTime = endTime
+	}
+
+	if runName == "" {
+		return writeRunMeta(dir, meta)
+	}
+
+	return setTags(dir, meta, []*entities.RunTag{{Key: utils.TagRunName, Value: runName}})
+}
+
+func (s TrackingFileStore) setRunLifecycleStage(runID, lifecycleStage string, deletedTime *int64) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return err
+	}
+
+	meta, err := readRunMeta(dir)
+	if err != nil {
+		return err
+	}
+
+	meta.LifecycleStage = lifecycleStage
+	meta.DeletedTime = deletedTime
+
+	return writeRunMeta(dir, meta)
+}
+
+func (s TrackingFileStore) DeleteRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageDeleted, utils.PtrTo(time.Now().UnixMilli()))
+}
+
+func (s TrackingFileStore) RestoreRun(_ context.Context, runID string) *contract.Error {
+	return s.setRunLifecycleStage(runID, lifecycleStageActive, nil)
+}
+
+func (s TrackingFileStore) LogBatch(
+	_ context.Context, runID string, metrics []*entities.Metric, params []*entities.Param, tags []*entities.RunTag,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, meta, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	if err := logParams(dir, runID, params); err != nil {
+		return err
+	}
+
+	if err := logMetrics(dir, metrics); err != nil {
+		return err
+	}
+
+	return setTags(dir, meta, tags)
+}
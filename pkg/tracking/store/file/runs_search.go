@@ -0,0 +1,294 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func runMetric(run *entities.Run, key string) *entities.Metric {
+	for _, metric := range run.Data.Metrics {
+		if metric.Key == key {
+			return metric
+		}
+	}
+
+	return nil
+}
+
+func runParam(run *entities.Run, key string) *string {
+	for _, param := range run.Data.Params {
+		if param.Key == key {
+			return param.Value
+		}
+	}
+
+	return nil
+}
+
+func runTag(run *entities.Run, key string) *string {
+	for _, tag := range run.Data.Tags {
+		if tag.Key == key {
+			return &tag.Value
+		}
+	}
+
+	return nil
+}
+
+// runAttribute returns the value of an attribute, either a string or a number.
+// The keys are the ones of the filters, where run_id is named after the run_uuid column.
+func runAttribute(run *entities.Run, key string) (*string, *float64, bool) {
+	switch key {
+	case "run_uuid", parser.RunID:
+		return &run.Info.RunID, nil, true
+	case parser.RunName:
+		return &run.Info.RunName, nil, true
+	case "user_id":
+		return &run.Info.UserID, nil, true
+	case "status":
+		return &run.Info.Status, nil, true
+	case "artifact_uri":
+		return &run.Info.ArtifactURI, nil, true
+	case "lifecycle_stage":
+		return &run.Info.LifecycleStage, nil, true
+	case parser.StartTime:
+		return nil, utils.PtrTo(float64(run.Info.StartTime)), true
+	case "end_time":
+		if run.Info.EndTime == nil {
+			return nil, nil, true
+		}
+
+		return nil, utils.PtrTo(float64(*run.Info.EndTime)), true
+	default:
+		return nil, nil, false
+	}
+}
+
+func runDatasetsMatch(run *entities.Run, condition *parser.ValidCompareExpr) bool {
+	for _, datasetInput := range run.Inputs.DatasetInputs {
+		var value *string
+
+		switch condition.Key {
+		case "name":
+			value = &datasetInput.Dataset.Name
+		case "digest":
+			value = &datasetInput.Dataset.Digest
+		case "context":
+			for _, tag := range datasetInput.Tags {
+				if tag.Key == utils.TagDatasetContext {
+					value = &tag.Value
+				}
+			}
+		}
+
+		if filestore.CompareStrings(value, condition.Operator, condition.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func runMatches(run *entities.Run, condition *parser.ValidCompareExpr) bool {
+	var (
+		text    *string
+		number  *float64
+		present bool
+	)
+
+	//nolint:exhaustive
+	switch condition.Identifier {
+	case parser.Metric:
+		if metric := runMetric(run, condition.Key); metric != nil {
+			number, present = &metric.Value, true
+		}
+	case parser.Parameter:
+		text = runParam(run, condition.Key)
+		present = text != nil
+	case parser.Tag:
+		text = runTag(run, condition.Key)
+		present = text != nil
+	case parser.Attribute:
+		text, number, _ = runAttribute(run, condition.Key)
+	case parser.Dataset:
+		return runDatasetsMatch(run, condition)
+	}
+
+	//nolint:exhaustive
+	switch condition.Operator {
+	case parser.IsNull:
+		return !present
+	case parser.IsNotNull:
+		return present
+	}
+
+	if number != nil {
+		return filestore.CompareNumbers(number, condition.Operator, condition.Value)
+	}
+
+	return filestore.CompareStrings(text, condition.Operator, condition.Value)
+}
+
+func runSortValue(run *entities.Run, order orderBy) filestore.SortValue {
+	switch order.identifier {
+	case "metric", "metrics":
+		metric := runMetric(run, order.key)
+		if metric == nil || math.IsNaN(metric.Value) {
+			return filestore.SortValue{Missing: true}
+		}
+
+		return filestore.SortValue{Number: metric.Value}
+	case "param", "params", "parameter", "parameters":
+		if value := runParam(run, order.key); value != nil {
+			return filestore.SortValue{Text: *value}
+		}
+	case "tag", "tags":
+		if value := runTag(run, order.key); value != nil {
+			return filestore.SortValue{Text: *value}
+		}
+	default:
+		text, number, _ := runAttribute(run, runOrderByAttribute(order.key))
+
+		switch {
+		case number != nil:
+			return filestore.SortValue{Number: *number}
+		case text != nil:
+			return filestore.SortValue{Text: *text}
+		}
+	}
+
+	return filestore.SortValue{Missing: true}
+}
+
+// runOrderByAttribute resolves the aliases of the attributes in order_by clauses.
+func runOrderByAttribute(key string) string {
+	switch key {
+	case parser.Created, "Created":
+		return parser.StartTime
+	case "run name", "Run name", "Run Name":
+		return parser.RunName
+	default:
+		return key
+	}
+}
+
+func runSortClauses(runs []*entities.Run, orderBy []string) ([]filestore.SortClause, *contract.Error) {
+	clauses := make([]filestore.SortClause, 0, len(orderBy)+2)
+
+	for _, clause := range orderBy {
+		order, err := parseOrderBy(clause)
+		if err != nil {
+			return nil, err
+		}
+
+		switch order.identifier {
+		case "", "attribute", "attributes", "attr", "run":
+			if _, _, ok := runAttribute(runs[0], runOrderByAttribute(order.key)); !ok {
+				return nil, contract.NewError(
+					protos.ErrorCode_INVALID_PARAMETER_VALUE,
+					fmt.Sprintf("invalid order_by clause %q.", clause),
+				)
+			}
+		case "metric", "metrics", "param", "params", "parameter", "parameters", "tag", "tags":
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause %q.", clause),
+			)
+		}
+
+		clauses = append(clauses, filestore.SortClause{
+			Descending: order.descending,
+			Value: func(index int) filestore.SortValue {
+				return runSortValue(runs[index], order)
+			},
+		})
+	}
+
+	// Ties are ordered by the most recent runs first.
+	return append(clauses, filestore.SortClause{
+		Descending: true,
+		Value: func(index int) filestore.SortValue {
+			return filestore.SortValue{Number: float64(runs[index].Info.StartTime)}
+		},
+	}, filestore.SortClause{
+		Value: func(index int) filestore.SortValue {
+			return filestore.SortValue{Text: runs[index].Info.RunID}
+		},
+	}), nil
+}
+
+//nolint:cyclop
+func (s TrackingFileStore) SearchRuns(
+	ctx context.Context,
+	experimentIDs []string, filter string,
+	runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string,
+) ([]*entities.Run, string, *contract.Error) {
+	filterExpression, parseErr := query.ParseFilter(filter)
+	if parseErr != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"error parsing search filter",
+			parseErr,
+		)
+	}
+
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpression)
+
+	lifecycleStages := lifecycleStagesOf(runViewType)
+	runs := make([]*entities.Run, 0)
+
+	for _, experimentID := range experimentIDs {
+		experimentDir, err := s.experimentDir(experimentID)
+		if err != nil {
+			continue
+		}
+
+		dirs, err := runDirs(experimentDir)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, dir := range dirs {
+			run, err := s.readRun(dir)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if !slices.Contains(lifecycleStages, run.Info.LifecycleStage) {
+				continue
+			}
+
+			match := func(condition *parser.ValidCompareExpr) bool {
+				return runMatches(run, condition)
+			}
+
+			if filterExpression != nil && !filestore.MatchesFilter(filterExpression, match) {
+				continue
+			}
+
+			runs = append(runs, run)
+		}
+	}
+
+	if len(runs) == 0 {
+		return runs, "", nil
+	}
+
+	clauses, err := runSortClauses(runs, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return filestore.Paginate(filestore.SortByClauses(runs, clauses), maxResults, pageToken)
+}
@@ -0,0 +1,47 @@
+package file
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+func lifecycleStagesOf(viewType protos.ViewType) []string {
+	switch viewType {
+	case protos.ViewType_ACTIVE_ONLY:
+		return []string{lifecycleStageActive}
+	case protos.ViewType_DELETED_ONLY:
+		return []string{lifecycleStageDeleted}
+	default:
+		return []string{lifecycleStageActive, lifecycleStageDeleted}
+	}
+}
+
+var orderByClauseExpression = regexp.MustCompile(
+	"^\\s*(?:(\\w+)\\.)?(`[^`]+`|\"[^\"]+\"|[^\\s`\"]+)(?i:\\s+(ASC|DESC))?\\s*$",
+)
+
+type orderBy struct {
+	identifier string
+	key        string
+	descending bool
+}
+
+func parseOrderBy(clause string) (orderBy, *contract.Error) {
+	parts := orderByClauseExpression.FindStringSubmatch(clause)
+	if parts == nil {
+		return orderBy{}, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid order_by clause %q.", clause),
+		)
+	}
+
+	return orderBy{
+		identifier: strings.ToLower(parts[1]),
+		key:        strings.Trim(parts[2], "`\""),
+		descending: strings.EqualFold(parts[3], "DESC"),
+	}, nil
+}
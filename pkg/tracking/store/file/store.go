@@ -0,0 +1,72 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var errNotAFileStoreURI = errors.New("store URI is neither a local path nor a file:// URI")
+
+// TrackingFileStore reads and writes the mlruns directory layout of the Python FileStore.
+//
+//	<root>/<experiment_id>/meta.yaml
+//	<root>/<experiment_id>/tags/<key>
+//	<root>/<experiment_id>/<run_id>/meta.yaml
+//	<root>/<experiment_id>/<run_id>/{metrics,params,tags}/<key>
+//	<root>/.trash/<experiment_id>/...
+type TrackingFileStore struct {
+	config *config.Config
+	root   string
+	// mutex serializes the writes, the file store has no transactions.
+	mutex *sync.Mutex
+}
+
+func NewTrackingFileStore(ctx context.Context, config *config.Config) (*TrackingFileStore, error) {
+	root, ok := utils.LocalPathFromURI(config.TrackingStoreURI)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errNotAFileStoreURI, config.TrackingStoreURI)
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve file store root %q: %w", config.TrackingStoreURI, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, trashFolderName), filestore.DirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create file store root %q: %w", root, err)
+	}
+
+	store := &TrackingFileStore{
+		config: config,
+		root:   root,
+		mutex:  &sync.Mutex{},
+	}
+
+	if err := store.ensureDefaultExperiment(ctx); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s TrackingFileStore) Destroy() error {
+	return nil
+}
+
+// artifactRoot is the root of the experiment artifact locations,
+// the store root itself when no default artifact root is configured.
+func (s TrackingFileStore) artifactRoot() string {
+	if s.config.DefaultArtifactRoot != "" {
+		return s.config.DefaultArtifactRoot
+	}
+
+	return "file://" + filepath.ToSlash(s.root)
+}
@@ -0,0 +1,207 @@
+package file
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func newTestStore(t *testing.T) *TrackingFileStore {
+	t.Helper()
+
+	store, err := NewTrackingFileStore(context.Background(), &config.Config{
+		TrackingStoreURI:    "file://" + t.TempDir(),
+		DefaultArtifactRoot: "mlflow-artifacts:/",
+	})
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestRunRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	experiment, err := store.GetExperimentByName(ctx, "Default")
+	require.Nil(t, err)
+	assert.Equal(t, "0", experiment.ExperimentID)
+
+	experimentID, err := store.CreateExperiment(
+		ctx, "experiment", "", []*entities.ExperimentTag{{Key: "team", Value: "ml"}},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "1", experimentID)
+
+	run, err := store.CreateRun(ctx, experimentID, "user", 1000, nil, "run")
+	require.Nil(t, err)
+	assert.Equal(t, "mlflow-artifacts:/1/"+run.Info.RunID+"/artifacts", run.Info.ArtifactURI)
+
+	require.Nil(t, store.LogBatch(
+		ctx,
+		run.Info.RunID,
+		[]*entities.Metric{
+			{Key: "loss", Value: 0.5, Timestamp: 1, Step: 0},
+			{Key: "loss", Value: 0.25, Timestamp: 2, Step: 1},
+		},
+		[]*entities.Param{{Key: "nested/param", Value: utils.PtrTo("value")}},
+		[]*entities.RunTag{{Key: "tag", Value: "value"}},
+	))
+
+	err = store.LogBatch(
+		ctx, run.Info.RunID, nil, []*entities.Param{{Key: "nested/param", Value: utils.PtrTo("changed")}}, nil,
+	)
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+
+	endTime := int64(2000)
+	require.Nil(t, store.UpdateRun(ctx, run.Info.RunID, "FINISHED", &endTime, ""))
+
+	run, err = store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+	assert.Equal(t, "run", run.Info.RunName)
+	assert.Equal(t, "FINISHED", run.Info.Status)
+	assert.Equal(t, &endTime, run.Info.EndTime)
+	require.Len(t, run.Data.Metrics, 1)
+	assert.InDelta(t, 0.25, run.Data.Metrics[0].Value, 0)
+	require.Len(t, run.Data.Params, 1)
+	assert.Equal(t, "nested/param", run.Data.Params[0].Key)
+
+	history, err := store.GetMetricHistory(ctx, run.Info.RunID, "loss")
+	require.Nil(t, err)
+	assert.Len(t, history, 2)
+
+	require.Nil(t, store.DeleteExperiment(ctx, experimentID))
+
+	_, err = store.CreateRun(ctx, experimentID, "user", 1000, nil, "")
+	require.NotNil(t, err)
+
+	// Runs of deleted experiments can still be read.
+	_, err = store.GetRun(ctx, run.Info.RunID)
+	require.Nil(t, err)
+}
+
+func TestSearchRuns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	runIDs := make([]string, 0, 3)
+
+	for index, accuracy := range []float64{0.9, 0.7, 0.8} {
+		run, err := store.CreateRun(ctx, "0", "user", int64(index), nil, "")
+		require.Nil(t, err)
+
+		require.Nil(t, store.LogMetric(ctx, run.Info.RunID, &entities.Metric{Key: "accuracy", Value: accuracy}))
+
+		runIDs = append(runIDs, run.Info.RunID)
+	}
+
+	require.Nil(t, store.SetTag(ctx, runIDs[1], "best", "no"))
+
+	testData := []struct {
+		name     string
+		filter   string
+		orderBy  []string
+		expected []string
+	}{
+		{
+			name:     "DefaultOrder",
+			expected: []string{runIDs[2], runIDs[1], runIDs[0]},
+		},
+		{
+			name:     "OrderByMetric",
+			orderBy:  []string{"metrics.accuracy DESC"},
+			expected: []string{runIDs[0], runIDs[2], runIDs[1]},
+		},
+		{
+			name:     "MetricFilter",
+			filter:   "metrics.accuracy > 0.75",
+			orderBy:  []string{"start_time"},
+			expected: []string{runIDs[0], runIDs[2]},
+		},
+		{
+			name:     "OrFilter",
+			filter:   "tags.best = 'no' OR metrics.accuracy >= 0.9",
+			orderBy:  []string{"attributes.start_time ASC"},
+			expected: []string{runIDs[0], runIDs[1]},
+		},
+		{
+			name:     "IsNullFilter",
+			filter:   "tags.best IS NULL",
+			expected: []string{runIDs[2], runIDs[0]},
+		},
+	}
+
+	for _, testData := range testData {
+		t.Run(testData.name, func(t *testing.T) {
+			t.Parallel()
+
+			runs, _, err := store.SearchRuns(
+				ctx, []string{"0"}, testData.filter, protos.ViewType_ACTIVE_ONLY, 10, testData.orderBy, "",
+			)
+			require.Nil(t, err)
+
+			actual := make([]string, 0, len(runs))
+			for _, run := range runs {
+				actual = append(actual, run.Info.RunID)
+			}
+
+			assert.Equal(t, testData.expected, actual)
+		})
+	}
+
+	runs, pageToken, err := store.SearchRuns(ctx, []string{"0"}, "", protos.ViewType_ALL, 2, nil, "")
+	require.Nil(t, err)
+	assert.Len(t, runs, 2)
+	assert.NotEmpty(t, pageToken)
+
+	runs, pageToken, err = store.SearchRuns(ctx, []string{"0"}, "", protos.ViewType_ALL, 2, nil, pageToken)
+	require.Nil(t, err)
+	assert.Equal(t, runIDs[0], runs[0].Info.RunID)
+	assert.Empty(t, pageToken)
+}
+
+func TestSearchExperiments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	for _, name := range []string{"a", "b"} {
+		_, err := store.CreateExperiment(ctx, name, "", nil)
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, store.SetExperimentTag(ctx, "2", "key", "value"))
+	require.Nil(t, store.DeleteExperiment(ctx, "1"))
+
+	experiments, _, err := store.SearchExperiments(ctx, protos.ViewType_ALL, 10, "", []string{"name DESC"}, "")
+	require.Nil(t, err)
+
+	names := make([]string, 0, len(experiments))
+	for _, experiment := range experiments {
+		names = append(names, experiment.Name)
+	}
+
+	assert.Equal(t, []string{"b", "a", "Default"}, names)
+
+	experiments, _, err = store.SearchExperiments(ctx, protos.ViewType_ACTIVE_ONLY, 10, "tags.key = 'value'", nil, "")
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, "b", experiments[0].Name)
+
+	experiments, _, err = store.SearchExperiments(ctx, protos.ViewType_DELETED_ONLY, 10, "", nil, "")
+	require.Nil(t, err)
+	require.Len(t, experiments, 1)
+	assert.Equal(t, "a", experiments[0].Name)
+}
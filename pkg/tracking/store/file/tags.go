@@ -0,0 +1,94 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/filestore"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// setTags writes the tags of a run, the run name and user tags also update the run metadata.
+func setTags(dir string, meta *runMeta, tags []*entities.RunTag) *contract.Error {
+	for _, tag := range tags {
+		switch tag.Key {
+		case utils.TagUser:
+			meta.UserID = tag.Value
+		case utils.TagRunName:
+			meta.RunName = tag.Value
+		}
+
+		path := filestore.KeyPath(filepath.Join(dir, tagsFolder), tag.Key)
+		if err := filestore.WriteFile(path, []byte(tag.Value)); err != nil {
+			return newInternalError(fmt.Sprintf("failed to create tags for run %q", meta.RunID), err)
+		}
+	}
+
+	return writeRunMeta(dir, meta)
+}
+
+func (s TrackingFileStore) GetRunTag(
+	_ context.Context, runID, tagKey string,
+) (*entities.RunTag, *contract.Error) {
+	dir, err := s.runDir(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, readErr := os.ReadFile(filestore.KeyPath(filepath.Join(dir, tagsFolder), tagKey))
+	if readErr != nil {
+		if errors.Is(readErr, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, newInternalError(fmt.Sprintf("failed to get run tag for run id %q", runID), readErr)
+	}
+
+	return &entities.RunTag{Key: tagKey, Value: string(value)}, nil
+}
+
+func (s TrackingFileStore) SetTag(
+	_ context.Context, runID, key, value string,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, meta, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	return setTags(dir, meta, []*entities.RunTag{{Key: key, Value: value}})
+}
+
+func (s TrackingFileStore) DeleteTag(
+	_ context.Context, runID, key string,
+) *contract.Error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	dir, _, err := s.activeRunDir(runID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filestore.KeyPath(filepath.Join(dir, tagsFolder), key)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("No tag with name: %s in run with id %s", key, runID),
+			)
+		}
+
+		return newInternalError(fmt.Sprintf("failed to delete tag %q of run %q", key, runID), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package file
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// Traces, assessments and logged models are not implemented by the Go file store,
+// the server forwards the requests answered with NOT_IMPLEMENTED to the Python FileStore.
+
+func notSupported(feature string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_NOT_IMPLEMENTED,
+		fmt.Sprintf("%s is not supported by the Go file store", feature),
+	)
+}
+
+func (s TrackingFileStore) SetTrace(
+	context.Context, string, int64, []*entities.TraceRequestMetadata, []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) EndTrace(
+	context.Context, string, int64, string, []*entities.TraceRequestMetadata, []*entities.TraceTag,
+) (*entities.TraceInfo, error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) GetTraceInfo(context.Context, string) (*entities.TraceInfo, *contract.Error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) SearchTraces(
+	context.Context, []string, string, int64, []string, string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	return nil, "", notSupported("tracing")
+}
+
+func (s TrackingFileStore) SetTraceTag(context.Context, string, string, string) error {
+	return notSupported("tracing")
+}
+
+func (s TrackingFileStore) GetTraceTag(context.Context, string, string) (*entities.TraceTag, *contract.Error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) DeleteTraceTag(context.Context, *entities.TraceTag) *contract.Error {
+	return notSupported("tracing")
+}
+
+func (s TrackingFileStore) DeleteTraces(context.Context, string, int64, int32, []string) (int32, *contract.Error) {
+	return 0, notSupported("tracing")
+}
+
+func (s TrackingFileStore) CreateAssessment(
+	context.Context, *entities.Assessment,
+) (*entities.Assessment, *contract.Error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) UpdateAssessment(
+	context.Context, string, string, *entities.AssessmentUpdate,
+) (*entities.Assessment, *contract.Error) {
+	return nil, notSupported("tracing")
+}
+
+func (s TrackingFileStore) DeleteAssessment(context.Context, string, string) *contract.Error {
+	return notSupported("tracing")
+}
+
+func (s TrackingFileStore) CreateLoggedModel(
+	context.Context, string, string, string, string, []*entities.LoggedModelParameter, []*entities.LoggedModelTag,
+) (*entities.LoggedModel, *contract.Error) {
+	return nil, notSupported("logged models")
+}
+
+func (s TrackingFileStore) GetLoggedModel(context.Context, string) (*entities.LoggedModel, *contract.Error) {
+	return nil, notSupported("logged models")
+}
+
+func (s TrackingFileStore) FinalizeLoggedModel(
+	context.Context, string, protos.LoggedModelStatus,
+) (*entities.LoggedModel, *contract.Error) {
+	return nil, notSupported("logged models")
+}
+
+func (s TrackingFileStore) DeleteLoggedModel(context.Context, string) *contract.Error {
+	return notSupported("logged models")
+}
+
+func (s TrackingFileStore) SearchLoggedModels(
+	context.Context,
+	[]string,
+	string,
+	[]*entities.LoggedModelDataset,
+	int64,
+	[]*entities.LoggedModelOrderBy,
+	string,
+) ([]*entities.LoggedModel, string, *contract.Error) {
+	return nil, "", notSupported("logged models")
+}
+
+func (s TrackingFileStore) SetLoggedModelTags(context.Context, string, []*entities.LoggedModelTag) *contract.Error {
+	return notSupported("logged models")
+}
+
+func (s TrackingFileStore) DeleteLoggedModelTag(context.Context, string, string) *contract.Error {
+	return notSupported("logged models")
+}
+
+func (s TrackingFileStore) LogLoggedModelParams(
+	context.Context, string, []*entities.LoggedModelParameter,
+) *contract.Error {
+	return notSupported("logged models")
+}
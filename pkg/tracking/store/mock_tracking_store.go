@@ -26,6 +26,67 @@ func (_m *MockTrackingStore) EXPECT() *MockTrackingStore_Expecter {
 	return &MockTrackingStore_Expecter{mock: &_m.Mock}
 }
 
+// CreateAssessment provides a mock function with given fields: ctx, assessment
+func (_m *MockTrackingStore) CreateAssessment(ctx context.Context, assessment *entities.Assessment) (*entities.Assessment, *contract.Error) {
+	ret := _m.Called(ctx, assessment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssessment")
+	}
+
+	var r0 *entities.Assessment
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Assessment) (*entities.Assessment, *contract.Error)); ok {
+		return rf(ctx, assessment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Assessment) *entities.Assessment); ok {
+		r0 = rf(ctx, assessment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Assessment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.Assessment) *contract.Error); ok {
+		r1 = rf(ctx, assessment)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_CreateAssessment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssessment'
+type MockTrackingStore_CreateAssessment_Call struct {
+	*mock.Call
+}
+
+// CreateAssessment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assessment *entities.Assessment
+func (_e *MockTrackingStore_Expecter) CreateAssessment(ctx interface{}, assessment interface{}) *MockTrackingStore_CreateAssessment_Call {
+	return &MockTrackingStore_CreateAssessment_Call{Call: _e.mock.On("CreateAssessment", ctx, assessment)}
+}
+
+func (_c *MockTrackingStore_CreateAssessment_Call) Run(run func(ctx context.Context, assessment *entities.Assessment)) *MockTrackingStore_CreateAssessment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Assessment))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateAssessment_Call) Return(_a0 *entities.Assessment, _a1 *contract.Error) *MockTrackingStore_CreateAssessment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateAssessment_Call) RunAndReturn(run func(context.Context, *entities.Assessment) (*entities.Assessment, *contract.Error)) *MockTrackingStore_CreateAssessment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateExperiment provides a mock function with given fields: ctx, name, artifactLocation, tags
 func (_m *MockTrackingStore) CreateExperiment(ctx context.Context, name string, artifactLocation string, tags []*entities.ExperimentTag) (string, *contract.Error) {
 	ret := _m.Called(ctx, name, artifactLocation, tags)
@@ -87,6 +148,72 @@ func (_c *MockTrackingStore_CreateExperiment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateLoggedModel provides a mock function with given fields: ctx, experimentID, name, modelType, sourceRunID, params, tags
+func (_m *MockTrackingStore) CreateLoggedModel(ctx context.Context, experimentID string, name string, modelType string, sourceRunID string, params []*entities.LoggedModelParameter, tags []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoggedModel")
+	}
+
+	var r0 *entities.LoggedModel
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []*entities.LoggedModelParameter, []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []*entities.LoggedModelParameter, []*entities.LoggedModelTag) *entities.LoggedModel); ok {
+		r0 = rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []*entities.LoggedModelParameter, []*entities.LoggedModelTag) *contract.Error); ok {
+		r1 = rf(ctx, experimentID, name, modelType, sourceRunID, params, tags)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_CreateLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoggedModel'
+type MockTrackingStore_CreateLoggedModel_Call struct {
+	*mock.Call
+}
+
+// CreateLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentID string
+//   - name string
+//   - modelType string
+//   - sourceRunID string
+//   - params []*entities.LoggedModelParameter
+//   - tags []*entities.LoggedModelTag
+func (_e *MockTrackingStore_Expecter) CreateLoggedModel(ctx interface{}, experimentID interface{}, name interface{}, modelType interface{}, sourceRunID interface{}, params interface{}, tags interface{}) *MockTrackingStore_CreateLoggedModel_Call {
+	return &MockTrackingStore_CreateLoggedModel_Call{Call: _e.mock.On("CreateLoggedModel", ctx, experimentID, name, modelType, sourceRunID, params, tags)}
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) Run(run func(ctx context.Context, experimentID string, name string, modelType string, sourceRunID string, params []*entities.LoggedModelParameter, tags []*entities.LoggedModelTag)) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]*entities.LoggedModelParameter), args[6].([]*entities.LoggedModelTag))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_CreateLoggedModel_Call) RunAndReturn(run func(context.Context, string, string, string, string, []*entities.LoggedModelParameter, []*entities.LoggedModelTag) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_CreateLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRun provides a mock function with given fields: ctx, experimentID, userID, startTime, tags, runName
 func (_m *MockTrackingStore) CreateRun(ctx context.Context, experimentID string, userID string, startTime int64, tags []*entities.RunTag, runName string) (*entities.Run, *contract.Error) {
 	ret := _m.Called(ctx, experimentID, userID, startTime, tags, runName)
@@ -152,6 +279,56 @@ func (_c *MockTrackingStore_CreateRun_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DeleteAssessment provides a mock function with given fields: ctx, traceID, assessmentID
+func (_m *MockTrackingStore) DeleteAssessment(ctx context.Context, traceID string, assessmentID string) *contract.Error {
+	ret := _m.Called(ctx, traceID, assessmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssessment")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *contract.Error); ok {
+		r0 = rf(ctx, traceID, assessmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_DeleteAssessment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAssessment'
+type MockTrackingStore_DeleteAssessment_Call struct {
+	*mock.Call
+}
+
+// DeleteAssessment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - traceID string
+//   - assessmentID string
+func (_e *MockTrackingStore_Expecter) DeleteAssessment(ctx interface{}, traceID interface{}, assessmentID interface{}) *MockTrackingStore_DeleteAssessment_Call {
+	return &MockTrackingStore_DeleteAssessment_Call{Call: _e.mock.On("DeleteAssessment", ctx, traceID, assessmentID)}
+}
+
+func (_c *MockTrackingStore_DeleteAssessment_Call) Run(run func(ctx context.Context, traceID string, assessmentID string)) *MockTrackingStore_DeleteAssessment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteAssessment_Call) Return(_a0 *contract.Error) *MockTrackingStore_DeleteAssessment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteAssessment_Call) RunAndReturn(run func(context.Context, string, string) *contract.Error) *MockTrackingStore_DeleteAssessment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteExperiment provides a mock function with given fields: ctx, id
 func (_m *MockTrackingStore) DeleteExperiment(ctx context.Context, id string) *contract.Error {
 	ret := _m.Called(ctx, id)
@@ -201,6 +378,105 @@ func (_c *MockTrackingStore_DeleteExperiment_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DeleteLoggedModel provides a mock function with given fields: ctx, modelID
+func (_m *MockTrackingStore) DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error {
+	ret := _m.Called(ctx, modelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLoggedModel")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string) *contract.Error); ok {
+		r0 = rf(ctx, modelID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_DeleteLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoggedModel'
+type MockTrackingStore_DeleteLoggedModel_Call struct {
+	*mock.Call
+}
+
+// DeleteLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+func (_e *MockTrackingStore_Expecter) DeleteLoggedModel(ctx interface{}, modelID interface{}) *MockTrackingStore_DeleteLoggedModel_Call {
+	return &MockTrackingStore_DeleteLoggedModel_Call{Call: _e.mock.On("DeleteLoggedModel", ctx, modelID)}
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) Run(run func(ctx context.Context, modelID string)) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) Return(_a0 *contract.Error) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModel_Call) RunAndReturn(run func(context.Context, string) *contract.Error) *MockTrackingStore_DeleteLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLoggedModelTag provides a mock function with given fields: ctx, modelID, key
+func (_m *MockTrackingStore) DeleteLoggedModelTag(ctx context.Context, modelID string, key string) *contract.Error {
+	ret := _m.Called(ctx, modelID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLoggedModelTag")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *contract.Error); ok {
+		r0 = rf(ctx, modelID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_DeleteLoggedModelTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLoggedModelTag'
+type MockTrackingStore_DeleteLoggedModelTag_Call struct {
+	*mock.Call
+}
+
+// DeleteLoggedModelTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - key string
+func (_e *MockTrackingStore_Expecter) DeleteLoggedModelTag(ctx interface{}, modelID interface{}, key interface{}) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	return &MockTrackingStore_DeleteLoggedModelTag_Call{Call: _e.mock.On("DeleteLoggedModelTag", ctx, modelID, key)}
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) Run(run func(ctx context.Context, modelID string, key string)) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) Return(_a0 *contract.Error) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_DeleteLoggedModelTag_Call) RunAndReturn(run func(context.Context, string, string) *contract.Error) *MockTrackingStore_DeleteLoggedModelTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRun provides a mock function with given fields: ctx, runID
 func (_m *MockTrackingStore) DeleteRun(ctx context.Context, runID string) *contract.Error {
 	ret := _m.Called(ctx, runID)
@@ -519,6 +795,68 @@ func (_c *MockTrackingStore_EndTrace_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FinalizeLoggedModel provides a mock function with given fields: ctx, modelID, status
+func (_m *MockTrackingStore) FinalizeLoggedModel(ctx context.Context, modelID string, status protos.LoggedModelStatus) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, modelID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeLoggedModel")
+	}
+
+	var r0 *entities.LoggedModel
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, protos.LoggedModelStatus) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, modelID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, protos.LoggedModelStatus) *entities.LoggedModel); ok {
+		r0 = rf(ctx, modelID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, protos.LoggedModelStatus) *contract.Error); ok {
+		r1 = rf(ctx, modelID, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_FinalizeLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizeLoggedModel'
+type MockTrackingStore_FinalizeLoggedModel_Call struct {
+	*mock.Call
+}
+
+// FinalizeLoggedModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - status protos.LoggedModelStatus
+func (_e *MockTrackingStore_Expecter) FinalizeLoggedModel(ctx interface{}, modelID interface{}, status interface{}) *MockTrackingStore_FinalizeLoggedModel_Call {
+	return &MockTrackingStore_FinalizeLoggedModel_Call{Call: _e.mock.On("FinalizeLoggedModel", ctx, modelID, status)}
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) Run(run func(ctx context.Context, modelID string, status protos.LoggedModelStatus)) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(protos.LoggedModelStatus))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_FinalizeLoggedModel_Call) RunAndReturn(run func(context.Context, string, protos.LoggedModelStatus) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_FinalizeLoggedModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExperiment provides a mock function with given fields: ctx, id
 func (_m *MockTrackingStore) GetExperiment(ctx context.Context, id string) (*entities.Experiment, *contract.Error) {
 	ret := _m.Called(ctx, id)
@@ -641,29 +979,29 @@ func (_c *MockTrackingStore_GetExperimentByName_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey
-func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string, metricKey string) ([]*entities.Metric, *contract.Error) {
-	ret := _m.Called(ctx, runID, metricKey)
+// GetLoggedModel provides a mock function with given fields: ctx, modelID
+func (_m *MockTrackingStore) GetLoggedModel(ctx context.Context, modelID string) (*entities.LoggedModel, *contract.Error) {
+	ret := _m.Called(ctx, modelID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMetricHistory")
+		panic("no return value specified for GetLoggedModel")
 	}
 
-	var r0 []*entities.Metric
+	var r0 *entities.LoggedModel
 	var r1 *contract.Error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*entities.Metric, *contract.Error)); ok {
-		return rf(ctx, runID, metricKey)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.LoggedModel, *contract.Error)); ok {
+		return rf(ctx, modelID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*entities.Metric); ok {
-		r0 = rf(ctx, runID, metricKey)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.LoggedModel); ok {
+		r0 = rf(ctx, modelID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entities.Metric)
+			r0 = ret.Get(0).(*entities.LoggedModel)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) *contract.Error); ok {
-		r1 = rf(ctx, runID, metricKey)
+	if rf, ok := ret.Get(1).(func(context.Context, string) *contract.Error); ok {
+		r1 = rf(ctx, modelID)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*contract.Error)
@@ -673,39 +1011,165 @@ func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string,
 	return r0, r1
 }
 
-// MockTrackingStore_GetMetricHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistory'
-type MockTrackingStore_GetMetricHistory_Call struct {
+// MockTrackingStore_GetLoggedModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoggedModel'
+type MockTrackingStore_GetLoggedModel_Call struct {
 	*mock.Call
 }
 
-// GetMetricHistory is a helper method to define mock.On call
+// GetLoggedModel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - runID string
-//   - metricKey string
-func (_e *MockTrackingStore_Expecter) GetMetricHistory(ctx interface{}, runID interface{}, metricKey interface{}) *MockTrackingStore_GetMetricHistory_Call {
-	return &MockTrackingStore_GetMetricHistory_Call{Call: _e.mock.On("GetMetricHistory", ctx, runID, metricKey)}
+//   - modelID string
+func (_e *MockTrackingStore_Expecter) GetLoggedModel(ctx interface{}, modelID interface{}) *MockTrackingStore_GetLoggedModel_Call {
+	return &MockTrackingStore_GetLoggedModel_Call{Call: _e.mock.On("GetLoggedModel", ctx, modelID)}
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) Run(run func(ctx context.Context, runID string, metricKey string)) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetLoggedModel_Call) Run(run func(ctx context.Context, modelID string)) *MockTrackingStore_GetLoggedModel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) Return(_a0 []*entities.Metric, _a1 *contract.Error) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetLoggedModel_Call) Return(_a0 *entities.LoggedModel, _a1 *contract.Error) *MockTrackingStore_GetLoggedModel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTrackingStore_GetMetricHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]*entities.Metric, *contract.Error)) *MockTrackingStore_GetMetricHistory_Call {
+func (_c *MockTrackingStore_GetLoggedModel_Call) RunAndReturn(run func(context.Context, string) (*entities.LoggedModel, *contract.Error)) *MockTrackingStore_GetLoggedModel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRun provides a mock function with given fields: ctx, runID
-func (_m *MockTrackingStore) GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error) {
-	ret := _m.Called(ctx, runID)
+// GetMetricHistory provides a mock function with given fields: ctx, runID, metricKey
+func (_m *MockTrackingStore) GetMetricHistory(ctx context.Context, runID string, metricKey string) ([]*entities.Metric, *contract.Error) {
+	ret := _m.Called(ctx, runID, metricKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricHistory")
+	}
+
+	var r0 []*entities.Metric
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*entities.Metric, *contract.Error)); ok {
+		return rf(ctx, runID, metricKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*entities.Metric); ok {
+		r0 = rf(ctx, runID, metricKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Metric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *contract.Error); ok {
+		r1 = rf(ctx, runID, metricKey)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetMetricHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistory'
+type MockTrackingStore_GetMetricHistory_Call struct {
+	*mock.Call
+}
+
+// GetMetricHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID string
+//   - metricKey string
+func (_e *MockTrackingStore_Expecter) GetMetricHistory(ctx interface{}, runID interface{}, metricKey interface{}) *MockTrackingStore_GetMetricHistory_Call {
+	return &MockTrackingStore_GetMetricHistory_Call{Call: _e.mock.On("GetMetricHistory", ctx, runID, metricKey)}
+}
+
+func (_c *MockTrackingStore_GetMetricHistory_Call) Run(run func(ctx context.Context, runID string, metricKey string)) *MockTrackingStore_GetMetricHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistory_Call) Return(_a0 []*entities.Metric, _a1 *contract.Error) *MockTrackingStore_GetMetricHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]*entities.Metric, *contract.Error)) *MockTrackingStore_GetMetricHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetricHistoryBulkInterval provides a mock function with given fields: ctx, runIDs, metricKey, startStep, endStep, maxResults
+func (_m *MockTrackingStore) GetMetricHistoryBulkInterval(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int) ([]*entities.MetricWithRunID, *contract.Error) {
+	ret := _m.Called(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricHistoryBulkInterval")
+	}
+
+	var r0 []*entities.MetricWithRunID
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)); ok {
+		return rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *int64, *int64, int) []*entities.MetricWithRunID); ok {
+		r0 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MetricWithRunID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, *int64, *int64, int) *contract.Error); ok {
+		r1 = rf(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_GetMetricHistoryBulkInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetricHistoryBulkInterval'
+type MockTrackingStore_GetMetricHistoryBulkInterval_Call struct {
+	*mock.Call
+}
+
+// GetMetricHistoryBulkInterval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runIDs []string
+//   - metricKey string
+//   - startStep *int64
+//   - endStep *int64
+//   - maxResults int
+func (_e *MockTrackingStore_Expecter) GetMetricHistoryBulkInterval(ctx interface{}, runIDs interface{}, metricKey interface{}, startStep interface{}, endStep interface{}, maxResults interface{}) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	return &MockTrackingStore_GetMetricHistoryBulkInterval_Call{Call: _e.mock.On("GetMetricHistoryBulkInterval", ctx, runIDs, metricKey, startStep, endStep, maxResults)}
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Run(run func(ctx context.Context, runIDs []string, metricKey string, startStep *int64, endStep *int64, maxResults int)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(*int64), args[4].(*int64), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) Return(_a0 []*entities.MetricWithRunID, _a1 *contract.Error) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_GetMetricHistoryBulkInterval_Call) RunAndReturn(run func(context.Context, []string, string, *int64, *int64, int) ([]*entities.MetricWithRunID, *contract.Error)) *MockTrackingStore_GetMetricHistoryBulkInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRun provides a mock function with given fields: ctx, runID
+func (_m *MockTrackingStore) GetRun(ctx context.Context, runID string) (*entities.Run, *contract.Error) {
+	ret := _m.Called(ctx, runID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRun")
@@ -1052,6 +1516,56 @@ func (_c *MockTrackingStore_LogInputs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// LogLoggedModelParams provides a mock function with given fields: ctx, modelID, params
+func (_m *MockTrackingStore) LogLoggedModelParams(ctx context.Context, modelID string, params []*entities.LoggedModelParameter) *contract.Error {
+	ret := _m.Called(ctx, modelID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogLoggedModelParams")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*entities.LoggedModelParameter) *contract.Error); ok {
+		r0 = rf(ctx, modelID, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_LogLoggedModelParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogLoggedModelParams'
+type MockTrackingStore_LogLoggedModelParams_Call struct {
+	*mock.Call
+}
+
+// LogLoggedModelParams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - params []*entities.LoggedModelParameter
+func (_e *MockTrackingStore_Expecter) LogLoggedModelParams(ctx interface{}, modelID interface{}, params interface{}) *MockTrackingStore_LogLoggedModelParams_Call {
+	return &MockTrackingStore_LogLoggedModelParams_Call{Call: _e.mock.On("LogLoggedModelParams", ctx, modelID, params)}
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) Run(run func(ctx context.Context, modelID string, params []*entities.LoggedModelParameter)) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*entities.LoggedModelParameter))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_LogLoggedModelParams_Call) RunAndReturn(run func(context.Context, string, []*entities.LoggedModelParameter) *contract.Error) *MockTrackingStore_LogLoggedModelParams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogMetric provides a mock function with given fields: ctx, runID, metric
 func (_m *MockTrackingStore) LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error {
 	ret := _m.Called(ctx, runID, metric)
@@ -1102,6 +1616,56 @@ func (_c *MockTrackingStore_LogMetric_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// LogOutputs provides a mock function with given fields: ctx, runID, modelOutputs
+func (_m *MockTrackingStore) LogOutputs(ctx context.Context, runID string, modelOutputs []*entities.ModelOutput) *contract.Error {
+	ret := _m.Called(ctx, runID, modelOutputs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogOutputs")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*entities.ModelOutput) *contract.Error); ok {
+		r0 = rf(ctx, runID, modelOutputs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_LogOutputs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogOutputs'
+type MockTrackingStore_LogOutputs_Call struct {
+	*mock.Call
+}
+
+// LogOutputs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID string
+//   - modelOutputs []*entities.ModelOutput
+func (_e *MockTrackingStore_Expecter) LogOutputs(ctx interface{}, runID interface{}, modelOutputs interface{}) *MockTrackingStore_LogOutputs_Call {
+	return &MockTrackingStore_LogOutputs_Call{Call: _e.mock.On("LogOutputs", ctx, runID, modelOutputs)}
+}
+
+func (_c *MockTrackingStore_LogOutputs_Call) Run(run func(ctx context.Context, runID string, modelOutputs []*entities.ModelOutput)) *MockTrackingStore_LogOutputs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*entities.ModelOutput))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_LogOutputs_Call) Return(_a0 *contract.Error) *MockTrackingStore_LogOutputs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_LogOutputs_Call) RunAndReturn(run func(context.Context, string, []*entities.ModelOutput) *contract.Error) *MockTrackingStore_LogOutputs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogParam provides a mock function with given fields: ctx, runID, metric
 func (_m *MockTrackingStore) LogParam(ctx context.Context, runID string, metric *entities.Param) *contract.Error {
 	ret := _m.Called(ctx, runID, metric)
@@ -1300,6 +1864,67 @@ func (_c *MockTrackingStore_RestoreRun_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SearchDatasets provides a mock function with given fields: ctx, experimentIDs
+func (_m *MockTrackingStore) SearchDatasets(ctx context.Context, experimentIDs []string) ([]*entities.DatasetSummary, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchDatasets")
+	}
+
+	var r0 []*entities.DatasetSummary
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*entities.DatasetSummary, *contract.Error)); ok {
+		return rf(ctx, experimentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*entities.DatasetSummary); ok {
+		r0 = rf(ctx, experimentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.DatasetSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) *contract.Error); ok {
+		r1 = rf(ctx, experimentIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_SearchDatasets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchDatasets'
+type MockTrackingStore_SearchDatasets_Call struct {
+	*mock.Call
+}
+
+// SearchDatasets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+func (_e *MockTrackingStore_Expecter) SearchDatasets(ctx interface{}, experimentIDs interface{}) *MockTrackingStore_SearchDatasets_Call {
+	return &MockTrackingStore_SearchDatasets_Call{Call: _e.mock.On("SearchDatasets", ctx, experimentIDs)}
+}
+
+func (_c *MockTrackingStore_SearchDatasets_Call) Run(run func(ctx context.Context, experimentIDs []string)) *MockTrackingStore_SearchDatasets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchDatasets_Call) Return(_a0 []*entities.DatasetSummary, _a1 *contract.Error) *MockTrackingStore_SearchDatasets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchDatasets_Call) RunAndReturn(run func(context.Context, []string) ([]*entities.DatasetSummary, *contract.Error)) *MockTrackingStore_SearchDatasets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchExperiments provides a mock function with given fields: ctx, experimentViewType, maxResults, filter, orderBy, pageToken
 func (_m *MockTrackingStore) SearchExperiments(ctx context.Context, experimentViewType protos.ViewType, maxResults int64, filter string, orderBy []string, pageToken string) ([]*entities.Experiment, string, *contract.Error) {
 	ret := _m.Called(ctx, experimentViewType, maxResults, filter, orderBy, pageToken)
@@ -1372,6 +1997,79 @@ func (_c *MockTrackingStore_SearchExperiments_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SearchLoggedModels provides a mock function with given fields: ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchLoggedModels(ctx context.Context, experimentIDs []string, filter string, datasets []*entities.LoggedModelDataset, maxResults int64, orderBy []*entities.LoggedModelOrderBy, pageToken string) ([]*entities.LoggedModel, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLoggedModels")
+	}
+
+	var r0 []*entities.LoggedModel
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int64, []*entities.LoggedModelOrderBy, string) ([]*entities.LoggedModel, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int64, []*entities.LoggedModelOrderBy, string) []*entities.LoggedModel); ok {
+		r0 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LoggedModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int64, []*entities.LoggedModelOrderBy, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, []*entities.LoggedModelDataset, int64, []*entities.LoggedModelOrderBy, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchLoggedModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLoggedModels'
+type MockTrackingStore_SearchLoggedModels_Call struct {
+	*mock.Call
+}
+
+// SearchLoggedModels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - datasets []*entities.LoggedModelDataset
+//   - maxResults int64
+//   - orderBy []*entities.LoggedModelOrderBy
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchLoggedModels(ctx interface{}, experimentIDs interface{}, filter interface{}, datasets interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchLoggedModels_Call {
+	return &MockTrackingStore_SearchLoggedModels_Call{Call: _e.mock.On("SearchLoggedModels", ctx, experimentIDs, filter, datasets, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, datasets []*entities.LoggedModelDataset, maxResults int64, orderBy []*entities.LoggedModelOrderBy, pageToken string)) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].([]*entities.LoggedModelDataset), args[4].(int64), args[5].([]*entities.LoggedModelOrderBy), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) Return(_a0 []*entities.LoggedModel, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchLoggedModels_Call) RunAndReturn(run func(context.Context, []string, string, []*entities.LoggedModelDataset, int64, []*entities.LoggedModelOrderBy, string) ([]*entities.LoggedModel, string, *contract.Error)) *MockTrackingStore_SearchLoggedModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchRuns provides a mock function with given fields: ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken
 func (_m *MockTrackingStore) SearchRuns(ctx context.Context, experimentIDs []string, filter string, runViewType protos.ViewType, maxResults int, orderBy []string, pageToken string) ([]*entities.Run, string, *contract.Error) {
 	ret := _m.Called(ctx, experimentIDs, filter, runViewType, maxResults, orderBy, pageToken)
@@ -1445,6 +2143,78 @@ func (_c *MockTrackingStore_SearchRuns_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// SearchTraces provides a mock function with given fields: ctx, experimentIDs, filter, maxResults, orderBy, pageToken
+func (_m *MockTrackingStore) SearchTraces(ctx context.Context, experimentIDs []string, filter string, maxResults int64, orderBy []string, pageToken string) ([]*entities.TraceInfo, string, *contract.Error) {
+	ret := _m.Called(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTraces")
+	}
+
+	var r0 []*entities.TraceInfo
+	var r1 string
+	var r2 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int64, []string, string) ([]*entities.TraceInfo, string, *contract.Error)); ok {
+		return rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int64, []string, string) []*entities.TraceInfo); ok {
+		r0 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.TraceInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, int64, []string, string) string); ok {
+		r1 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, string, int64, []string, string) *contract.Error); ok {
+		r2 = rf(ctx, experimentIDs, filter, maxResults, orderBy, pageToken)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*contract.Error)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// MockTrackingStore_SearchTraces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTraces'
+type MockTrackingStore_SearchTraces_Call struct {
+	*mock.Call
+}
+
+// SearchTraces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experimentIDs []string
+//   - filter string
+//   - maxResults int64
+//   - orderBy []string
+//   - pageToken string
+func (_e *MockTrackingStore_Expecter) SearchTraces(ctx interface{}, experimentIDs interface{}, filter interface{}, maxResults interface{}, orderBy interface{}, pageToken interface{}) *MockTrackingStore_SearchTraces_Call {
+	return &MockTrackingStore_SearchTraces_Call{Call: _e.mock.On("SearchTraces", ctx, experimentIDs, filter, maxResults, orderBy, pageToken)}
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Run(run func(ctx context.Context, experimentIDs []string, filter string, maxResults int64, orderBy []string, pageToken string)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(int64), args[4].([]string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) Return(_a0 []*entities.TraceInfo, _a1 string, _a2 *contract.Error) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTrackingStore_SearchTraces_Call) RunAndReturn(run func(context.Context, []string, string, int64, []string, string) ([]*entities.TraceInfo, string, *contract.Error)) *MockTrackingStore_SearchTraces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetExperimentTag provides a mock function with given fields: ctx, experimentID, key, value
 func (_m *MockTrackingStore) SetExperimentTag(ctx context.Context, experimentID string, key string, value string) *contract.Error {
 	ret := _m.Called(ctx, experimentID, key, value)
@@ -1496,6 +2266,56 @@ func (_c *MockTrackingStore_SetExperimentTag_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// SetLoggedModelTags provides a mock function with given fields: ctx, modelID, tags
+func (_m *MockTrackingStore) SetLoggedModelTags(ctx context.Context, modelID string, tags []*entities.LoggedModelTag) *contract.Error {
+	ret := _m.Called(ctx, modelID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoggedModelTags")
+	}
+
+	var r0 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*entities.LoggedModelTag) *contract.Error); ok {
+		r0 = rf(ctx, modelID, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contract.Error)
+		}
+	}
+
+	return r0
+}
+
+// MockTrackingStore_SetLoggedModelTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLoggedModelTags'
+type MockTrackingStore_SetLoggedModelTags_Call struct {
+	*mock.Call
+}
+
+// SetLoggedModelTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - modelID string
+//   - tags []*entities.LoggedModelTag
+func (_e *MockTrackingStore_Expecter) SetLoggedModelTags(ctx interface{}, modelID interface{}, tags interface{}) *MockTrackingStore_SetLoggedModelTags_Call {
+	return &MockTrackingStore_SetLoggedModelTags_Call{Call: _e.mock.On("SetLoggedModelTags", ctx, modelID, tags)}
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) Run(run func(ctx context.Context, modelID string, tags []*entities.LoggedModelTag)) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*entities.LoggedModelTag))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) Return(_a0 *contract.Error) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTrackingStore_SetLoggedModelTags_Call) RunAndReturn(run func(context.Context, string, []*entities.LoggedModelTag) *contract.Error) *MockTrackingStore_SetLoggedModelTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetTag provides a mock function with given fields: ctx, runID, key, value
 func (_m *MockTrackingStore) SetTag(ctx context.Context, runID string, key string, value string) *contract.Error {
 	ret := _m.Called(ctx, runID, key, value)
@@ -1658,6 +2478,69 @@ func (_c *MockTrackingStore_SetTraceTag_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UpdateAssessment provides a mock function with given fields: ctx, traceID, assessmentID, update
+func (_m *MockTrackingStore) UpdateAssessment(ctx context.Context, traceID string, assessmentID string, update *entities.AssessmentUpdate) (*entities.Assessment, *contract.Error) {
+	ret := _m.Called(ctx, traceID, assessmentID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssessment")
+	}
+
+	var r0 *entities.Assessment
+	var r1 *contract.Error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *entities.AssessmentUpdate) (*entities.Assessment, *contract.Error)); ok {
+		return rf(ctx, traceID, assessmentID, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *entities.AssessmentUpdate) *entities.Assessment); ok {
+		r0 = rf(ctx, traceID, assessmentID, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Assessment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *entities.AssessmentUpdate) *contract.Error); ok {
+		r1 = rf(ctx, traceID, assessmentID, update)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*contract.Error)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTrackingStore_UpdateAssessment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAssessment'
+type MockTrackingStore_UpdateAssessment_Call struct {
+	*mock.Call
+}
+
+// UpdateAssessment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - traceID string
+//   - assessmentID string
+//   - update *entities.AssessmentUpdate
+func (_e *MockTrackingStore_Expecter) UpdateAssessment(ctx interface{}, traceID interface{}, assessmentID interface{}, update interface{}) *MockTrackingStore_UpdateAssessment_Call {
+	return &MockTrackingStore_UpdateAssessment_Call{Call: _e.mock.On("UpdateAssessment", ctx, traceID, assessmentID, update)}
+}
+
+func (_c *MockTrackingStore_UpdateAssessment_Call) Run(run func(ctx context.Context, traceID string, assessmentID string, update *entities.AssessmentUpdate)) *MockTrackingStore_UpdateAssessment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*entities.AssessmentUpdate))
+	})
+	return _c
+}
+
+func (_c *MockTrackingStore_UpdateAssessment_Call) Return(_a0 *entities.Assessment, _a1 *contract.Error) *MockTrackingStore_UpdateAssessment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrackingStore_UpdateAssessment_Call) RunAndReturn(run func(context.Context, string, string, *entities.AssessmentUpdate) (*entities.Assessment, *contract.Error)) *MockTrackingStore_UpdateAssessment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRun provides a mock function with given fields: ctx, runID, runStatus, endTime, runName
 func (_m *MockTrackingStore) UpdateRun(ctx context.Context, runID string, runStatus string, endTime *int64, runName string) *contract.Error {
 	ret := _m.Called(ctx, runID, runStatus, endTime, runName)
@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/file"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+// NewTrackingStore returns the file store for file:// URIs and local paths, the SQL store otherwise.
+//
+//nolint:ireturn
+func NewTrackingStore(ctx context.Context, config *config.Config) (TrackingStore, error) {
+	if _, ok := utils.LocalPathFromURI(config.TrackingStoreURI); ok {
+		store, err := file.NewTrackingFileStore(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new file store: %w", err)
+		}
+
+		return store, nil
+	}
+
+	store, err := sql.NewTrackingSQLStore(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new sql store: %w", err)
+	}
+
+	return store, nil
+}
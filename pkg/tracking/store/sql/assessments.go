@@ -0,0 +1,263 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+func checkTraceExists(transaction *gorm.DB, requestID string) *contract.Error {
+	var count int64
+	if err := transaction.Model(
+		&models.TraceInfo{},
+	).Where(
+		"request_id = ?", requestID,
+	).Count(&count).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to get trace with request_id '%s'", requestID),
+			err,
+		)
+	}
+
+	if count == 0 {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("Trace with request_id '%s' not found.", requestID),
+		)
+	}
+
+	return nil
+}
+
+// Returns the valid assessments of a trace, in creation order.
+func getTraceAssessments(transaction *gorm.DB, requestID string) ([]*entities.Assessment, *contract.Error) {
+	var assessments []models.Assessment
+	if err := transaction.Where(
+		"trace_id = ?", requestID,
+	).Where(
+		"valid = ?", true,
+	).Order(
+		"created_timestamp",
+	).Order(
+		"assessment_id",
+	).Find(&assessments).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to get assessments of trace '%s'", requestID),
+			err,
+		)
+	}
+
+	data := make([]*entities.Assessment, 0, len(assessments))
+
+	for _, assessment := range assessments {
+		entity, err := assessment.ToEntity()
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				fmt.Sprintf("failed to decode assessment '%s'", assessment.ID),
+				err,
+			)
+		}
+
+		data = append(data, entity)
+	}
+
+	return data, nil
+}
+
+func (s TrackingSQLStore) CreateAssessment(
+	ctx context.Context, assessment *entities.Assessment,
+) (*entities.Assessment, *contract.Error) {
+	if err := checkTraceExists(s.db.WithContext(ctx), assessment.TraceID); err != nil {
+		return nil, err
+	}
+
+	assessment.AssessmentID = "a-" + newGUID()
+
+	if assessment.CreateTimeMS == 0 {
+		assessment.CreateTimeMS = time.Now().UnixMilli()
+	}
+
+	if assessment.LastUpdateTimeMS == 0 {
+		assessment.LastUpdateTimeMS = assessment.CreateTimeMS
+	}
+
+	model, err := models.NewAssessmentFromEntity(assessment)
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			"failed to encode assessment value",
+			err,
+		)
+	}
+
+	if err := s.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create assessment for trace '%s'", assessment.TraceID),
+			err,
+		)
+	}
+
+	return assessment, nil
+}
+
+func getValidAssessment(transaction *gorm.DB, traceID, assessmentID string) (*models.Assessment, *contract.Error) {
+	var assessment models.Assessment
+	if err := transaction.Where(
+		"trace_id = ?", traceID,
+	).Where(
+		"assessment_id = ?", assessmentID,
+	).Where(
+		"valid = ?", true,
+	).First(&assessment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, contract.NewError(
+				protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+				fmt.Sprintf("Assessment with ID '%s' not found for trace '%s'.", assessmentID, traceID),
+			)
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to get assessment '%s'", assessmentID),
+			err,
+		)
+	}
+
+	return &assessment, nil
+}
+
+// UpdateAssessment updates an assessment in place. When its value changes, the previous
+// version is kept as an invalid assessment which the updated one overrides, so the
+// history of an assessment can be followed through the overrides column.
+//
+//nolint:funlen
+func (s TrackingSQLStore) UpdateAssessment(
+	ctx context.Context, traceID, assessmentID string, update *entities.AssessmentUpdate,
+) (*entities.Assessment, *contract.Error) {
+	var updated *models.Assessment
+
+	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		assessment, contractError := getValidAssessment(transaction, traceID, assessmentID)
+		if contractError != nil {
+			return contractError
+		}
+
+		if update.Type != nil && *update.Type != assessment.AssessmentType {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf(
+					"Cannot update the %s value of the %s assessment '%s'.",
+					*update.Type, assessment.AssessmentType, assessmentID,
+				),
+			)
+		}
+
+		previous := *assessment
+
+		valueChanged, err := assessment.ApplyUpdate(update, time.Now().UnixMilli())
+		if err != nil {
+			return contract.NewErrorWith(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				"failed to encode assessment value",
+				err,
+			)
+		}
+
+		if valueChanged {
+			previous.ID = "a-" + newGUID()
+			previous.Valid = false
+
+			if err := transaction.Create(&previous).Error; err != nil {
+				return err
+			}
+
+			assessment.Overrides.String, assessment.Overrides.Valid = previous.ID, true
+		}
+
+		if err := transaction.Save(assessment).Error; err != nil {
+			return err
+		}
+
+		updated = assessment
+
+		return nil
+	})
+	if err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return nil, contractError
+		}
+
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to update assessment '%s'", assessmentID),
+			err,
+		)
+	}
+
+	entity, err := updated.ToEntity()
+	if err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to decode assessment '%s'", assessmentID),
+			err,
+		)
+	}
+
+	return entity, nil
+}
+
+// DeleteAssessment deletes an assessment along with the previous versions it overrides.
+// Deleting an assessment that doesn't exist is not an error.
+func (s TrackingSQLStore) DeleteAssessment(ctx context.Context, traceID, assessmentID string) *contract.Error {
+	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		assessmentIDs := []string{}
+
+		for nextID := assessmentID; nextID != ""; {
+			var assessment models.Assessment
+			if err := transaction.Select(
+				"assessment_id", "overrides",
+			).Where(
+				"trace_id = ?", traceID,
+			).Where(
+				"assessment_id = ?", nextID,
+			).Limit(1).Find(&assessment).Error; err != nil {
+				return err
+			}
+
+			if assessment.ID == "" {
+				break
+			}
+
+			assessmentIDs = append(assessmentIDs, assessment.ID)
+			nextID = assessment.Overrides.String
+		}
+
+		if len(assessmentIDs) == 0 {
+			return nil
+		}
+
+		return transaction.Where("assessment_id IN (?)", assessmentIDs).Delete(&models.Assessment{}).Error
+	})
+	if err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete assessment '%s'", assessmentID),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -47,6 +48,81 @@ func checkExperimentIsActive(experiment *entities.Experiment) *contract.Error {
 	return nil
 }
 
+const (
+	defaultExperimentID   = 0
+	defaultExperimentName = "Default"
+)
+
+// ensureDefaultExperiment creates the default experiment in a database without experiments, like the Python store.
+// A database without schema is left to the Python store, which creates the default experiment with it.
+func ensureDefaultExperiment(ctx context.Context, database *gorm.DB, defaultArtifactRoot string) error {
+	if !database.WithContext(ctx).Migrator().HasTable(&models.Experiment{}) {
+		return nil
+	}
+
+	var count int64
+	if err := database.WithContext(ctx).Model(&models.Experiment{}).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to count experiments: %w", err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	artifactLocation, err := utils.AppendToURIPath(defaultArtifactRoot, strconv.Itoa(defaultExperimentID))
+	if err != nil {
+		return fmt.Errorf("failed to join artifact location: %w", err)
+	}
+
+	// A map is used as the zero experiment_id would otherwise be left to the auto increment,
+	// conflicts are ignored as the Python store might have created the experiment meanwhile.
+	now := time.Now().UnixMilli()
+	experiment := map[string]any{
+		"experiment_id":     defaultExperimentID,
+		"name":              defaultExperimentName,
+		"artifact_location": artifactLocation,
+		"lifecycle_stage":   models.LifecycleStageActive,
+		"creation_time":     now,
+		"last_update_time":  now,
+	}
+
+	if err := database.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		// Like the Python store, allow the insertion of an explicit value in the auto increment column.
+		switch transaction.Dialector.Name() {
+		case "mysql":
+			// The transaction holds a pooled connection, its mode is restored before the connection is released.
+			var sqlMode string
+			if err := transaction.Raw("SELECT @@SESSION.sql_mode").Scan(&sqlMode).Error; err != nil {
+				return err
+			}
+
+			if err := transaction.Exec(
+				"SET SESSION sql_mode = ?", strings.TrimPrefix(sqlMode+",NO_AUTO_VALUE_ON_ZERO", ","),
+			).Error; err != nil {
+				return err
+			}
+
+			defer transaction.Exec("SET SESSION sql_mode = ?", sqlMode)
+		case "sqlserver":
+			if err := transaction.Exec("SET IDENTITY_INSERT experiments ON").Error; err != nil {
+				return err
+			}
+
+			defer transaction.Exec("SET IDENTITY_INSERT experiments OFF")
+		}
+
+		return transaction.Model(
+			&models.Experiment{},
+		).Clauses(
+			clause.OnConflict{DoNothing: true},
+		).Create(experiment).Error
+	}); err != nil {
+		return fmt.Errorf("failed to create default experiment: %w", err)
+	}
+
+	return nil
+}
+
 func (s TrackingSQLStore) GetExperiment(ctx context.Context, id string) (*entities.Experiment, *contract.Error) {
 	experimentID, err := convertExperimentIDToInt(id)
 	if err != nil {
@@ -282,10 +358,10 @@ func (s TrackingSQLStore) SearchExperiments(
 	query := applyExperimentsLifecycleStagesFilter(s.db.WithContext(ctx), experimentViewType)
 
 	// apply Limit
-	query, limit := applyExperimentsLimitFilter(query, maxResults)
+	query, limit := applyLimitFilter(query, maxResults)
 
 	// apply Offset
-	query, offset, err := applyExperimentsOffsetFilter(query, pageToken)
+	query, offset, err := applyOffsetFilter(query, pageToken)
 	if err != nil {
 		return nil, "", err
 	}
@@ -1,11 +1,8 @@
 package sql
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"gorm.io/gorm"
@@ -13,7 +10,10 @@ import (
 
 	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 // supported expression list.
@@ -28,44 +28,7 @@ const (
 	GreaterOrEqualExpression = ">="
 )
 
-//nolint:lll
-var (
-	filterAnd       = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond      = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
-)
-
-// PageToken.
-type PageToken struct {
-	Offset int32 `json:"offset"`
-}
-
-func applyExperimentsLimitFilter(query *gorm.DB, maxResults int64) (*gorm.DB, int) {
-	return query.Limit(int(maxResults) + 1), int(maxResults)
-}
-
-func applyExperimentsOffsetFilter(query *gorm.DB, pageToken string) (*gorm.DB, int, *contract.Error) {
-	var offset int
-
-	if pageToken != "" {
-		var token PageToken
-		if err := json.NewDecoder(
-			base64.NewDecoder(
-				base64.StdEncoding,
-				strings.NewReader(pageToken),
-			),
-		).Decode(&token); err != nil {
-			return nil, 0, contract.NewError(
-				protos.ErrorCode_INVALID_PARAMETER_VALUE,
-				fmt.Sprintf("invalid page_token '%s': %s", pageToken, err),
-			)
-		}
-
-		offset = int(token.Offset)
-	}
-
-	return query.Offset(offset), offset, nil
-}
+var experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
 
 func applyExperimentsLifecycleStagesFilter(query *gorm.DB, runViewType protos.ViewType) *gorm.DB {
 	switch runViewType {
@@ -139,141 +102,120 @@ func applyExperimentsOrderBy(query *gorm.DB, orderBy []string) (*gorm.DB, *contr
 	return query, nil
 }
 
-//nolint:funlen,gocognit,nestif,cyclop,goconst,mnd,forcetypeassert
-func applyExperimentsFilter(database, query *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
-	if filter != "" {
-		for index, f := range filterAnd.Split(filter, -1) {
-			parts := filterCond.FindStringSubmatch(f)
-			if len(parts) != 5 {
-				return nil, contract.NewError(
-					protos.ErrorCode_INVALID_PARAMETER_VALUE,
-					fmt.Sprintf("malformed filter '%s'", f),
-				)
-			}
+// Returns the comparison of the column with the value of the condition.
+// SQLite has no ILIKE, both sides are lowercased and compared with LIKE instead.
+func experimentsComparison(database *gorm.DB, column string, condition *parser.ValidCompareExpr) clause.Expr {
+	comparison, value := condition.Operator.String(), condition.Value
 
-			var value any = parts[4]
-
-			entity, key, comparison := parts[1], strings.Trim(parts[2], "\"`"), parts[3]
-
-			switch entity {
-			case "", "attribute", "attributes", "attr":
-				switch key {
-				case "creation_time", "last_update_time":
-					switch comparison {
-					case GreaterExpression, GreaterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						intValue, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, contract.NewError(
-								protos.ErrorCode_INVALID_PARAMETER_VALUE,
-								fmt.Sprintf("invalid numeric value '%s'", value),
-							)
-						}
-
-						value = intValue
-					default:
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf(
-								"invalid numeric attribute comparison operator '%s'", comparison,
-							),
-						)
-					}
-				case "name":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, contract.NewError(
-								protos.ErrorCode_INVALID_PARAMETER_VALUE,
-								fmt.Sprintf("invalid string value '%s'", value),
-							)
-						}
-
-						value = strings.Trim(value.(string), `"'`)
-
-						if database.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-							key = fmt.Sprintf("LOWER(%s)", key)
-							comparison = LikeExpression
-							value = strings.ToLower(value.(string))
-						}
-					default:
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf(
-								"invalid string attribute comparison operator '%s'", comparison,
-							),
-						)
-					}
-				default:
-					return nil, contract.NewError(
-						protos.ErrorCode_INVALID_PARAMETER_VALUE,
-						fmt.Sprintf(
-							"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
-						),
-					)
-				}
-
-				query.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, contract.NewError(
-							protos.ErrorCode_INVALID_PARAMETER_VALUE,
-							fmt.Sprintf("invalid string value '%s'", value),
-						)
-					}
-
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, contract.NewError(
-						protos.ErrorCode_INVALID_PARAMETER_VALUE,
-						fmt.Sprintf("invalid tag comparison operator '%s'", comparison),
-					)
-				}
-
-				table := fmt.Sprintf("filter_%d", index)
-				where := fmt.Sprintf("value %s ?", comparison)
-
-				if database.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-
-				query.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
-					database.Select(
-						"experiment_id", "value",
-					).Where("key = ?", key).Where(where, value).Model(&models.ExperimentTag{}),
-				)
-			default:
-				return nil, contract.NewError(
-					protos.ErrorCode_INVALID_PARAMETER_VALUE,
-					fmt.Sprintf("invalid entity type '%s'. Valid values are ['tag', 'tags', 'attribute']", entity),
-				)
+	if database.Dialector.Name() == "sqlite" && condition.Operator == parser.ILike {
+		column, comparison = "LOWER("+column+")", LikeExpression
+
+		if str, ok := value.(string); ok {
+			value = strings.ToLower(str)
+		}
+	}
+
+	return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, comparison), Vars: []any{value}}
+}
+
+// Returns the subquery selecting the experiments matching a tag condition.
+func experimentTagsSubquery(database *gorm.DB, condition *parser.ValidCompareExpr) *gorm.DB {
+	subquery := database.Select("experiment_id").Where("key = ?", condition.Key).Model(&models.ExperimentTag{})
+
+	if condition.Operator != parser.IsNull && condition.Operator != parser.IsNotNull {
+		subquery = subquery.Where(experimentsComparison(database, "value", condition))
+	}
+
+	return subquery
+}
+
+// Returns the condition matching the experiments of a filter containing OR operators.
+//
+//nolint:ireturn
+func experimentsFilterCondition(database *gorm.DB, expr parser.ValidExpr) clause.Expression {
+	switch expr := expr.(type) {
+	case *parser.ValidBooleanExpr:
+		conditions := make([]clause.Expression, 0, len(expr.Exprs))
+		for _, e := range expr.Exprs {
+			conditions = append(conditions, experimentsFilterCondition(database, e))
+		}
+
+		if expr.Operator == parser.Or {
+			return clause.Or(conditions...)
+		}
+
+		return clause.And(conditions...)
+	case *parser.ValidCompareExpr:
+		if expr.Identifier == parser.Attribute {
+			return experimentsComparison(database, "experiments."+expr.Key, expr)
+		}
+
+		if expr.Operator == parser.IsNull {
+			return clause.Expr{
+				SQL: "experiments.experiment_id NOT IN (?)", Vars: []any{experimentTagsSubquery(database, expr)},
 			}
 		}
+
+		return clause.Expr{
+			SQL: "experiments.experiment_id IN (?)", Vars: []any{experimentTagsSubquery(database, expr)},
+		}
+	default:
+		return clause.Expr{}
 	}
+}
 
-	return query, nil
+func applyExperimentsFilter(database, transaction *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
+	filterExpression, err := query.ParseExperimentFilter(filter)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", err),
+		)
+	}
+
+	if filterExpression == nil {
+		return transaction, nil
+	}
+
+	for index, conjunct := range filterConjuncts(filterExpression) {
+		condition, ok := conjunct.(*parser.ValidCompareExpr)
+		if !ok {
+			transaction.Where(experimentsFilterCondition(database, conjunct))
+
+			continue
+		}
+
+		if condition.Identifier == parser.Attribute {
+			transaction.Where(experimentsComparison(database, "experiments."+condition.Key, condition))
+
+			continue
+		}
+
+		table := fmt.Sprintf("filter_%d", index)
+
+		// Experiments missing the tag are found with an anti-join.
+		if condition.Operator == parser.IsNull {
+			transaction.Joins(
+				fmt.Sprintf("LEFT JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
+				experimentTagsSubquery(database, condition),
+			).Where(table + ".experiment_id IS NULL")
+
+			continue
+		}
+
+		transaction.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
+			experimentTagsSubquery(database, condition),
+		)
+	}
+
+	return transaction, nil
 }
 
-//nolint:gosec // disable G115
 func createExperimentsNextPageToken(experiments []models.Experiment, limit, offset int) (string, *contract.Error) {
-	var token strings.Builder
 	if len(experiments) > limit {
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(PageToken{
-			Offset: int32(offset + limit),
-		}); err != nil {
-			return "", contract.NewErrorWith(
-				protos.ErrorCode_INTERNAL_ERROR,
-				"error encoding 'nextPageToken' value",
-				err,
-			)
-		}
+		return utils.EncodePageToken(offset + limit)
 	}
 
-	return token.String(), nil
+	return "", nil
 }
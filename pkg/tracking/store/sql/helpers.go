@@ -2,8 +2,13 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 
+	"gorm.io/gorm"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
 	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
@@ -17,6 +22,20 @@ const (
 	MlflowArtifactLocation = "mlflow.artifactLocation"
 )
 
+// The limit fetches one more row than requested, which tells whether there is a next page.
+func applyLimitFilter(query *gorm.DB, maxResults int64) (*gorm.DB, int) {
+	return query.Limit(int(maxResults) + 1), int(maxResults)
+}
+
+func applyOffsetFilter(query *gorm.DB, pageToken string) (*gorm.DB, int, *contract.Error) {
+	offset, err := utils.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return query.Offset(offset), offset, nil
+}
+
 func GetTraceArtifactLocationTag(
 	experiment *entities.Experiment, requestID string,
 ) (models.TraceTag, error) {
@@ -36,3 +55,21 @@ func GetTraceArtifactLocationTag(
 		RequestID: requestID,
 	}, nil
 }
+
+// FilterComparison returns the SQL comparison of a filter condition, along with the wrapper of the compared column
+// and the value to compare it with. Only PostgreSQL knows ILIKE, the other dialects compare the lowered column
+// and value with LIKE.
+func FilterComparison(
+	database *gorm.DB, condition *parser.ValidCompareExpr,
+) (string, func(column string) string, any) {
+	if database.Dialector.Name() == "postgres" || condition.Operator != parser.ILike {
+		return condition.Operator.String(), func(column string) string { return column }, condition.Value
+	}
+
+	value := condition.Value
+	if str, ok := value.(string); ok {
+		value = strings.ToLower(str)
+	}
+
+	return LikeExpression, func(column string) string { return "LOWER(" + column + ")" }, value
+}
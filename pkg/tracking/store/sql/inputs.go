@@ -13,6 +13,7 @@ import (
 	"github.com/mlflow/mlflow-go-backend/pkg/entities"
 	"github.com/mlflow/mlflow-go-backend/pkg/protos"
 	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
 )
 
 type digestKey struct {
@@ -255,3 +256,57 @@ func (s TrackingSQLStore) LogInputs(
 
 	return nil
 }
+
+// Same limit as the Python store, the UI only needs the datasets to populate its filters.
+const maxDatasetSummariesResults = 1000
+
+// SearchDatasets returns the distinct datasets logged in the experiments along with the
+// context they were logged with. Datasets logged without a context are returned too.
+func (s TrackingSQLStore) SearchDatasets(
+	ctx context.Context, experimentIDs []string,
+) ([]*entities.DatasetSummary, *contract.Error) {
+	ids := make([]int32, 0, len(experimentIDs))
+
+	for _, experimentID := range experimentIDs {
+		id, err := convertExperimentIDToInt(experimentID)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return []*entities.DatasetSummary{}, nil
+	}
+
+	var summaries []*entities.DatasetSummary
+	if err := s.db.WithContext(ctx).Model(
+		&models.Dataset{},
+	).Distinct(
+		"datasets.experiment_id", "datasets.name", "datasets.digest", "input_tags.value AS context",
+	).Joins(
+		"JOIN inputs ON inputs.source_id = datasets.dataset_uuid",
+	).Joins(
+		"LEFT JOIN input_tags ON input_tags.input_uuid = inputs.input_uuid AND input_tags.name = ?",
+		utils.TagDatasetContext,
+	).Where(
+		"datasets.experiment_id IN (?)", ids,
+	).Order(
+		"datasets.experiment_id",
+	).Order(
+		"datasets.name",
+	).Order(
+		"datasets.digest",
+	).Limit(
+		maxDatasetSummariesResults,
+	).Scan(&summaries).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to search datasets",
+			err,
+		)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,361 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const LoggedModelsFolderName = "models"
+
+func loggedModelNotFoundError(modelID string) *contract.Error {
+	return contract.NewError(
+		protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+		fmt.Sprintf("Logged model with ID '%s' not found.", modelID),
+	)
+}
+
+func newLoggedModelID() string {
+	return "m-" + strings.ReplaceAll(utils.NewUUID(), "-", "")
+}
+
+//nolint:funlen
+func (s TrackingSQLStore) CreateLoggedModel(
+	ctx context.Context,
+	experimentID, name, modelType, sourceRunID string,
+	params []*entities.LoggedModelParameter,
+	tags []*entities.LoggedModelTag,
+) (*entities.LoggedModel, *contract.Error) {
+	experiment, err := s.GetExperiment(ctx, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExperimentIsActive(experiment); err != nil {
+		return nil, err
+	}
+
+	experimentIDInt, err := convertExperimentIDToInt(experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		randomName, err := utils.GenerateRandomName()
+		if err != nil {
+			return nil, contract.NewErrorWith(
+				protos.ErrorCode_INTERNAL_ERROR,
+				"failed to generate random logged model name",
+				err,
+			)
+		}
+
+		name = randomName
+	}
+
+	modelID := newLoggedModelID()
+
+	artifactLocation, appendErr := utils.AppendToURIPath(
+		experiment.ArtifactLocation,
+		LoggedModelsFolderName,
+		modelID,
+		ArtifactFolderName,
+	)
+	if appendErr != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"failed to append logged model ID to experiment artifact location",
+			appendErr,
+		)
+	}
+
+	creationTime := time.Now().UnixMilli()
+	loggedModel := models.LoggedModel{
+		ID:                     modelID,
+		ExperimentID:           experimentIDInt,
+		Name:                   name,
+		ArtifactLocation:       artifactLocation,
+		CreationTimestampMS:    creationTime,
+		LastUpdatedTimestampMS: creationTime,
+		Status:                 int32(protos.LoggedModelStatus_LOGGED_MODEL_PENDING),
+		LifecycleStage:         models.LifecycleStageActive,
+		ModelType:              sql.NullString{String: modelType, Valid: modelType != ""},
+		SourceRunID:            sql.NullString{String: sourceRunID, Valid: sourceRunID != ""},
+		Tags:                   make([]models.LoggedModelTag, 0, len(tags)),
+		Params:                 make([]models.LoggedModelParam, 0, len(params)),
+	}
+
+	for _, tag := range tags {
+		loggedModel.Tags = append(loggedModel.Tags, models.NewLoggedModelTagFromEntity(modelID, experimentIDInt, tag))
+	}
+
+	for _, param := range params {
+		loggedModel.Params = append(
+			loggedModel.Params, models.NewLoggedModelParamFromEntity(modelID, experimentIDInt, param),
+		)
+	}
+
+	if err := s.db.WithContext(ctx).Create(&loggedModel).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to create logged model for experiment_id %q", experimentID),
+			err,
+		)
+	}
+
+	return loggedModel.ToEntity(), nil
+}
+
+// Returns the active logged model, deleted logged models are not found.
+func (s TrackingSQLStore) getLoggedModel(ctx context.Context, modelID string) (*models.LoggedModel, *contract.Error) {
+	var loggedModel models.LoggedModel
+	if err := s.db.WithContext(ctx).Where(
+		"model_id = ?", modelID,
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageActive,
+	).Preload(
+		"Tags",
+	).Preload(
+		"Params",
+	).Preload(
+		"Metrics",
+	).First(&loggedModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, loggedModelNotFoundError(modelID)
+		}
+
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get logged model", err)
+	}
+
+	return &loggedModel, nil
+}
+
+func (s TrackingSQLStore) GetLoggedModel(ctx context.Context, modelID string) (*entities.LoggedModel, *contract.Error) {
+	loggedModel, err := s.getLoggedModel(ctx, modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loggedModel.ToEntity(), nil
+}
+
+func (s TrackingSQLStore) FinalizeLoggedModel(
+	ctx context.Context, modelID string, status protos.LoggedModelStatus,
+) (*entities.LoggedModel, *contract.Error) {
+	if err := s.updateLoggedModel(ctx, modelID, map[string]any{"status": int32(status)}); err != nil {
+		return nil, err
+	}
+
+	return s.GetLoggedModel(ctx, modelID)
+}
+
+func (s TrackingSQLStore) DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error {
+	return s.updateLoggedModel(ctx, modelID, map[string]any{"lifecycle_stage": models.LifecycleStageDeleted})
+}
+
+// Update the columns of an active logged model and bump its last update time.
+func (s TrackingSQLStore) updateLoggedModel(ctx context.Context, modelID string, columns map[string]any) *contract.Error {
+	columns["last_updated_timestamp_ms"] = time.Now().UnixMilli()
+
+	result := s.db.WithContext(ctx).Model(
+		&models.LoggedModel{},
+	).Where(
+		"model_id = ?", modelID,
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageActive,
+	).UpdateColumns(columns)
+	if result.Error != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to update logged model with ID '%s'", modelID),
+			result.Error,
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		return loggedModelNotFoundError(modelID)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) SetLoggedModelTags(
+	ctx context.Context, modelID string, tags []*entities.LoggedModelTag,
+) *contract.Error {
+	loggedModel, err := s.getLoggedModel(ctx, modelID)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// The last value wins when a key is given more than once.
+	tagsByKey := make(map[string]models.LoggedModelTag, len(tags))
+	for _, tag := range tags {
+		tagsByKey[tag.Key] = models.NewLoggedModelTagFromEntity(modelID, loggedModel.ExperimentID, tag)
+	}
+
+	loggedModelTags := make([]models.LoggedModelTag, 0, len(tagsByKey))
+	for _, tag := range tagsByKey {
+		loggedModelTags = append(loggedModelTags, tag)
+	}
+
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "model_id"}, {Name: "tag_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tag_value"}),
+	}).CreateInBatches(loggedModelTags, batchSize).Error; err != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to set tags of logged model with ID '%s'", modelID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) DeleteLoggedModelTag(ctx context.Context, modelID, key string) *contract.Error {
+	if _, err := s.getLoggedModel(ctx, modelID); err != nil {
+		return err
+	}
+
+	result := s.db.WithContext(ctx).Where(
+		"model_id = ?", modelID,
+	).Where(
+		"tag_key = ?", key,
+	).Delete(&models.LoggedModelTag{})
+	if result.Error != nil {
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to delete tag of logged model with ID '%s'", modelID),
+			result.Error,
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		return contract.NewError(
+			protos.ErrorCode_RESOURCE_DOES_NOT_EXIST,
+			fmt.Sprintf("No tag with key '%s' found for model with ID '%s'.", key, modelID),
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) LogLoggedModelParams(
+	ctx context.Context, modelID string, params []*entities.LoggedModelParameter,
+) *contract.Error {
+	loggedModel, err := s.getLoggedModel(ctx, modelID)
+	if err != nil {
+		return err
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	loggedModelParams := make([]models.LoggedModelParam, 0, len(params))
+	for _, param := range params {
+		loggedModelParams = append(
+			loggedModelParams, models.NewLoggedModelParamFromEntity(modelID, loggedModel.ExperimentID, param),
+		)
+	}
+
+	if err := s.db.WithContext(ctx).CreateInBatches(loggedModelParams, batchSize).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Changing param values is not allowed. Params of logged model with ID '%s' "+
+					"were already logged.", modelID),
+			)
+		}
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to log params of logged model with ID '%s'", modelID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s TrackingSQLStore) SearchLoggedModels(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	datasets []*entities.LoggedModelDataset,
+	maxResults int64,
+	orderBy []*entities.LoggedModelOrderBy,
+	pageToken string,
+) ([]*entities.LoggedModel, string, *contract.Error) {
+	query := s.db.WithContext(ctx).Model(
+		&models.LoggedModel{},
+	).Where(
+		"logged_models.experiment_id IN (?)", experimentIDs,
+	).Where(
+		"logged_models.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+
+	// apply Limit
+	query, limit := applyLimitFilter(query, maxResults)
+
+	// apply Offset
+	query, offset, err := applyOffsetFilter(query, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Apply Filter
+	query, err = applyLoggedModelsFilter(s.db, query, filter, datasets)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// OrderBy
+	query, err = applyLoggedModelsOrderBy(s.db, query, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Actual query
+	var loggedModels []models.LoggedModel
+	if err := query.Preload("Tags").Preload("Params").Preload("Metrics").Find(&loggedModels).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to search logged models %q", err),
+			err,
+		)
+	}
+
+	// encode `nextPageToken` value.
+	nextPageToken, err := createLoggedModelsNextPageToken(loggedModels, limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(loggedModels) > limit {
+		loggedModels = loggedModels[:limit]
+	}
+
+	data := make([]*entities.LoggedModel, len(loggedModels))
+	for i, loggedModel := range loggedModels {
+		data[i] = loggedModel.ToEntity()
+	}
+
+	return data, nextPageToken, nil
+}
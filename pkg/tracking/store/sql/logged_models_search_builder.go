@@ -0,0 +1,256 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+const loggedModelMetricsPrefix = "metrics."
+
+// Returns the stored value of a logged model status, which can be given as READY or LOGGED_MODEL_READY.
+func parseLoggedModelStatus(status string) (int32, *contract.Error) {
+	status = strings.ToUpper(status)
+	if value, ok := protos.LoggedModelStatus_value[status]; ok {
+		return value, nil
+	}
+
+	if value, ok := protos.LoggedModelStatus_value["LOGGED_MODEL_"+status]; ok {
+		return value, nil
+	}
+
+	return 0, contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf("Invalid logged model status '%s'.", status),
+	)
+}
+
+func parseLoggedModelStatusValue(value any) (any, *contract.Error) {
+	switch value := value.(type) {
+	case string:
+		return parseLoggedModelStatus(value)
+	case []string:
+		statuses := make([]int32, 0, len(value))
+
+		for _, status := range value {
+			parsed, err := parseLoggedModelStatus(status)
+			if err != nil {
+				return nil, err
+			}
+
+			statuses = append(statuses, parsed)
+		}
+
+		return statuses, nil
+	default:
+		return value, nil
+	}
+}
+
+// Returns the latest value of a metric for each logged model,
+// only considering the metrics logged on the given datasets if any.
+//
+// SELECT model_id, metric_value FROM (
+//
+//	SELECT model_id, metric_value,
+//	       ROW_NUMBER() OVER (PARTITION BY model_id ORDER BY metric_timestamp_ms DESC, metric_step DESC) AS metric_rank
+//	FROM logged_model_metrics
+//	WHERE metric_name = ? AND ((dataset_name = ? AND dataset_digest = ?) OR ...)
+//
+// ) AS latest WHERE metric_rank = 1
+func latestLoggedModelMetrics(database *gorm.DB, key string, datasets []*entities.LoggedModelDataset) *gorm.DB {
+	metrics := database.Model(
+		&models.LoggedModelMetric{},
+	).Select(
+		"model_id, metric_value, ROW_NUMBER() OVER ("+
+			"PARTITION BY model_id ORDER BY metric_timestamp_ms DESC, metric_step DESC"+
+			") AS metric_rank",
+	).Where("metric_name = ?", key)
+
+	if len(datasets) > 0 {
+		datasetConditions := database.Where("1 = 0")
+
+		for _, dataset := range datasets {
+			condition := database.Where("dataset_name = ?", dataset.Name)
+			if dataset.Digest != "" {
+				condition = condition.Where("dataset_digest = ?", dataset.Digest)
+			}
+
+			datasetConditions = datasetConditions.Or(condition)
+		}
+
+		metrics = metrics.Where(datasetConditions)
+	}
+
+	return database.Table("(?) AS latest", metrics).Select(
+		"model_id", "metric_value",
+	).Where("metric_rank = 1")
+}
+
+//nolint:funlen,cyclop
+func applyLoggedModelsFilter(
+	database, transaction *gorm.DB, filter string, datasets []*entities.LoggedModelDataset,
+) (*gorm.DB, *contract.Error) {
+	filterConditions, err := query.ParseLoggedModelFilter(filter)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", err),
+		)
+	}
+
+	for index, condition := range filterConditions {
+		comparison, wrapColumn, value := FilterComparison(database, condition)
+
+		table := fmt.Sprintf("filter_%d", index)
+
+		//nolint:exhaustive
+		switch condition.Identifier {
+		case parser.Attribute:
+			column := wrapColumn("logged_models." + condition.Key)
+
+			if condition.Key == "status" {
+				statusValue, err := parseLoggedModelStatusValue(value)
+				if err != nil {
+					return nil, err
+				}
+
+				value = statusValue
+			}
+
+			placeholder := "?"
+			if condition.Operator == parser.In || condition.Operator == parser.NotIn {
+				placeholder = "(?)"
+			}
+
+			transaction = transaction.Where(fmt.Sprintf("%s %s %s", column, comparison, placeholder), value)
+		case parser.Metric:
+			transaction = transaction.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON logged_models.model_id = %s.model_id", table, table),
+				database.Table(
+					"(?) AS metrics", latestLoggedModelMetrics(database, condition.Key, datasets),
+				).Select("model_id").Where(fmt.Sprintf("metric_value %s ?", comparison), value),
+			)
+		case parser.Parameter, parser.Tag:
+			var model any = &models.LoggedModelParam{}
+
+			keyColumn, valueColumn := "param_key", "param_value"
+			if condition.Identifier == parser.Tag {
+				model = &models.LoggedModelTag{}
+				keyColumn, valueColumn = "tag_key", "tag_value"
+			}
+
+			where := fmt.Sprintf("%s %s ?", wrapColumn(valueColumn), comparison)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON logged_models.model_id = %s.model_id", table, table),
+				database.Model(model).Select("model_id").Where(keyColumn+" = ?", condition.Key).Where(where, value),
+			)
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid token type: %s", condition.Identifier),
+			)
+		}
+	}
+
+	return transaction, nil
+}
+
+// Returns the logged_models column of an order_by attribute field.
+func parseLoggedModelOrderByAttribute(fieldName string) (string, *contract.Error) {
+	switch fieldName {
+	case "name", "model_id", "model_type", "status", "source_run_id", "experiment_id":
+		return fieldName, nil
+	case "creation_timestamp", "creation_time", parser.LoggedModelCreationTimestamp:
+		return parser.LoggedModelCreationTimestamp, nil
+	case "last_updated_timestamp", "last_updated_time", parser.LoggedModelLastUpdatedTimestamp:
+		return parser.LoggedModelLastUpdatedTimestamp, nil
+	default:
+		return "", contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("Invalid order by field name: %s", fieldName),
+		)
+	}
+}
+
+// Logged models are ordered by the given clauses, followed by creation_timestamp_ms DESC and model_id as tiebreakers.
+func applyLoggedModelsOrderBy(
+	database, transaction *gorm.DB, orderBy []*entities.LoggedModelOrderBy,
+) (*gorm.DB, *contract.Error) {
+	observedColumns := map[string]struct{}{}
+	columnSelection := "logged_models.*"
+
+	for index, order := range orderBy {
+		var column clause.Column
+
+		if metricKey, ok := strings.CutPrefix(order.FieldName, loggedModelMetricsPrefix); ok {
+			var datasets []*entities.LoggedModelDataset
+			if order.Dataset != nil {
+				datasets = append(datasets, order.Dataset)
+			}
+
+			table := fmt.Sprintf("order_%d", index)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON logged_models.model_id = %s.model_id", table, table),
+				latestLoggedModelMetrics(database, metricKey, datasets),
+			)
+
+			column = clause.Column{Table: table, Name: "metric_value"}
+		} else {
+			name, err := parseLoggedModelOrderByAttribute(order.FieldName)
+			if err != nil {
+				return nil, err
+			}
+
+			observedColumns[name] = struct{}{}
+			column = clause.Column{Table: "logged_models", Name: name}
+		}
+
+		// Null values are ordered last whatever the direction.
+		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
+		columnSelection = fmt.Sprintf(
+			"%s, (CASE WHEN (%s.%s IS NULL) THEN 1 ELSE 0 END) AS %s",
+			columnSelection,
+			column.Table,
+			column.Name,
+			nullableColumnAlias,
+		)
+
+		transaction = transaction.Order(nullableColumnAlias).Order(clause.OrderByColumn{
+			Column: column,
+			Desc:   !order.Ascending,
+		})
+	}
+
+	if _, ok := observedColumns[parser.LoggedModelCreationTimestamp]; !ok {
+		transaction = transaction.Order("logged_models.creation_timestamp_ms DESC")
+	}
+
+	if _, ok := observedColumns["model_id"]; !ok {
+		transaction = transaction.Order("logged_models.model_id")
+	}
+
+	return transaction.Select(columnSelection), nil
+}
+
+func createLoggedModelsNextPageToken(
+	loggedModels []models.LoggedModel, limit, offset int,
+) (string, *contract.Error) {
+	if len(loggedModels) > limit {
+		return utils.EncodePageToken(offset + limit)
+	}
+
+	return "", nil
+}
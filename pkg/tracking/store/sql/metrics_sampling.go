@@ -0,0 +1,174 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+// MaxResultsGetMetricHistory caps the number of metrics returned for a single run.
+const MaxResultsGetMetricHistory = 25000
+
+// SampleSteps samples at most maxResults steps at a regular interval
+// from the sorted steps within [startStep, endStep].
+// The last step of the range is always part of the sample.
+// Port of _get_sampled_steps_from_steps in the Python server handlers.
+func SampleSteps(startStep, endStep int64, maxResults int, allSteps []int64) map[int64]struct{} {
+	startIndex := sort.Search(len(allSteps), func(i int) bool { return allSteps[i] >= startStep })
+	endIndex := sort.Search(len(allSteps), func(i int) bool { return allSteps[i] > endStep })
+
+	sampledSteps := make(map[int64]struct{}, maxResults+1)
+
+	if endIndex-startIndex <= maxResults {
+		for _, step := range allSteps[startIndex:endIndex] {
+			sampledSteps[step] = struct{}{}
+		}
+
+		return sampledSteps
+	}
+
+	interval := float64(endIndex-startIndex) / float64(maxResults)
+	for i := range maxResults {
+		if index := startIndex + int(float64(i)*interval); index < endIndex {
+			sampledSteps[allSteps[index]] = struct{}{}
+		}
+	}
+
+	sampledSteps[allSteps[endIndex-1]] = struct{}{}
+
+	return sampledSteps
+}
+
+// Returns the steps to fetch for the metric histories of the runs.
+// The first and last step of every run are kept, so that each line of a chart spans its full range.
+// Without a step range, all steps from 0 to the last logged step are considered.
+func (s TrackingSQLStore) getSampledMetricSteps(
+	ctx context.Context, runIDs []string, metricKey string, startStep, endStep *int64, maxResults int,
+) ([]int64, *contract.Error) {
+	var runSteps []struct {
+		RunID   string `gorm:"column:run_uuid"`
+		MinStep int64  `gorm:"column:min_step"`
+		MaxStep int64  `gorm:"column:max_step"`
+	}
+
+	if err := s.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Select(
+		"run_uuid", "MIN(step) AS min_step", "MAX(step) AS max_step",
+	).Where(
+		"run_uuid IN (?)", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Group(
+		"run_uuid",
+	).Scan(&runSteps).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("error getting metric steps: %v", err),
+			err,
+		)
+	}
+
+	var allSteps []int64
+	if err := s.db.WithContext(ctx).Model(
+		&models.Metric{},
+	).Distinct(
+		"step",
+	).Where(
+		"run_uuid IN (?)", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Order(
+		"step",
+	).Pluck("step", &allSteps).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("error getting metric steps: %v", err),
+			err,
+		)
+	}
+
+	start, end := int64(0), int64(0)
+	if len(allSteps) > 0 {
+		end = allSteps[len(allSteps)-1]
+	}
+
+	if startStep != nil && endStep != nil {
+		start, end = *startStep, *endStep
+	}
+
+	sampledSteps := SampleSteps(start, end, maxResults, allSteps)
+
+	for _, runStep := range runSteps {
+		for _, step := range []int64{runStep.MinStep, runStep.MaxStep} {
+			if start <= step && step <= end {
+				sampledSteps[step] = struct{}{}
+			}
+		}
+	}
+
+	steps := make([]int64, 0, len(sampledSteps))
+	for step := range sampledSteps {
+		steps = append(steps, step)
+	}
+
+	slices.Sort(steps)
+
+	return steps, nil
+}
+
+func (s TrackingSQLStore) GetMetricHistoryBulkInterval(
+	ctx context.Context, runIDs []string, metricKey string, startStep, endStep *int64, maxResults int,
+) ([]*entities.MetricWithRunID, *contract.Error) {
+	steps, err := s.getSampledMetricSteps(ctx, runIDs, metricKey, startStep, endStep, maxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(steps) == 0 {
+		return []*entities.MetricWithRunID{}, nil
+	}
+
+	var metrics []models.Metric
+	if err := s.db.WithContext(ctx).Where(
+		"run_uuid IN (?)", runIDs,
+	).Where(
+		"key = ?", metricKey,
+	).Where(
+		"step IN (?)", steps,
+	).Order(
+		"step",
+	).Order(
+		"timestamp",
+	).Order(
+		"value",
+	).Find(&metrics).Error; err != nil {
+		return nil, contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("error getting metric history: %v", err),
+			err,
+		)
+	}
+
+	metricsByRun := make(map[string][]*entities.MetricWithRunID, len(runIDs))
+	for _, metric := range metrics {
+		if len(metricsByRun[metric.RunID]) < MaxResultsGetMetricHistory {
+			metricsByRun[metric.RunID] = append(metricsByRun[metric.RunID], metric.ToMetricWithRunIDEntity())
+		}
+	}
+
+	// Metrics are grouped by run, in the order of the requested run IDs, a duplicated run ID is only returned once.
+	result := make([]*entities.MetricWithRunID, 0, len(metrics))
+	for _, runID := range runIDs {
+		result = append(result, metricsByRun[runID]...)
+		delete(metricsByRun, runID)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package sql
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleSteps(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		name       string
+		startStep  int64
+		endStep    int64
+		maxResults int
+		steps      []int64
+		expected   []int64
+	}{
+		{
+			name:       "FewerStepsThanMaxResults",
+			startStep:  0,
+			endStep:    10,
+			maxResults: 5,
+			steps:      []int64{0, 2, 4},
+			expected:   []int64{0, 2, 4},
+		},
+		{
+			name:       "StepsOutsideOfRange",
+			startStep:  2,
+			endStep:    6,
+			maxResults: 5,
+			steps:      []int64{0, 2, 4, 6, 8},
+			expected:   []int64{2, 4, 6},
+		},
+		{
+			name:       "SampledAtInterval",
+			startStep:  0,
+			endStep:    9,
+			maxResults: 3,
+			steps:      []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expected:   []int64{0, 3, 6, 9},
+		},
+		{
+			name:       "EmptyRange",
+			startStep:  20,
+			endStep:    30,
+			maxResults: 3,
+			steps:      []int64{0, 1, 2},
+			expected:   []int64{},
+		},
+	}
+
+	for _, testData := range testData {
+		t.Run(testData.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := slices.Sorted(maps.Keys(
+				SampleSteps(testData.startStep, testData.endStep, testData.maxResults, testData.steps),
+			))
+
+			assert.Equal(t, testData.expected, append([]int64{}, result...))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+)
+
+// Assessment mapped from table <assessments>.
+//
+// An updated value doesn't overwrite the previous one, the previous version is kept
+// as an invalid assessment that the updated one overrides.
+type Assessment struct {
+	ID                   string         `gorm:"column:assessment_id;primaryKey"`
+	TraceID              string         `gorm:"column:trace_id;index:index_assessments_trace_id_created_timestamp"`
+	Name                 string         `gorm:"column:name"`
+	AssessmentType       string         `gorm:"column:assessment_type"`
+	Value                string         `gorm:"column:value"`
+	Error                sql.NullString `gorm:"column:error"`
+	CreatedTimestamp     int64          `gorm:"column:created_timestamp;index:index_assessments_trace_id_created_timestamp"`
+	LastUpdatedTimestamp int64          `gorm:"column:last_updated_timestamp"`
+	SourceType           string         `gorm:"column:source_type"`
+	SourceID             sql.NullString `gorm:"column:source_id"`
+	RunID                sql.NullString `gorm:"column:run_id"`
+	SpanID               sql.NullString `gorm:"column:span_id"`
+	Rationale            sql.NullString `gorm:"column:rationale"`
+	Overrides            sql.NullString `gorm:"column:overrides"`
+	Valid                bool           `gorm:"column:valid"`
+	Metadata             sql.NullString `gorm:"column:assessment_metadata"`
+}
+
+func (a Assessment) TableName() string {
+	return "assessments"
+}
+
+func (a Assessment) ToEntity() (*entities.Assessment, error) {
+	assessment := entities.Assessment{
+		AssessmentID:     a.ID,
+		TraceID:          a.TraceID,
+		Name:             a.Name,
+		Type:             a.AssessmentType,
+		SourceType:       a.SourceType,
+		SourceID:         a.SourceID.String,
+		SpanID:           a.SpanID.String,
+		Rationale:        a.Rationale.String,
+		CreateTimeMS:     a.CreatedTimestamp,
+		LastUpdateTimeMS: a.LastUpdatedTimestamp,
+	}
+
+	if err := json.Unmarshal([]byte(a.Value), &assessment.Value); err != nil {
+		return nil, err
+	}
+
+	if a.Error.Valid {
+		if err := json.Unmarshal([]byte(a.Error.String), &assessment.Error); err != nil {
+			return nil, err
+		}
+	}
+
+	if a.Metadata.Valid {
+		if err := json.Unmarshal([]byte(a.Metadata.String), &assessment.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return &assessment, nil
+}
+
+// Values are stored as JSON, like the Python store does.
+func toNullJSONString(value any) (sql.NullString, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(encoded), Valid: true}, nil
+}
+
+func NewAssessmentFromEntity(assessment *entities.Assessment) (*Assessment, error) {
+	value, err := json.Marshal(assessment.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	model := Assessment{
+		ID:                   assessment.AssessmentID,
+		TraceID:              assessment.TraceID,
+		Name:                 assessment.Name,
+		AssessmentType:       assessment.Type,
+		Value:                string(value),
+		CreatedTimestamp:     assessment.CreateTimeMS,
+		LastUpdatedTimestamp: assessment.LastUpdateTimeMS,
+		SourceType:           assessment.SourceType,
+		SourceID:             sql.NullString{String: assessment.SourceID, Valid: assessment.SourceID != ""},
+		SpanID:               sql.NullString{String: assessment.SpanID, Valid: assessment.SpanID != ""},
+		Rationale:            sql.NullString{String: assessment.Rationale, Valid: assessment.Rationale != ""},
+		Valid:                true,
+	}
+
+	if assessment.Error != nil {
+		if model.Error, err = toNullJSONString(assessment.Error); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(assessment.Metadata) > 0 {
+		if model.Metadata, err = toNullJSONString(assessment.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return &model, nil
+}
+
+// ApplyUpdate applies the update to the assessment and reports whether its value changed.
+//
+//nolint:cyclop
+func (a *Assessment) ApplyUpdate(update *entities.AssessmentUpdate, lastUpdatedTimestamp int64) (bool, error) {
+	valueChanged := false
+
+	if update.Name != nil {
+		a.Name = *update.Name
+	}
+
+	if update.Type != nil {
+		value, err := json.Marshal(update.Value)
+		if err != nil {
+			return false, err
+		}
+
+		errorValue := sql.NullString{}
+		if update.Error != nil {
+			if errorValue, err = toNullJSONString(update.Error); err != nil {
+				return false, err
+			}
+		}
+
+		valueChanged = a.Value != string(value) || a.Error != errorValue
+		a.Value = string(value)
+		a.Error = errorValue
+	}
+
+	if update.Rationale != nil {
+		a.Rationale = sql.NullString{String: *update.Rationale, Valid: *update.Rationale != ""}
+	}
+
+	if len(update.Metadata) > 0 {
+		metadata := map[string]string{}
+		if a.Metadata.Valid {
+			if err := json.Unmarshal([]byte(a.Metadata.String), &metadata); err != nil {
+				return false, err
+			}
+		}
+
+		for key, value := range update.Metadata {
+			metadata[key] = value
+		}
+
+		encoded, err := toNullJSONString(metadata)
+		if err != nil {
+			return false, err
+		}
+
+		a.Metadata = encoded
+	}
+
+	a.LastUpdatedTimestamp = lastUpdatedTimestamp
+
+	return valueChanged, nil
+}
@@ -19,6 +19,21 @@ type LoggedModelMetric struct {
 	DatasetDigest     string  `gorm:"column:dataset_digest"`
 }
 
+func (m LoggedModelMetric) ToEntity() *entities.MetricWithRunID {
+	return &entities.MetricWithRunID{
+		Metric: entities.Metric{
+			Key:           m.MetricName,
+			Value:         m.MetricValue,
+			Timestamp:     m.MetricTimestampMs,
+			Step:          m.MetricStep,
+			ModelID:       m.ModelID,
+			DatasetName:   m.DatasetName,
+			DatasetDigest: m.DatasetDigest,
+		},
+		RunID: m.RunID,
+	}
+}
+
 func NewLoggedMetricFromEntity(runID string, metric *entities.Metric) *LoggedModelMetric {
 	model := LoggedModelMetric{
 		RunID:             runID,
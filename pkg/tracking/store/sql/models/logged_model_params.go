@@ -0,0 +1,29 @@
+package models
+
+import "github.com/mlflow/mlflow-go-backend/pkg/entities"
+
+// LoggedModelParam mapped from table <logged_model_params>.
+type LoggedModelParam struct {
+	ModelID      string `gorm:"column:model_id;primaryKey"`
+	ExperimentID int32  `gorm:"column:experiment_id"`
+	Key          string `gorm:"column:param_key;primaryKey"`
+	Value        string `gorm:"column:param_value"`
+}
+
+func (p LoggedModelParam) ToEntity() *entities.LoggedModelParameter {
+	return &entities.LoggedModelParameter{
+		Key:   p.Key,
+		Value: p.Value,
+	}
+}
+
+func NewLoggedModelParamFromEntity(
+	modelID string, experimentID int32, param *entities.LoggedModelParameter,
+) LoggedModelParam {
+	return LoggedModelParam{
+		ModelID:      modelID,
+		ExperimentID: experimentID,
+		Key:          param.Key,
+		Value:        param.Value,
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import "github.com/mlflow/mlflow-go-backend/pkg/entities"
+
+// LoggedModelTag mapped from table <logged_model_tags>.
+type LoggedModelTag struct {
+	ModelID      string `gorm:"column:model_id;primaryKey"`
+	ExperimentID int32  `gorm:"column:experiment_id"`
+	Key          string `gorm:"column:tag_key;primaryKey"`
+	Value        string `gorm:"column:tag_value"`
+}
+
+func (t LoggedModelTag) ToEntity() *entities.LoggedModelTag {
+	return &entities.LoggedModelTag{
+		Key:   t.Key,
+		Value: t.Value,
+	}
+}
+
+func NewLoggedModelTagFromEntity(modelID string, experimentID int32, tag *entities.LoggedModelTag) LoggedModelTag {
+	return LoggedModelTag{
+		ModelID:      modelID,
+		ExperimentID: experimentID,
+		Key:          tag.Key,
+		Value:        tag.Value,
+	}
+}
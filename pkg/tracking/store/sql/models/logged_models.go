@@ -0,0 +1,59 @@
+package models
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// LoggedModel mapped from table <logged_models>.
+type LoggedModel struct {
+	ID                     string              `gorm:"column:model_id;primaryKey"`
+	ExperimentID           int32               `gorm:"column:experiment_id"`
+	Name                   string              `gorm:"column:name"`
+	ArtifactLocation       string              `gorm:"column:artifact_location"`
+	CreationTimestampMS    int64               `gorm:"column:creation_timestamp_ms"`
+	LastUpdatedTimestampMS int64               `gorm:"column:last_updated_timestamp_ms"`
+	Status                 int32               `gorm:"column:status"`
+	LifecycleStage         LifecycleStage      `gorm:"column:lifecycle_stage"`
+	ModelType              sql.NullString      `gorm:"column:model_type"`
+	SourceRunID            sql.NullString      `gorm:"column:source_run_id"`
+	StatusMessage          sql.NullString      `gorm:"column:status_message"`
+	Tags                   []LoggedModelTag    `gorm:"foreignKey:ModelID"`
+	Params                 []LoggedModelParam  `gorm:"foreignKey:ModelID"`
+	Metrics                []LoggedModelMetric `gorm:"foreignKey:ModelID"`
+}
+
+func (lm LoggedModel) ToEntity() *entities.LoggedModel {
+	loggedModel := entities.LoggedModel{
+		ModelID:                lm.ID,
+		ExperimentID:           strconv.Itoa(int(lm.ExperimentID)),
+		Name:                   lm.Name,
+		ArtifactURI:            lm.ArtifactLocation,
+		CreationTimestampMS:    lm.CreationTimestampMS,
+		LastUpdatedTimestampMS: lm.LastUpdatedTimestampMS,
+		Status:                 protos.LoggedModelStatus(lm.Status),
+		StatusMessage:          lm.StatusMessage.String,
+		ModelType:              lm.ModelType.String,
+		SourceRunID:            lm.SourceRunID.String,
+		Tags:                   make([]*entities.LoggedModelTag, 0, len(lm.Tags)),
+		Params:                 make([]*entities.LoggedModelParameter, 0, len(lm.Params)),
+		Metrics:                make([]*entities.MetricWithRunID, 0, len(lm.Metrics)),
+	}
+
+	for _, tag := range lm.Tags {
+		loggedModel.Tags = append(loggedModel.Tags, tag.ToEntity())
+	}
+
+	for _, param := range lm.Params {
+		loggedModel.Params = append(loggedModel.Params, param.ToEntity())
+	}
+
+	for _, metric := range lm.Metrics {
+		loggedModel.Metrics = append(loggedModel.Metrics, metric.ToEntity())
+	}
+
+	return &loggedModel
+}
@@ -55,6 +55,13 @@ func (m Metric) ToEntity() *entities.Metric {
 	}
 }
 
+func (m Metric) ToMetricWithRunIDEntity() *entities.MetricWithRunID {
+	return &entities.MetricWithRunID{
+		Metric: *m.ToEntity(),
+		RunID:  m.RunID,
+	}
+}
+
 func (m Metric) NewLatestMetricFromProto() LatestMetric {
 	return LatestMetric{
 		RunID:     m.RunID,
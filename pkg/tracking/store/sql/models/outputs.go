@@ -21,3 +21,14 @@ func (o *Output) ToEntity() *entities.ModelOutput {
 		ModelID: o.DestinationID,
 	}
 }
+
+func NewOutputFromEntity(id, runID string, output *entities.ModelOutput) *Output {
+	return &Output{
+		ID:              id,
+		Step:            output.Step,
+		SourceType:      SourceTypeRunOutput.String(),
+		SourceID:        runID,
+		DestinationType: DestinationTypeModelOutput,
+		DestinationID:   output.ModelID,
+	}
+}
@@ -28,7 +28,8 @@ type Run struct {
 	Metrics        []Metric
 	LatestMetrics  []LatestMetric
 	Inputs         []Input  `gorm:"foreignKey:DestinationID"`
-	Outputs        []Output `gorm:"foreignKey:DestinationID"`
+	ModelInputs    []Input  `gorm:"-"`
+	Outputs        []Output `gorm:"-"`
 }
 
 type RunStatus string
@@ -89,8 +90,8 @@ func (r Run) ToEntity() *entities.Run {
 		modelOutputs = append(modelOutputs, output.ToEntity())
 	}
 
-	modelInputs := make([]*entities.ModelInput, 0, len(r.Inputs))
-	for _, input := range r.Inputs {
+	modelInputs := make([]*entities.ModelInput, 0, len(r.ModelInputs))
+	for _, input := range r.ModelInputs {
 		modelInputs = append(modelInputs, input.ModelInputToEntity())
 	}
 
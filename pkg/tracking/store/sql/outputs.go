@@ -0,0 +1,56 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+)
+
+func (s TrackingSQLStore) LogOutputs(
+	ctx context.Context, runID string, modelOutputs []*entities.ModelOutput,
+) *contract.Error {
+	err := s.db.WithContext(ctx).Transaction(func(transaction *gorm.DB) error {
+		if contractError := checkRunIsActive(transaction, runID); contractError != nil {
+			return contractError
+		}
+
+		if len(modelOutputs) == 0 {
+			return nil
+		}
+
+		outputsToInsert := make([]*models.Output, 0, len(modelOutputs))
+		for _, modelOutput := range modelOutputs {
+			outputsToInsert = append(outputsToInsert, models.NewOutputFromEntity(newGUID(), runID, modelOutput))
+		}
+
+		// Logging the same model again records the step it was produced at last.
+		return transaction.Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "source_type"}, {Name: "source_id"}, {Name: "destination_type"}, {Name: "destination_id"},
+			},
+			DoUpdates: clause.AssignmentColumns([]string{"step"}),
+		}).CreateInBatches(&outputsToInsert, batchSize).Error
+	})
+	if err != nil {
+		var contractError *contract.Error
+		if errors.As(err, &contractError) {
+			return contractError
+		}
+
+		return contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("log outputs transaction failed for %q", runID),
+			err,
+		)
+	}
+
+	return nil
+}
@@ -76,7 +76,7 @@ func (s TrackingSQLStore) SearchRuns(
 	transaction.Limit(maxResults)
 
 	// PageToken
-	offset, contractError := getOffset(pageToken)
+	offset, contractError := utils.DecodePageToken(pageToken)
 	if contractError != nil {
 		return nil, "", contractError
 	}
@@ -218,10 +218,18 @@ func (s TrackingSQLStore) GetRun(ctx context.Context, runID string) (*entities.R
 		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run", err)
 	}
 
+	// Model inputs and outputs are stored with the run as source, they can't be preloaded.
+	if err := s.db.WithContext(ctx).Where(
+		"source_type = ? AND destination_type = ? AND source_id = ?",
+		models.SourceTypeRunInput, models.DestinationTypeModelInput, runID,
+	).Find(&run.ModelInputs).Error; err != nil {
+		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run model inputs", err)
+	}
+
 	if err := s.db.WithContext(ctx).Where(
 		"source_type = ? AND destination_type = ? AND source_id = ?",
 		models.SourceTypeRunOutput, models.DestinationTypeModelOutput, runID,
-	).Find(&run.Outputs).Error; err != nil {
+	).Order("step").Order("destination_id").Find(&run.Outputs).Error; err != nil {
 		return nil, contract.NewErrorWith(protos.ErrorCode_INTERNAL_ERROR, "failed to get run outputs", err)
 	}
 
@@ -280,7 +288,7 @@ func (s TrackingSQLStore) CreateRun(
 		return nil, errRunName
 	}
 
-	if err := s.db.Create(&runModel).Error; err != nil {
+	if err := s.db.WithContext(ctx).Create(&runModel).Error; err != nil {
 		return nil, contract.NewErrorWith(
 			protos.ErrorCode_INTERNAL_ERROR,
 			fmt.Sprintf(
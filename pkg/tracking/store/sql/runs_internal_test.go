@@ -283,6 +283,113 @@ var tests = []testData{
 		},
 		expectedVars: []any{"accuracy", 0.72, "batch_size", "%a"},
 	},
+	{
+		name:  "OrQuery",
+		query: "metrics.accuracy > 0.72 OR params.batch_size = '2'",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE (
+		runs.run_uuid IN (SELECT "run_uuid" FROM "latest_metrics" WHERE key = $1 AND value > $2)
+		OR runs.run_uuid IN (SELECT "run_uuid" FROM "params" WHERE key = $3 AND value = $4)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE (
+		runs.run_uuid IN (SELECT run_uuid FROM latest_metrics WHERE key = ? AND value > ?)
+		OR runs.run_uuid IN (SELECT run_uuid FROM params WHERE key = ? AND value = ?)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"accuracy", 0.72, "batch_size", "2"},
+	},
+	{
+		name: "GroupedOrQuery",
+		query: "metrics.accuracy > 0.72 AND " +
+			"(tags.task ILIKE 'classif%' OR (datasets.context = 'train' AND attributes.run_id = 'a1b2c3d4'))",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	JOIN (SELECT "run_uuid","value" FROM "latest_metrics" WHERE key = $1 AND value > $2)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	WHERE (
+		runs.run_uuid IN (SELECT "run_uuid" FROM "tags" WHERE key = $3 AND value ILIKE $4)
+		OR (
+			runs.run_uuid IN (
+				SELECT inputs.destination_id AS run_uuid FROM "inputs"
+				JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid
+				AND input_tags.name = 'mlflow.data.context'
+				AND input_tags.value = $5 WHERE inputs.destination_type = 'RUN'
+			)
+			AND runs.run_uuid = $6
+		)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	JOIN (SELECT run_uuid,value FROM latest_metrics WHERE key = ? AND value > ?)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	WHERE (
+		runs.run_uuid IN (SELECT run_uuid FROM tags WHERE key = ? AND LOWER(value) LIKE ?)
+		OR (
+			runs.run_uuid IN (
+				SELECT inputs.destination_id AS run_uuid FROM inputs
+				JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid
+				AND input_tags.name = 'mlflow.data.context'
+				AND input_tags.value = ? WHERE inputs.destination_type = 'RUN'
+			)
+			AND runs.run_uuid = ?
+		)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"accuracy", 0.72, "task", "classif%", "train", "a1b2c3d4"},
+	},
+	{
+		name:  "IsNullQuery",
+		query: "tags.holdout IS NULL AND params.lr IS NOT NULL",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	LEFT JOIN (SELECT "run_uuid" FROM "tags" WHERE key = $1)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	JOIN (SELECT "run_uuid" FROM "params" WHERE key = $2)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE filter_0.run_uuid IS NULL
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	LEFT JOIN (SELECT run_uuid FROM tags WHERE key = ?)
+	AS filter_0 ON runs.run_uuid = filter_0.run_uuid
+	JOIN (SELECT run_uuid FROM params WHERE key = ?)
+	AS filter_1 ON runs.run_uuid = filter_1.run_uuid
+	WHERE filter_0.run_uuid IS NULL
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"holdout", "lr"},
+	},
+	{
+		name:  "IsNullOrQuery",
+		query: "metrics.auc IS NULL OR tags.holdout IS NOT NULL",
+		expectedSQL: map[string]string{
+			"postgres": `
+	SELECT "run_uuid" FROM "runs"
+	WHERE (
+		runs.run_uuid NOT IN (SELECT "run_uuid" FROM "latest_metrics" WHERE key = $1)
+		OR runs.run_uuid IN (SELECT "run_uuid" FROM "tags" WHERE key = $2)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+			"sqlite": `
+	SELECT run_uuid FROM runs
+	WHERE (
+		runs.run_uuid NOT IN (SELECT run_uuid FROM latest_metrics WHERE key = ?)
+		OR runs.run_uuid IN (SELECT run_uuid FROM tags WHERE key = ?)
+	)
+	ORDER BY runs.start_time DESC,runs.run_uuid`,
+		},
+		expectedVars: []any{"auc", "holdout"},
+	},
 	{
 		name:    "OrderByStartTimeASC",
 		query:   "",
@@ -2,8 +2,6 @@ package sql
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -42,31 +40,174 @@ func applyLifecycleStagesFilter(runViewType protos.ViewType) []models.LifecycleS
 	}
 }
 
-func getOffset(pageToken string) (int, *contract.Error) {
-	if pageToken != "" {
-		var token PageToken
-		if err := json.NewDecoder(
-			base64.NewDecoder(
-				base64.StdEncoding,
-				strings.NewReader(pageToken),
-			),
-		).Decode(&token); err != nil {
-			return 0, contract.NewErrorWith(
-				protos.ErrorCode_INVALID_PARAMETER_VALUE,
-				fmt.Sprintf("invalid page_token: %q", pageToken),
-				err,
-			)
+// runsFilter is a comparison of the filter resolved against the tables of the runs.
+type runsFilter struct {
+	identifier parser.ValidIdentifier
+	kind       any
+	key        string
+	comparison string
+	value      any
+	lowercase  bool
+}
+
+func newRunsFilter(database *gorm.DB, clause *parser.ValidCompareExpr) runsFilter {
+	filter := runsFilter{
+		identifier: clause.Identifier,
+		key:        clause.Key,
+		comparison: strings.ToUpper(clause.Operator.String()),
+		value:      clause.Value,
+	}
+
+	switch clause.Identifier {
+	case parser.Metric:
+		filter.kind = &models.LatestMetric{}
+	case parser.Parameter:
+		filter.kind = &models.Param{}
+	case parser.Tag:
+		filter.kind = &models.Tag{}
+	case parser.Dataset:
+		filter.kind = &models.Dataset{}
+	case parser.Attribute:
+		filter.kind = nil
+	}
+
+	// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
+	// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
+	if filter.key == "run_name" {
+		filter.identifier = parser.Tag
+		filter.kind = &models.Tag{}
+		filter.key = utils.TagRunName
+	}
+
+	// SQLite has no ILIKE, both sides are lowercased and compared with LIKE instead.
+	if database.Dialector.Name() == "sqlite" && filter.comparison == "ILIKE" {
+		filter.lowercase = true
+		filter.comparison = "LIKE"
+
+		if str, ok := filter.value.(string); ok {
+			filter.value = strings.ToLower(str)
+		}
+	}
+
+	return filter
+}
+
+// IS NULL and IS NOT NULL filters check for the presence of a metric, param or tag.
+func (f runsFilter) isPresenceCheck() bool {
+	return f.comparison == parser.IsNull.String() || f.comparison == parser.IsNotNull.String()
+}
+
+// Returns the condition comparing the column with the value of the filter.
+func (f runsFilter) condition(column string) string {
+	if f.lowercase {
+		column = "LOWER(" + column + ")"
+	}
+
+	return column + " " + f.comparison + " ?"
+}
+
+// Returns the subquery selecting the runs matching a metric, param, tag or dataset filter,
+// along with the column holding the run IDs. When selectValue is set, the compared value
+// is selected as well.
+func (f runsFilter) subquery(database *gorm.DB, selectValue bool) (*gorm.DB, string) {
+	switch {
+	case f.identifier == parser.Dataset && f.key == "context":
+		// SELECT inputs.destination_id AS run_uuid
+		// FROM inputs
+		// JOIN input_tags
+		// ON inputs.input_uuid = input_tags.input_uuid
+		// AND input_tags.name = 'mlflow.data.context'
+		// AND input_tags.value %s ?
+		// WHERE inputs.destination_type = 'RUN'
+		return database.Select("inputs.destination_id AS run_uuid").
+			Joins(
+				"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
+					" AND input_tags.name = '"+utils.TagDatasetContext+"'"+
+					" AND "+f.condition("input_tags.value"),
+				f.value,
+			).
+			Where("inputs.destination_type = 'RUN'").
+			Model(&models.Input{}), "run_uuid"
+	case f.identifier == parser.Dataset:
+		// SELECT destination_id, key
+		// FROM datasets
+		// JOIN inputs ON inputs.source_id = datasets.dataset_uuid
+		// WHERE key comparison value
+		//
+		// columns: name, digest, context
+		columns := []string{"destination_id"}
+		if selectValue {
+			columns = append(columns, f.key)
+		}
+
+		return database.Model(f.kind).
+			Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
+			Where(f.condition(f.key), f.value).
+			Select(columns), "destination_id"
+	default:
+		if f.isPresenceCheck() {
+			return database.Select("run_uuid").Where("key = ?", f.key).Model(f.kind), "run_uuid"
+		}
+
+		columns := []string{"run_uuid"}
+		if selectValue {
+			columns = append(columns, "value")
+		}
+
+		return database.Select(columns).
+			Where("key = ?", f.key).
+			Where(f.condition("value"), f.value).
+			Model(f.kind), "run_uuid"
+	}
+}
+
+// Returns the condition matching the runs of a filter containing OR operators.
+// Comparisons on metrics, params, tags and datasets are translated to subqueries
+// as joins can't express a disjunction.
+//
+//nolint:ireturn
+func runsFilterCondition(database *gorm.DB, expr parser.ValidExpr) clause.Expression {
+	switch expr := expr.(type) {
+	case *parser.ValidBooleanExpr:
+		conditions := make([]clause.Expression, 0, len(expr.Exprs))
+		for _, e := range expr.Exprs {
+			conditions = append(conditions, runsFilterCondition(database, e))
 		}
 
-		return int(token.Offset), nil
+		if expr.Operator == parser.Or {
+			return clause.Or(conditions...)
+		}
+
+		return clause.And(conditions...)
+	case *parser.ValidCompareExpr:
+		filter := newRunsFilter(database, expr)
+		if filter.kind == nil {
+			return clause.Expr{SQL: filter.condition("runs." + filter.key), Vars: []any{filter.value}}
+		}
+
+		subquery, _ := filter.subquery(database, false)
+
+		if filter.comparison == parser.IsNull.String() {
+			return clause.Expr{SQL: "runs.run_uuid NOT IN (?)", Vars: []any{subquery}}
+		}
+
+		return clause.Expr{SQL: "runs.run_uuid IN (?)", Vars: []any{subquery}}
+	default:
+		return clause.Expr{}
 	}
+}
 
-	return 0, nil
+// Returns the expressions of the top level conjunction of a filter.
+func filterConjuncts(expr parser.ValidExpr) []parser.ValidExpr {
+	if booleanExpr, ok := expr.(*parser.ValidBooleanExpr); ok && booleanExpr.Operator == parser.And {
+		return booleanExpr.Exprs
+	}
+
+	return []parser.ValidExpr{expr}
 }
 
-//nolint:funlen,cyclop,gocognit
 func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter string) *contract.Error {
-	filterConditions, err := query.ParseFilter(filter)
+	filterExpression, err := query.ParseFilter(filter)
 	if err != nil {
 		return contract.NewErrorWith(
 			protos.ErrorCode_INVALID_PARAMETER_VALUE,
@@ -75,128 +216,47 @@ func applyFilter(ctx context.Context, database, transaction *gorm.DB, filter str
 		)
 	}
 
-	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterConditions)
+	if filterExpression == nil {
+		return nil
+	}
 
-	for index, clause := range filterConditions {
-		var kind any
+	utils.GetLoggerFromContext(ctx).Debugf("Filter conditions: %v", filterExpression)
 
-		key := clause.Key
-		comparison := strings.ToUpper(clause.Operator.String())
-		value := clause.Value
-
-		switch clause.Identifier {
-		case parser.Metric:
-			kind = &models.LatestMetric{}
-		case parser.Parameter:
-			kind = &models.Param{}
-		case parser.Tag:
-			kind = &models.Tag{}
-		case parser.Dataset:
-			kind = &models.Dataset{}
-		case parser.Attribute:
-			kind = nil
-		}
+	// The comparisons of the top level conjunction are applied as joins,
+	// only the disjunctions need to be expressed as conditions.
+	for index, conjunct := range filterConjuncts(filterExpression) {
+		clause, ok := conjunct.(*parser.ValidCompareExpr)
+		if !ok {
+			transaction.Where(runsFilterCondition(database, conjunct))
 
-		// Treat "attributes.run_name == <value>" as "tags.`mlflow.runName` == <value>".
-		// The name column in the runs table is empty for runs logged in MLflow <= 1.29.0.
-		if key == "run_name" {
-			kind = &models.Tag{}
-			key = utils.TagRunName
+			continue
 		}
 
-		isSqliteAndILike := database.Dialector.Name() == "sqlite" && comparison == "ILIKE"
-		table := fmt.Sprintf("filter_%d", index)
-
-		switch {
-		case kind == nil:
-			if isSqliteAndILike {
-				key = fmt.Sprintf("LOWER(runs.%s)", key)
-				comparison = "LIKE"
+		filter := newRunsFilter(database, clause)
 
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
+		if filter.kind == nil {
+			transaction.Where(filter.condition("runs."+filter.key), filter.value)
 
-				transaction.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			} else {
-				transaction.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-			}
-		case clause.Identifier == parser.Dataset && key == "context":
-			// SELECT *
-			// FROM runs
-			// JOIN (
-			//   SELECT inputs.destination_id AS run_uuid
-			//   FROM inputs
-			//   JOIN input_tags
-			//   ON inputs.input_uuid = input_tags.input_uuid
-			//   AND input_tags.name = 'mlflow.data.context'
-			//   AND input_tags.value %s ?
-			//   WHERE inputs.destination_type = 'RUN'
-			// ) AS filter_0
-			// ON runs.run_uuid = filter_0.run_uuid
-			valueColumn := "input_tags.value "
-			if isSqliteAndILike {
-				valueColumn = "LOWER(input_tags.value) "
-				comparison = "LIKE"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+			continue
+		}
 
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("inputs.destination_id AS run_uuid").
-					Joins(
-						"JOIN input_tags ON inputs.input_uuid = input_tags.input_uuid"+
-							" AND input_tags.name = 'mlflow.data.context'"+
-							" AND "+valueColumn+comparison+" ?",
-						value,
-					).
-					Where("inputs.destination_type = 'RUN'").
-					Model(&models.Input{}),
-			)
-		case clause.Identifier == parser.Dataset:
-			// add join with datasets
-			// JOIN (
-			// 		SELECT "experiment_id", key
-			//		FROM datasests d
-			// 		JOIN inputs ON inputs.source_id = datasets.dataset_uuid
-			//		WHERE key comparison value
-			// ) AS filter_0 ON runs.experiment_id = dataset.experiment_id
-			//
-			// columns: name, digest, context
-			where := key + " " + comparison + " ?"
-			if isSqliteAndILike {
-				where = "LOWER(" + key + ") LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+		table := fmt.Sprintf("filter_%d", index)
+		subquery, column := filter.subquery(database, true)
 
+		// Runs missing the metric, param or tag are found with an anti-join.
+		if filter.comparison == parser.IsNull.String() {
 			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.destination_id", table, table),
-				database.Model(kind).
-					Joins("JOIN inputs ON inputs.source_id = datasets.dataset_uuid").
-					Where(where, value).
-					Select("destination_id", key),
-			)
-		default:
-			where := fmt.Sprintf("value %s ?", comparison)
-			if isSqliteAndILike {
-				where = "LOWER(value) LIKE ?"
-
-				if str, ok := value.(string); ok {
-					value = strings.ToLower(str)
-				}
-			}
+				fmt.Sprintf("LEFT JOIN (?) AS %s ON runs.run_uuid = %s.%s", table, table, column),
+				subquery,
+			).Where(fmt.Sprintf("%s.%s IS NULL", table, column))
 
-			transaction.Joins(
-				fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.Select("run_uuid", "value").Where("key = ?", key).Where(where, value).Model(kind),
-			)
+			continue
 		}
+
+		transaction.Joins(
+			fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.%s", table, table, column),
+			subquery,
+		)
 	}
 
 	return nil
@@ -465,26 +525,10 @@ func applyOrderBy(ctx context.Context, database, transaction *gorm.DB, orderBy [
 	return nil
 }
 
-//nolint:gosec // disable G115
 func mkNextPageToken(runLength, maxResults, offset int) (string, *contract.Error) {
-	var nextPageToken string
-
 	if runLength == maxResults {
-		var token strings.Builder
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(PageToken{
-			Offset: int32(offset + maxResults),
-		}); err != nil {
-			return "", contract.NewErrorWith(
-				protos.ErrorCode_INTERNAL_ERROR,
-				"error encoding 'nextPageToken' value",
-				err,
-			)
-		}
-
-		nextPageToken = token.String()
+		return utils.EncodePageToken(offset + maxResults)
 	}
 
-	return nextPageToken, nil
+	return "", nil
 }
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -21,12 +22,21 @@ func NewTrackingSQLStore(ctx context.Context, config *config.Config) (*TrackingS
 		return nil, fmt.Errorf("failed to connect to database %q: %w", config.TrackingStoreURI, err)
 	}
 
+	if err := ensureDefaultExperiment(ctx, database, config.DefaultArtifactRoot); err != nil {
+		return nil, errors.Join(err, sql.CloseDatabase(database))
+	}
+
 	return &TrackingSQLStore{
 		config: config,
 		db:     database,
 	}, nil
 }
 
+// DB returns the database of the store, for the stats of its connection pool.
+func (s TrackingSQLStore) DB() *gorm.DB {
+	return s.db
+}
+
 func (s TrackingSQLStore) Destroy() error {
 	if err := sql.CloseDatabase(s.db); err != nil {
 		return fmt.Errorf("failed to close database: %w", err)
@@ -0,0 +1,244 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/config"
+	"github.com/mlflow/mlflow-go-backend/pkg/entities"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestInMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := &config.Config{
+		TrackingStoreURI:    "sqlite:///:memory:",
+		DefaultArtifactRoot: "mlflow-artifacts:/",
+	}
+
+	store, err := NewTrackingSQLStore(ctx, cfg)
+	require.NoError(t, err)
+
+	experiment, contractErr := store.GetExperiment(ctx, "0")
+	require.Nil(t, contractErr)
+	assert.Equal(t, "Default", experiment.Name)
+	assert.Equal(t, "mlflow-artifacts:/0", experiment.ArtifactLocation)
+
+	experimentID, contractErr := store.CreateExperiment(ctx, "in-memory", "", nil)
+	require.Nil(t, contractErr)
+	assert.Equal(t, "1", experimentID)
+
+	_, contractErr = store.CreateExperiment(ctx, "in-memory", "", nil)
+	require.NotNil(t, contractErr)
+	assert.Equal(t, protos.ErrorCode_RESOURCE_ALREADY_EXISTS, protos.ErrorCode(contractErr.Code))
+
+	// A second store of the process shares the same database.
+	other, err := NewTrackingSQLStore(ctx, cfg)
+	require.NoError(t, err)
+
+	_, contractErr = other.GetExperimentByName(ctx, "in-memory")
+	require.Nil(t, contractErr)
+
+	require.NoError(t, other.Destroy())
+	require.NoError(t, store.Destroy())
+}
+
+func TestSearchTracesPages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewTrackingSQLStore(ctx, &config.Config{
+		TrackingStoreURI:    "sqlite:///:memory:",
+		DefaultArtifactRoot: "mlflow-artifacts:/",
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	expected := make([]string, 0, 12)
+
+	for i := range 12 {
+		trace, err := store.SetTrace(ctx, "0", int64(i), nil, []*entities.TraceTag{{Key: "paged", Value: "traces"}})
+		require.NoError(t, err)
+
+		expected = append(expected, trace.RequestID)
+	}
+
+	// The token of the second page, {"offset":10}, is not a multiple of three bytes and ends with padding.
+	requestIDs := make([]string, 0, 12)
+	pageToken := ""
+
+	for range 3 {
+		traces, nextPageToken, contractErr := store.SearchTraces(
+			ctx, []string{"0"}, "tag.paged = 'traces'", 10, []string{"timestamp_ms ASC"}, pageToken,
+		)
+		require.Nil(t, contractErr)
+
+		for _, trace := range traces {
+			requestIDs = append(requestIDs, trace.RequestID)
+		}
+
+		pageToken = nextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	assert.Equal(t, expected, requestIDs)
+	assert.Empty(t, pageToken)
+}
+
+func TestSearchLoggedModelsPages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewTrackingSQLStore(ctx, &config.Config{
+		TrackingStoreURI:    "sqlite:///:memory:",
+		DefaultArtifactRoot: "mlflow-artifacts:/",
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	expected := make([]string, 0, 12)
+
+	for i := range 12 {
+		loggedModel, contractErr := store.CreateLoggedModel(ctx, "0", fmt.Sprintf("paged-%02d", i), "", "", nil, nil)
+		require.Nil(t, contractErr)
+
+		expected = append(expected, loggedModel.Name)
+	}
+
+	// The token of the second page, {"offset":10}, is not a multiple of three bytes and ends with padding.
+	names := make([]string, 0, 12)
+	pageToken := ""
+
+	for range 3 {
+		loggedModels, nextPageToken, contractErr := store.SearchLoggedModels(
+			ctx, []string{"0"}, "name LIKE 'paged-%'", nil, 10,
+			[]*entities.LoggedModelOrderBy{{FieldName: "name", Ascending: true}}, pageToken,
+		)
+		require.Nil(t, contractErr)
+
+		for _, loggedModel := range loggedModels {
+			names = append(names, loggedModel.Name)
+		}
+
+		pageToken = nextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	assert.Equal(t, expected, names)
+	assert.Empty(t, pageToken)
+}
+
+//nolint:funlen
+func TestAssessmentOverrides(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewTrackingSQLStore(ctx, &config.Config{
+		TrackingStoreURI:    "sqlite:///:memory:",
+		DefaultArtifactRoot: "mlflow-artifacts:/",
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.Destroy())
+	})
+
+	trace, err := store.SetTrace(ctx, "0", 0, nil, nil)
+	require.NoError(t, err)
+
+	countAssessments := func() int64 {
+		t.Helper()
+
+		var count int64
+		require.NoError(t, store.db.Model(
+			&models.Assessment{},
+		).Where(
+			"trace_id = ?", trace.RequestID,
+		).Count(&count).Error)
+
+		return count
+	}
+
+	assessment, contractErr := store.CreateAssessment(ctx, &entities.Assessment{
+		TraceID:    trace.RequestID,
+		Name:       "correctness",
+		Type:       entities.AssessmentTypeFeedback,
+		Value:      true,
+		SourceType: "HUMAN",
+		SourceID:   "reviewer",
+	})
+	require.Nil(t, contractErr)
+
+	// Neither the rationale nor the same value create a new version.
+	updated, contractErr := store.UpdateAssessment(
+		ctx, trace.RequestID, assessment.AssessmentID, &entities.AssessmentUpdate{
+			Type:      utils.PtrTo(entities.AssessmentTypeFeedback),
+			Value:     true,
+			Rationale: utils.PtrTo("looks right"),
+		},
+	)
+	require.Nil(t, contractErr)
+	assert.Equal(t, "looks right", updated.Rationale)
+	assert.Equal(t, int64(1), countAssessments())
+
+	// A new value keeps the previous one as an invalid assessment, overridden by the updated one.
+	for _, value := range []any{false, "unsure"} {
+		updated, contractErr = store.UpdateAssessment(
+			ctx, trace.RequestID, assessment.AssessmentID, &entities.AssessmentUpdate{
+				Type:  utils.PtrTo(entities.AssessmentTypeFeedback),
+				Value: value,
+			},
+		)
+		require.Nil(t, contractErr)
+		assert.Equal(t, assessment.AssessmentID, updated.AssessmentID)
+		assert.Equal(t, value, updated.Value)
+	}
+
+	assert.Equal(t, int64(3), countAssessments())
+
+	var current models.Assessment
+	require.NoError(t, store.db.Where("assessment_id = ?", assessment.AssessmentID).First(&current).Error)
+
+	var previous models.Assessment
+	require.NoError(t, store.db.Where("assessment_id = ?", current.Overrides.String).First(&previous).Error)
+	assert.False(t, previous.Valid)
+	assert.Equal(t, "false", previous.Value)
+
+	var first models.Assessment
+	require.NoError(t, store.db.Where("assessment_id = ?", previous.Overrides.String).First(&first).Error)
+	assert.False(t, first.Valid)
+	assert.Equal(t, "true", first.Value)
+	assert.Equal(t, "looks right", first.Rationale.String)
+
+	assessments, contractErr := getTraceAssessments(store.db, trace.RequestID)
+	require.Nil(t, contractErr)
+	require.Len(t, assessments, 1)
+	assert.Equal(t, "unsure", assessments[0].Value)
+
+	// Deleting the assessment deletes the whole chain of its versions.
+	require.Nil(t, store.DeleteAssessment(ctx, trace.RequestID, assessment.AssessmentID))
+	assert.Equal(t, int64(0), countAssessments())
+
+	require.Nil(t, store.DeleteAssessment(ctx, trace.RequestID, assessment.AssessmentID))
+}
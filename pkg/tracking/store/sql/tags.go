@@ -149,7 +149,7 @@ func (s TrackingSQLStore) SetTag(
 ) *contract.Error {
 	var run models.Run
 
-	err := s.db.Where("run_uuid = ?", runID).First(&run).Error
+	err := s.db.WithContext(ctx).Where("run_uuid = ?", runID).First(&run).Error
 	if err != nil {
 		return contract.NewErrorWith(
 			protos.ErrorCode_INTERNAL_ERROR,
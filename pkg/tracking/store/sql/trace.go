@@ -171,7 +171,78 @@ func (s TrackingSQLStore) GetTraceInfo(ctx context.Context, reqeustID string) (*
 		)
 	}
 
-	return traceInfo.ToEntity(), nil
+	assessments, err := getTraceAssessments(s.db.WithContext(ctx), reqeustID)
+	if err != nil {
+		return nil, err
+	}
+
+	entity := traceInfo.ToEntity()
+	entity.Assessments = assessments
+
+	return entity, nil
+}
+
+func (s TrackingSQLStore) SearchTraces(
+	ctx context.Context,
+	experimentIDs []string,
+	filter string,
+	maxResults int64,
+	orderBy []string,
+	pageToken string,
+) ([]*entities.TraceInfo, string, *contract.Error) {
+	query := s.db.WithContext(ctx).Model(
+		&models.TraceInfo{},
+	).Where(
+		"trace_info.experiment_id IN (?)", experimentIDs,
+	)
+
+	// apply Limit
+	query, limit := applyLimitFilter(query, maxResults)
+
+	// apply Offset
+	query, offset, err := applyOffsetFilter(query, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Apply Filter
+	query, err = applyTracesFilter(s.db, query, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// OrderBy
+	query, err = applyTracesOrderBy(s.db, query, orderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Actual query
+	var traces []models.TraceInfo
+	if err := query.Preload("Tags").Preload("TraceRequestMetadata").Find(&traces).Error; err != nil {
+		return nil, "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			fmt.Sprintf("failed to search traces %q", err),
+			err,
+		)
+	}
+
+	// encode `nextPageToken` value.
+	nextPageToken, err := createTracesNextPageToken(traces, limit, offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(traces) > limit {
+		traces = traces[:limit]
+	}
+
+	data := make([]*entities.TraceInfo, len(traces))
+	for i, trace := range traces {
+		data[i] = trace.ToEntity()
+	}
+
+	return data, nextPageToken, nil
 }
 
 func (s TrackingSQLStore) EndTrace(
@@ -0,0 +1,187 @@
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/service/query/parser"
+	"github.com/mlflow/mlflow-go-backend/pkg/tracking/store/sql/models"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+var traceOrder = regexp.MustCompile("^(?:(\\w+)\\.)?(\"[^\"]+\"|`[^`]+`|[\\w\\.]+)(?i:\\s+(ASC|DESC))?$")
+
+// Returns the model of the table that stores the key-value pairs of an identifier.
+func traceKeyValueModel(identifier parser.ValidIdentifier) any {
+	if identifier == parser.RequestMetadata {
+		return &models.TraceRequestMetadata{}
+	}
+
+	return &models.TraceTag{}
+}
+
+//nolint:funlen
+func applyTracesFilter(database, transaction *gorm.DB, filter string) (*gorm.DB, *contract.Error) {
+	filterConditions, err := query.ParseTraceFilter(filter)
+	if err != nil {
+		return nil, contract.NewError(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("error parsing search filter: %s", err),
+		)
+	}
+
+	for index, condition := range filterConditions {
+		comparison, wrapColumn, value := FilterComparison(database, condition)
+
+		//nolint:exhaustive
+		switch condition.Identifier {
+		case parser.Attribute:
+			column := wrapColumn("trace_info." + condition.Key)
+
+			placeholder := "?"
+			if condition.Operator == parser.In || condition.Operator == parser.NotIn {
+				placeholder = "(?)"
+			}
+
+			transaction = transaction.Where(fmt.Sprintf("%s %s %s", column, comparison, placeholder), value)
+		case parser.Tag, parser.RequestMetadata:
+			// SELECT trace_info.*
+			// FROM trace_info
+			// JOIN (
+			//   SELECT request_id FROM trace_tags WHERE key = ? AND value %s ?
+			// ) AS filter_0
+			// ON trace_info.request_id = filter_0.request_id
+			where := fmt.Sprintf("%s %s ?", wrapColumn("value"), comparison)
+
+			table := fmt.Sprintf("filter_%d", index)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf("JOIN (?) AS %s ON trace_info.request_id = %s.request_id", table, table),
+				database.Select("request_id").Where("key = ?", condition.Key).Where(where, value).Model(
+					traceKeyValueModel(condition.Identifier),
+				),
+			)
+		default:
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("Invalid token type: %s", condition.Identifier),
+			)
+		}
+	}
+
+	return transaction, nil
+}
+
+// Returns the identifier and the column or key a trace order_by clause refers to.
+func parseTraceOrderByKey(identifier, key string) (parser.ValidIdentifier, string, *contract.Error) {
+	switch identifier {
+	case "tag", "tags":
+		return parser.Tag, key, nil
+	case "request_metadata", "metadata":
+		return parser.RequestMetadata, key, nil
+	case "", "attribute", "attributes", "attr", "trace":
+		switch key {
+		case "timestamp", parser.TraceTimestampMS:
+			return parser.Attribute, parser.TraceTimestampMS, nil
+		case "execution_time", parser.TraceExecutionTimeMS:
+			return parser.Attribute, parser.TraceExecutionTimeMS, nil
+		case "status", "request_id", "experiment_id":
+			return parser.Attribute, key, nil
+		case "name":
+			return parser.Tag, parser.TraceNameTagKey, nil
+		case parser.RunID:
+			return parser.RequestMetadata, parser.TraceSourceRunKey, nil
+		}
+	}
+
+	return -1, "", contract.NewError(
+		protos.ErrorCode_INVALID_PARAMETER_VALUE,
+		fmt.Sprintf("Invalid order by key '%s' specified.", strings.TrimPrefix(identifier+"."+key, ".")),
+	)
+}
+
+// Traces are ordered by the given clauses, followed by timestamp_ms DESC and request_id as tiebreakers.
+//
+//nolint:funlen
+func applyTracesOrderBy(database, transaction *gorm.DB, orderBy []string) (*gorm.DB, *contract.Error) {
+	observedKeys := map[string]struct{}{}
+	columnSelection := "trace_info.*"
+
+	for index, o := range orderBy {
+		parts := traceOrder.FindStringSubmatch(strings.TrimSpace(o))
+		if len(parts) == 0 {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("invalid order_by clause '%s'", o),
+			)
+		}
+
+		identifier, key, err := parseTraceOrderByKey(parts[1], strings.Trim(parts[2], "\"`"))
+		if err != nil {
+			return nil, err
+		}
+
+		observedKey := fmt.Sprintf("%s.%s", identifier, key)
+		if _, ok := observedKeys[observedKey]; ok {
+			return nil, contract.NewError(
+				protos.ErrorCode_INVALID_PARAMETER_VALUE,
+				fmt.Sprintf("`order_by` contains duplicate fields: %v", orderBy),
+			)
+		}
+
+		observedKeys[observedKey] = struct{}{}
+
+		column := clause.Column{Table: "trace_info", Name: key}
+
+		if identifier != parser.Attribute {
+			table := fmt.Sprintf("order_%d", index)
+
+			transaction = transaction.Joins(
+				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON trace_info.request_id = %s.request_id", table, table),
+				database.Select("request_id", "value").Where("key = ?", key).Model(traceKeyValueModel(identifier)),
+			)
+
+			column = clause.Column{Table: table, Name: "value"}
+		}
+
+		// Like the Python store, null values are ordered last whatever the direction.
+		nullableColumnAlias := fmt.Sprintf("order_null_%d", index)
+		columnSelection = fmt.Sprintf(
+			"%s, (CASE WHEN (%s.%s IS NULL) THEN 1 ELSE 0 END) AS %s",
+			columnSelection,
+			column.Table,
+			column.Name,
+			nullableColumnAlias,
+		)
+
+		transaction = transaction.Order(nullableColumnAlias).Order(clause.OrderByColumn{
+			Column: column,
+			Desc:   strings.ToUpper(parts[3]) == "DESC",
+		})
+	}
+
+	if _, ok := observedKeys[fmt.Sprintf("%s.%s", parser.Attribute, parser.TraceTimestampMS)]; !ok {
+		transaction = transaction.Order("trace_info.timestamp_ms DESC")
+	}
+
+	if _, ok := observedKeys[fmt.Sprintf("%s.request_id", parser.Attribute)]; !ok {
+		transaction = transaction.Order("trace_info.request_id")
+	}
+
+	return transaction.Select(columnSelection), nil
+}
+
+func createTracesNextPageToken(traces []models.TraceInfo, limit, offset int) (string, *contract.Error) {
+	if len(traces) > limit {
+		return utils.EncodePageToken(offset + limit)
+	}
+
+	return "", nil
+}
@@ -16,6 +16,7 @@ type TrackingStore interface {
 	MetricTrackingStore
 	ExperimentTrackingStore
 	InputTrackingStore
+	LoggedModelTrackingStore
 }
 
 type (
@@ -59,6 +60,14 @@ type (
 			tags []*entities.TraceTag,
 		) (*entities.TraceInfo, error)
 		GetTraceInfo(ctx context.Context, reqeustID string) (*entities.TraceInfo, *contract.Error)
+		SearchTraces(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			maxResults int64,
+			orderBy []string,
+			pageToken string,
+		) ([]*entities.TraceInfo, string, *contract.Error)
 		SetTraceTag(ctx context.Context, requestID, key, value string) error
 		GetTraceTag(ctx context.Context, requestID, key string) (*entities.TraceTag, *contract.Error)
 		DeleteTraceTag(ctx context.Context, tag *entities.TraceTag) *contract.Error
@@ -69,6 +78,11 @@ type (
 			maxTraces int32,
 			requestIDs []string,
 		) (int32, *contract.Error)
+		CreateAssessment(ctx context.Context, assessment *entities.Assessment) (*entities.Assessment, *contract.Error)
+		UpdateAssessment(
+			ctx context.Context, traceID, assessmentID string, update *entities.AssessmentUpdate,
+		) (*entities.Assessment, *contract.Error)
+		DeleteAssessment(ctx context.Context, traceID, assessmentID string) *contract.Error
 	}
 	MetricTrackingStore interface {
 		LogBatch(
@@ -81,6 +95,13 @@ type (
 		LogMetric(ctx context.Context, runID string, metric *entities.Metric) *contract.Error
 		LogParam(ctx context.Context, runID string, metric *entities.Param) *contract.Error
 		GetMetricHistory(ctx context.Context, runID, metricKey string) ([]*entities.Metric, *contract.Error)
+		GetMetricHistoryBulkInterval(
+			ctx context.Context,
+			runIDs []string,
+			metricKey string,
+			startStep, endStep *int64,
+			maxResults int,
+		) ([]*entities.MetricWithRunID, *contract.Error)
 	}
 
 	ExperimentTrackingStore interface {
@@ -124,5 +145,33 @@ type (
 		LogInputs(
 			ctx context.Context, runID string, modelInputs []*entities.ModelInput, datasets []*entities.DatasetInput,
 		) *contract.Error
+		LogOutputs(ctx context.Context, runID string, modelOutputs []*entities.ModelOutput) *contract.Error
+		SearchDatasets(ctx context.Context, experimentIDs []string) ([]*entities.DatasetSummary, *contract.Error)
+	}
+
+	LoggedModelTrackingStore interface {
+		CreateLoggedModel(
+			ctx context.Context,
+			experimentID, name, modelType, sourceRunID string,
+			params []*entities.LoggedModelParameter,
+			tags []*entities.LoggedModelTag,
+		) (*entities.LoggedModel, *contract.Error)
+		GetLoggedModel(ctx context.Context, modelID string) (*entities.LoggedModel, *contract.Error)
+		FinalizeLoggedModel(
+			ctx context.Context, modelID string, status protos.LoggedModelStatus,
+		) (*entities.LoggedModel, *contract.Error)
+		DeleteLoggedModel(ctx context.Context, modelID string) *contract.Error
+		SearchLoggedModels(
+			ctx context.Context,
+			experimentIDs []string,
+			filter string,
+			datasets []*entities.LoggedModelDataset,
+			maxResults int64,
+			orderBy []*entities.LoggedModelOrderBy,
+			pageToken string,
+		) ([]*entities.LoggedModel, string, *contract.Error)
+		SetLoggedModelTags(ctx context.Context, modelID string, tags []*entities.LoggedModelTag) *contract.Error
+		DeleteLoggedModelTag(ctx context.Context, modelID, key string) *contract.Error
+		LogLoggedModelParams(ctx context.Context, modelID string, params []*entities.LoggedModelParameter) *contract.Error
 	}
 )
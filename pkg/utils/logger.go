@@ -5,6 +5,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/mlflow/mlflow-go-backend/pkg/config"
 )
@@ -15,11 +16,13 @@ func NewContextWithLogger(ctx context.Context, logger *logrus.Logger) context.Co
 	return context.WithValue(ctx, loggerKey{}, logger)
 }
 
-// NewContextWithLoggerFromFiberContext transfer logger from Fiber context to a normal context.Context object.
+// NewContextWithLoggerFromFiberContext transfer logger and trace span from Fiber context
+// to a normal context.Context object.
 func NewContextWithLoggerFromFiberContext(c *fiber.Ctx) context.Context {
 	logger := GetLoggerFromContext(c.UserContext())
+	ctx := trace.ContextWithSpan(c.Context(), trace.SpanFromContext(c.UserContext()))
 
-	return NewContextWithLogger(c.Context(), logger)
+	return NewContextWithLogger(ctx, logger)
 }
 
 func GetLoggerFromContext(ctx context.Context) *logrus.Logger {
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/contract"
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+)
+
+// pageToken is the JSON of the page tokens of the searches, encoded in base64 like the Python stores do.
+type pageToken struct {
+	Offset int32 `json:"offset"`
+}
+
+// EncodePageToken returns the token of the page starting at offset.
+//
+//nolint:gosec // disable G115
+func EncodePageToken(offset int) (string, *contract.Error) {
+	data, err := json.Marshal(pageToken{Offset: int32(offset)})
+	if err != nil {
+		return "", contract.NewErrorWith(
+			protos.ErrorCode_INTERNAL_ERROR,
+			"error encoding 'nextPageToken' value",
+			err,
+		)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodePageToken returns the offset of a page token, an empty token is the first page.
+func DecodePageToken(token string) (int, *contract.Error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var decoded pageToken
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &decoded)
+	}
+
+	if err != nil {
+		return 0, contract.NewErrorWith(
+			protos.ErrorCode_INVALID_PARAMETER_VALUE,
+			fmt.Sprintf("invalid page_token: %q", token),
+			err,
+		)
+	}
+
+	return int(decoded.Offset), nil
+}
@@ -0,0 +1,33 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mlflow/mlflow-go-backend/pkg/protos"
+	"github.com/mlflow/mlflow-go-backend/pkg/utils"
+)
+
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+
+	for _, offset := range []int{1, 10, 50, 1000, 10000} {
+		token, err := utils.EncodePageToken(offset)
+		require.Nil(t, err)
+
+		decoded, err := utils.DecodePageToken(token)
+		require.Nil(t, err, token)
+		assert.Equal(t, offset, decoded)
+	}
+
+	// The tokens of the Python stores are accepted.
+	offset, err := utils.DecodePageToken("eyJvZmZzZXQiOiA1MH0=")
+	require.Nil(t, err)
+	assert.Equal(t, 50, offset)
+
+	_, err = utils.DecodePageToken("eyJvZmZzZXQiOjUw")
+	require.NotNil(t, err)
+	assert.Equal(t, protos.ErrorCode_INVALID_PARAMETER_VALUE, protos.ErrorCode(err.Code))
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"path/filepath"
+	"runtime"
 	"strings"
 )
 
@@ -88,3 +90,28 @@ func AppendToURIPath(uri string, paths ...string) (string, error) {
 
 	return prefix + parsedURI.String(), nil
 }
+
+// LocalPathFromURI returns the local directory of a file store URI, which is either a plain path
+// or a file:// URI. The second return value is false for URIs of other schemes.
+func LocalPathFromURI(uri string) (string, bool) {
+	if uri == "" {
+		return "", false
+	}
+
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return "", false
+	}
+
+	switch {
+	case parsedURI.Scheme == "":
+		return filepath.FromSlash(uri), true
+	case len(parsedURI.Scheme) == 1 && runtime.GOOS == "windows":
+		// A drive letter like C:\mlruns is parsed as a scheme.
+		return uri, true
+	case parsedURI.Scheme == "file":
+		return filepath.FromSlash(parsedURI.Path), true
+	default:
+		return "", false
+	}
+}
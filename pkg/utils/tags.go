@@ -3,4 +3,6 @@ package utils
 const (
 	TagRunName = "mlflow.runName"
 	TagUser    = "mlflow.user"
+
+	TagDatasetContext = "mlflow.data.context"
 )
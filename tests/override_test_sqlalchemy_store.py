@@ -23,10 +23,6 @@ def test_log_batch_null_metrics(store: SqlAlchemyStore):
     ()
 
 
-def test_sqlalchemy_store_behaves_as_expected_with_inmemory_sqlite_db(monkeypatch):
-    ()
-
-
 def test_search_experiments_max_results_validation(store: SqlAlchemyStore):
     with pytest.raises(
         MlflowException,